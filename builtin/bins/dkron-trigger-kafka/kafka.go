@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	dktypes "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	dkplugin "github.com/distribworks/dkron/v4/plugin"
+)
+
+const (
+	// retryDelay is how long to wait before handling again a batch whose run failed.
+	retryDelay = 5 * time.Second
+	// defaultMaxAttempts is how many times a batch is handled before giving up on it.
+	defaultMaxAttempts = 5
+)
+
+// Kafka consumes kafka topics
+type Kafka struct {
+}
+
+// Consume method of the plugin
+// "trigger": "kafka",
+//
+//	"trigger_config": {
+//	    "brokerAddress": "192.168.59.103:9092", // kafka broker url
+//	    "topic": "jobs",
+//	    "groupId": "dkron-myjob", // consumer group, defaults to dkron-<job name>
+//	    "initialOffset": "oldest", // oldest/newest, where new groups start
+//	    "batch_size": "1",
+//	    "batch_wait": "1s",
+//	    "maxAttempts": "5", // runs of a batch before giving up on it, 0 means forever
+//	    "deadLetterTopic": "jobs-failed" // where the batches given up on are sent
+//	}
+func (s *Kafka) Consume(ctx context.Context, args *dktypes.ConsumeRequest, h dkplugin.MessageHandler) error {
+	config, err := newConfig(args.Config)
+	if err != nil {
+		return err
+	}
+	size, wait, err := dkplugin.BatchConfig(args.Config)
+	if err != nil {
+		return err
+	}
+
+	groupID := args.Config["groupId"]
+	if groupID == "" {
+		groupID = "dkron-" + args.JobName
+	}
+
+	maxAttempts, err := maxAttempts(args.Config)
+	if err != nil {
+		return err
+	}
+
+	brokers := strings.Split(args.Config["brokerAddress"], ",")
+	group, err := sarama.NewConsumerGroup(brokers, groupID, config)
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	c := &consumer{handler: h, size: size, wait: wait, maxAttempts: maxAttempts}
+	if topic := args.Config["deadLetterTopic"]; topic != "" {
+		producer, err := sarama.NewSyncProducer(brokers, config)
+		if err != nil {
+			return err
+		}
+		defer producer.Close()
+		c.deadLetter = func(msgs []*sarama.ConsumerMessage) error {
+			return producer.SendMessages(toDeadLetters(topic, msgs))
+		}
+	}
+	topics := strings.Split(args.Config["topic"], ",")
+	for ctx.Err() == nil {
+		// Consume returns on rebalances, join the group again
+		if err := group.Consume(ctx, topics, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newConfig returns the sarama config of a consumer from the trigger config.
+func newConfig(cfg map[string]string) (*sarama.Config, error) {
+	if cfg["brokerAddress"] == "" {
+		return nil, errors.New("brokerAddress is empty")
+	}
+
+	if cfg["topic"] == "" {
+		return nil, errors.New("topic is empty")
+	}
+
+	config := sarama.NewConfig()
+	// Offsets are committed after every successful run
+	config.Consumer.Offsets.AutoCommit.Enable = false
+	// The dead letter producer waits for the batches to be stored
+	config.Producer.Return.Successes = true
+	switch cfg["initialOffset"] {
+	case "", "newest":
+		config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	case "oldest":
+		config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	default:
+		return nil, errors.New("invalid initialOffset, must be 'oldest' or 'newest'")
+	}
+
+	if cfg["tlsEnable"] == "true" {
+		config.Net.TLS.Enable = true
+
+		config.Net.TLS.Config = &tls.Config{}
+		if cfg["tlsInsecureSkipVerify"] == "true" {
+			config.Net.TLS.Config.InsecureSkipVerify = true
+		}
+	}
+
+	if cfg["saslUsername"] != "" && cfg["saslPassword"] != "" {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = cfg["saslUsername"]
+		config.Net.SASL.Password = cfg["saslPassword"]
+		config.Net.SASL.Handshake = true
+
+		if cfg["saslMechanism"] == "sha512" {
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &XDGSCRAMClient{HashGeneratorFcn: SHA512}
+			}
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		} else if cfg["saslMechanism"] == "sha256" {
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &XDGSCRAMClient{HashGeneratorFcn: SHA256}
+			}
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		} else {
+			return nil, errors.New("invalid SASL mechanism, must be 'sha256' or 'sha512'")
+		}
+	}
+
+	return config, nil
+}
+
+// maxAttempts returns the maxAttempts of a trigger config, how many times a
+// batch is handled before giving up on it.
+func maxAttempts(cfg map[string]string) (int, error) {
+	v := cfg["maxAttempts"]
+	if v == "" {
+		return defaultMaxAttempts, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid maxAttempts %q", v)
+	}
+	return n, nil
+}
+
+// consumer handles the claimed partitions, committing the offsets of the
+// messages once their run succeeded, or once given up on.
+type consumer struct {
+	handler dkplugin.MessageHandler
+	size    int
+	wait    time.Duration
+
+	// maxAttempts is how many times a batch is handled, 0 means until it
+	// succeeds.
+	maxAttempts int
+	// deadLetter sends the batches given up on to the dead letter topic,
+	// when there is one.
+	deadLetter func([]*sarama.ConsumerMessage) error
+}
+
+func (c *consumer) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+func (c *consumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (c *consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	for {
+		msgs := dkplugin.CollectBatch(ctx, claim.Messages(), c.size, c.wait)
+		if len(msgs) == 0 {
+			return nil
+		}
+
+		// Failed batches are handled again, the offsets of the partition
+		// don't move past them until their run succeeds or they are given
+		// up on after maxAttempts.
+		batch := toTriggerMessages(msgs)
+		for attempt := 1; ; attempt++ {
+			err := c.handler.Handle(ctx, batch)
+			if err == nil {
+				break
+			}
+			log.Printf("kafka: run failed for %s/%d at offset %d: %s", claim.Topic(), claim.Partition(), msgs[0].Offset, err)
+			if c.maxAttempts > 0 && attempt >= c.maxAttempts {
+				if c.giveUp(claim, msgs) {
+					break
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(retryDelay):
+			}
+		}
+
+		session.MarkMessage(msgs[len(msgs)-1], "")
+		session.Commit()
+	}
+}
+
+// giveUp sends a batch that failed maxAttempts times to the dead letter
+// topic, if any, returning whether the batch can be skipped. Batches that
+// can't be sent to the dead letter topic are handled again.
+func (c *consumer) giveUp(claim sarama.ConsumerGroupClaim, msgs []*sarama.ConsumerMessage) bool {
+	if c.deadLetter == nil {
+		log.Printf("kafka: giving up on %s/%d at offset %d after %d attempts", claim.Topic(), claim.Partition(), msgs[0].Offset, c.maxAttempts)
+		return true
+	}
+	if err := c.deadLetter(msgs); err != nil {
+		log.Printf("kafka: error sending %s/%d at offset %d to the dead letter topic: %s", claim.Topic(), claim.Partition(), msgs[0].Offset, err)
+		return false
+	}
+	log.Printf("kafka: sent %s/%d at offset %d to the dead letter topic after %d attempts", claim.Topic(), claim.Partition(), msgs[0].Offset, c.maxAttempts)
+	return true
+}
+
+// toDeadLetters copies kafka messages to the dead letter topic.
+func toDeadLetters(topic string, msgs []*sarama.ConsumerMessage) []*sarama.ProducerMessage {
+	out := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, m := range msgs {
+		headers := make([]sarama.RecordHeader, 0, len(m.Headers))
+		for _, h := range m.Headers {
+			headers = append(headers, *h)
+		}
+		out = append(out, &sarama.ProducerMessage{
+			Topic:   topic,
+			Key:     sarama.ByteEncoder(m.Key),
+			Value:   sarama.ByteEncoder(m.Value),
+			Headers: headers,
+		})
+	}
+	return out
+}
+
+// toTriggerMessages converts kafka messages to trigger messages.
+func toTriggerMessages(msgs []*sarama.ConsumerMessage) []*dktypes.TriggerMessage {
+	batch := make([]*dktypes.TriggerMessage, 0, len(msgs))
+	for _, m := range msgs {
+		headers := make(map[string]string, len(m.Headers))
+		for _, h := range m.Headers {
+			headers[string(h.Key)] = string(h.Value)
+		}
+		batch = append(batch, &dktypes.TriggerMessage{
+			Source:  m.Topic,
+			Key:     string(m.Key),
+			Payload: m.Value,
+			Headers: headers,
+		})
+	}
+	return batch
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dktypes "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+)
+
+func TestConsumeConfig(t *testing.T) {
+	kafka := &Kafka{}
+	err := kafka.Consume(context.Background(), &dktypes.ConsumeRequest{
+		JobName: "testJob",
+		Config:  map[string]string{"brokerAddress": "testaddress"},
+	}, nil)
+	assert.EqualError(t, err, "topic is empty")
+
+	_, err = newConfig(map[string]string{"brokerAddress": "testaddress", "topic": "test", "initialOffset": "latest"})
+	assert.Error(t, err)
+
+	config, err := newConfig(map[string]string{"brokerAddress": "testaddress", "topic": "test", "initialOffset": "oldest"})
+	require.NoError(t, err)
+	assert.Equal(t, sarama.OffsetOldest, config.Consumer.Offsets.Initial)
+	assert.False(t, config.Consumer.Offsets.AutoCommit.Enable)
+	assert.True(t, config.Producer.Return.Successes)
+}
+
+func TestMaxAttempts(t *testing.T) {
+	n, err := maxAttempts(map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultMaxAttempts, n)
+
+	n, err = maxAttempts(map[string]string{"maxAttempts": "0"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	_, err = maxAttempts(map[string]string{"maxAttempts": "-1"})
+	assert.Error(t, err)
+	_, err = maxAttempts(map[string]string{"maxAttempts": "many"})
+	assert.Error(t, err)
+}
+
+func TestToDeadLetters(t *testing.T) {
+	msgs := toDeadLetters("failed", []*sarama.ConsumerMessage{{
+		Topic:   "test",
+		Key:     []byte("key"),
+		Value:   []byte("{\"hello\":11}"),
+		Headers: []*sarama.RecordHeader{{Key: []byte("source"), Value: []byte("app")}},
+	}})
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "failed", msgs[0].Topic)
+	assert.Equal(t, sarama.ByteEncoder("key"), msgs[0].Key)
+	assert.Equal(t, sarama.ByteEncoder("{\"hello\":11}"), msgs[0].Value)
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte("source"), Value: []byte("app")}}, msgs[0].Headers)
+}
+
+func TestToTriggerMessages(t *testing.T) {
+	msgs := toTriggerMessages([]*sarama.ConsumerMessage{{
+		Topic:   "test",
+		Key:     []byte("key"),
+		Value:   []byte("{\"hello\":11}"),
+		Headers: []*sarama.RecordHeader{{Key: []byte("source"), Value: []byte("app")}},
+	}})
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "test", msgs[0].Source)
+	assert.Equal(t, "key", msgs[0].Key)
+	assert.Equal(t, "{\"hello\":11}", string(msgs[0].Payload))
+	assert.Equal(t, map[string]string{"source": "app"}, msgs[0].Headers)
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	dkplugin "github.com/distribworks/dkron/v4/plugin"
+	"github.com/hashicorp/go-plugin"
+)
+
+func main() {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: dkplugin.Handshake,
+		Plugins: map[string]plugin.Plugin{
+			"trigger": &dkplugin.TriggerPlugin{Trigger: &Kafka{}},
+		},
+
+		// A non-nil value here enables gRPC serving for this plugin...
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
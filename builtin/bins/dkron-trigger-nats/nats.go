@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	dktypes "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	dkplugin "github.com/distribworks/dkron/v4/plugin"
+)
+
+// defaultAckWait is how long the server waits for the ack of a message
+// before delivering it again, it matches the default ack_timeout of the job.
+const defaultAckWait = time.Hour
+
+// Nats consumes JetStream subjects
+type Nats struct {
+}
+
+// Consume method of the plugin
+// "trigger": "nats",
+//
+//	"trigger_config": {
+//	    "url": "tls://nats.demo.io:4443", // nats server url
+//	    "subject": "jobs.>",
+//	    "stream": "JOBS", // optional, looked up from the subject otherwise
+//	    "durable": "dkron-myjob", // durable consumer, defaults to dkron-<job name>
+//	    "userName":"test@hbh.dfg",
+//	    "password":"dfdffs",
+//	    "batch_size": "1",
+//	    "batch_wait": "1s"
+//	}
+func (s *Nats) Consume(ctx context.Context, args *dktypes.ConsumeRequest, h dkplugin.MessageHandler) error {
+	cfg := args.Config
+	if cfg["url"] == "" {
+		return errors.New("url is empty")
+	}
+
+	if cfg["subject"] == "" {
+		return errors.New("subject is empty")
+	}
+
+	size, wait, err := dkplugin.BatchConfig(cfg)
+	if err != nil {
+		return err
+	}
+	ackWait, err := ackWait(cfg)
+	if err != nil {
+		return err
+	}
+
+	nc, err := nats.Connect(cfg["url"], nats.UserInfo(cfg["userName"], cfg["password"]))
+	if err != nil {
+		return err
+	}
+	defer nc.Close()
+
+	js, err := nc.JetStream()
+	if err != nil {
+		return err
+	}
+
+	durable := cfg["durable"]
+	if durable == "" {
+		durable = "dkron-" + args.JobName
+	}
+	opts := []nats.SubOpt{nats.AckWait(ackWait)}
+	if cfg["stream"] != "" {
+		opts = append(opts, nats.BindStream(cfg["stream"]))
+	}
+	sub, err := js.PullSubscribe(cfg["subject"], durable, opts...)
+	if err != nil {
+		return err
+	}
+
+	for ctx.Err() == nil {
+		msgs, err := sub.Fetch(size, nats.MaxWait(wait))
+		if errors.Is(err, nats.ErrTimeout) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+
+		// Failed batches are delivered again by the server
+		if err := h.Handle(ctx, toTriggerMessages(msgs)); err != nil {
+			log.Printf("nats: run failed for %d messages from %s: %s", len(msgs), cfg["subject"], err)
+			for _, m := range msgs {
+				m.Nak()
+			}
+			continue
+		}
+		for _, m := range msgs {
+			m.Ack()
+		}
+	}
+	return nil
+}
+
+// ackWait returns how long the server waits for the ack of a message, the
+// ack_timeout of the job.
+func ackWait(cfg map[string]string) (time.Duration, error) {
+	if cfg["ack_timeout"] == "" {
+		return defaultAckWait, nil
+	}
+	d, err := time.ParseDuration(cfg["ack_timeout"])
+	if err != nil || d <= 0 {
+		return 0, errors.New("invalid ack_timeout")
+	}
+	return d, nil
+}
+
+// toTriggerMessages converts NATS messages to trigger messages.
+func toTriggerMessages(msgs []*nats.Msg) []*dktypes.TriggerMessage {
+	batch := make([]*dktypes.TriggerMessage, 0, len(msgs))
+	for _, m := range msgs {
+		headers := make(map[string]string, len(m.Header))
+		for k := range m.Header {
+			headers[k] = m.Header.Get(k)
+		}
+		batch = append(batch, &dktypes.TriggerMessage{
+			Source:  m.Subject,
+			Payload: m.Data,
+			Headers: headers,
+		})
+	}
+	return batch
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dktypes "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+)
+
+func TestConsumeConfig(t *testing.T) {
+	n := &Nats{}
+	err := n.Consume(context.Background(), &dktypes.ConsumeRequest{
+		JobName: "testJob",
+		Config:  map[string]string{"url": "localhost:4222"},
+	}, nil)
+	assert.EqualError(t, err, "subject is empty")
+
+	d, err := ackWait(map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultAckWait, d)
+
+	d, err = ackWait(map[string]string{"ack_timeout": "10m"})
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, d)
+}
+
+func TestToTriggerMessages(t *testing.T) {
+	m := nats.NewMsg("jobs.test")
+	m.Data = []byte("{\"hello\":11}")
+	m.Header.Set("Source", "app")
+
+	msgs := toTriggerMessages([]*nats.Msg{m})
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "jobs.test", msgs[0].Source)
+	assert.Equal(t, "{\"hello\":11}", string(msgs[0].Payload))
+	assert.Equal(t, map[string]string{"Source": "app"}, msgs[0].Headers)
+}
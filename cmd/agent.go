@@ -62,6 +62,7 @@ func agentRun(args ...string) error {
 	plugins := dkron.Plugins{
 		Processors: p.Processors,
 		Executors:  p.Executors,
+		Triggers:   p.Triggers,
 	}
 
 	agent = dkron.NewAgent(config, dkron.WithPlugins(plugins))
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"github.com/distribworks/dkron/v4/dkron"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// cancelCmd represents the cancel command
+var cancelCmd = &cobra.Command{
+	Use:   "cancel [job] [execution]",
+	Short: "Cancel a running execution",
+	Long: `Cancel stops a running execution of a job, the agent running it stops
+	the executor and the execution is recorded as cancelled.`,
+	Args: cobra.ExactArgs(2),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		ipa, err := dkron.ParseSingleIPTemplate(rpcAddr)
+		if err != nil {
+			return err
+		}
+		ip = ipa
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log := logrus.NewEntry(logrus.New())
+		gc := dkron.NewGRPCClient(nil, nil, log)
+
+		if _, err := gc.CancelExecution(ip, args[0], args[1]); err != nil {
+			return err
+		}
+
+		log.Info("Execution cancel requested")
+		return nil
+	},
+}
+
+func init() {
+	dkronCmd.AddCommand(cancelCmd)
+	cancelCmd.PersistentFlags().StringVar(&rpcAddr, "rpc-addr", "{{ GetPrivateIP }}:6868", "gRPC address of a server")
+}
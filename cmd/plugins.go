@@ -20,6 +20,7 @@ var embededPlugins = []string{"shell", "http"}
 type Plugins struct {
 	Processors map[string]dkplugin.Processor
 	Executors  map[string]dkplugin.Executor
+	Triggers   map[string]dkplugin.Trigger
 	LogLevel   string
 	NodeName   string
 }
@@ -35,6 +36,7 @@ type Plugins struct {
 func (p *Plugins) DiscoverPlugins() error {
 	p.Processors = make(map[string]dkplugin.Processor)
 	p.Executors = make(map[string]dkplugin.Executor)
+	p.Triggers = make(map[string]dkplugin.Trigger)
 
 	pluginDir := filepath.Join("/etc", "dkron", "plugins")
 	if viper.ConfigFileUsed() != "" {
@@ -53,6 +55,12 @@ func (p *Plugins) DiscoverPlugins() error {
 		return err
 	}
 
+	// Look in /etc/dkron/plugins (or the used config path)
+	triggers, err := plugin.Discover("dkron-trigger-*", pluginDir)
+	if err != nil {
+		return err
+	}
+
 	// Next, look in the same directory as the Dkron executable, usually
 	// /usr/local/bin. If found, this replaces what we found in the config path.
 	exePath, err := osext.Executable()
@@ -69,6 +77,11 @@ func (p *Plugins) DiscoverPlugins() error {
 			return err
 		}
 		executors = append(executors, e...)
+		t, err := plugin.Discover("dkron-trigger-*", filepath.Dir(exePath))
+		if err != nil {
+			return err
+		}
+		triggers = append(triggers, t...)
 	}
 
 	for _, file := range processors {
@@ -97,6 +110,19 @@ func (p *Plugins) DiscoverPlugins() error {
 		p.Executors[pluginName] = raw.(dkplugin.Executor)
 	}
 
+	for _, file := range triggers {
+		pluginName, ok := getPluginName(file)
+		if !ok {
+			continue
+		}
+
+		raw, err := p.pluginFactory(file, []string{}, dkplugin.TriggerPluginName)
+		if err != nil {
+			return err
+		}
+		p.Triggers[pluginName] = raw.(dkplugin.Trigger)
+	}
+
 	// Load the embeded plugins
 	for _, pluginName := range embededPlugins {
 		raw, err := p.pluginFactory(exePath, []string{pluginName}, dkplugin.ExecutorPluginName)
@@ -136,7 +162,7 @@ func (p *Plugins) pluginFactory(path string, args []string, pluginType string) (
 	switch pluginType {
 	case dkplugin.ProcessorPluginName:
 		config.AllowedProtocols = []plugin.Protocol{plugin.ProtocolNetRPC}
-	case dkplugin.ExecutorPluginName:
+	case dkplugin.ExecutorPluginName, dkplugin.TriggerPluginName:
 		config.AllowedProtocols = []plugin.Protocol{plugin.ProtocolGRPC}
 	}
 
@@ -30,6 +30,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -50,6 +51,10 @@ var (
 	ErrNoSuitableServer = errors.New("no suitable server found to send the request, aborting")
 
 	runningExecutions sync.Map
+
+	// executionCancels holds the cancel functions of the executions running in
+	// this agent that can be cancelled, by execution key.
+	executionCancels sync.Map
 )
 
 type RaftStore interface {
@@ -69,6 +74,9 @@ type Agent struct {
 	//ExecutorPlugins maps executor plugins
 	ExecutorPlugins map[string]plugin.Executor
 
+	// TriggerPlugins maps trigger plugins
+	TriggerPlugins map[string]plugin.Trigger
+
 	// HTTPTransport is a swappable interface for the HTTP server interface
 	HTTPTransport Transport
 
@@ -129,9 +137,29 @@ type Agent struct {
 
 	tracer trace.Tracer
 
-	// pauseNewJobs controls whether new jobs can be created or updated
-	pauseNewJobs bool
-	pauseMu      sync.RWMutex
+	// roundRobinLast holds the last node picked for every job using the
+	// round robin selector.
+	roundRobinLast map[string]string
+	selectorLock   sync.Mutex
+
+	// retryTimers holds the timers of the pending retries and of the deferred
+	// runs while this agent is the leader, by timer key.
+	retryTimers     map[string]*time.Timer
+	retryTimersLock sync.Mutex
+
+	// consumers holds the cancel functions of the trigger consumers while
+	// this agent is the leader, by job name.
+	consumers     map[string]context.CancelFunc
+	consumersLock sync.Mutex
+
+	// executionWaiters holds the channels waiting for the result of the
+	// executions started by triggers, by job name and execution group.
+	executionWaiters     map[string]chan []*Execution
+	executionWaitersLock sync.Mutex
+
+	// setJobLock serializes the job updates on the leader, so the expected
+	// version of an update is checked against the last one.
+	setJobLock sync.Mutex
 }
 
 // ProcessorFactory is a function type that creates a new instance
@@ -142,6 +170,7 @@ type ProcessorFactory func() (plugin.Processor, error)
 type Plugins struct {
 	Processors map[string]plugin.Processor
 	Executors  map[string]plugin.Executor
+	Triggers   map[string]plugin.Trigger
 }
 
 // AgentOption type that defines agent options
@@ -779,9 +808,12 @@ func (a *Agent) join(addrs []string, replay bool) (n int, err error) {
 	return
 }
 
-func (a *Agent) getTargetNodes(tags map[string]string, selectFunc func([]Node) int) []Node {
+func (a *Agent) getTargetNodes(tags map[string]string, constraints []*tagConstraint, selectFunc func([]Node) int) []Node {
 	bareTags, cardinality := cleanTags(tags, a.logger)
 	nodes := a.getQualifyingNodes(a.serf.Members(), bareTags)
+	nodes = filterArray(nodes, func(node Node) bool {
+		return nodeMatchesConstraints(node, constraints)
+	})
 	return selectNodes(nodes, cardinality, selectFunc)
 }
 
@@ -867,11 +899,513 @@ func (a *Agent) applySetJob(job *typesv1.Job) error {
 		return ErrParentJobNotFound
 	case ErrSameParent:
 		return ErrParentJobNotFound
+	case ErrDependencyCycle:
+		return ErrDependencyCycle
+	case ErrIndependentParents:
+		return ErrIndependentParents
+	case ErrCalendarNotFound:
+		return ErrCalendarNotFound
+	case ErrJobTemplateNotFound:
+		return ErrJobTemplateNotFound
+	case ErrNamespaceNotFound:
+		return ErrNamespaceNotFound
+	case ErrNamespaceMaxJobs:
+		return ErrNamespaceMaxJobs
+	case ErrNamespaceMismatch:
+		return ErrNamespaceMismatch
+	case ErrCrossNamespaceParent:
+		return ErrCrossNamespaceParent
+	}
+
+	return nil
+}
+
+// applySetCalendar stores a calendar through raft.
+func (a *Agent) applySetCalendar(calendar *typesv1.Calendar) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetCalendarType, &typesv1.SetCalendarRequest{Calendar: calendar})
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
 	}
 
 	return nil
 }
 
+// applyDeleteCalendar deletes a calendar through raft, returning the deleted calendar.
+func (a *Agent) applyDeleteCalendar(name string) (*Calendar, error) {
+	if a.raft == nil {
+		return nil, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeleteCalendarType, &typesv1.DeleteCalendarRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return nil, res
+	case *Calendar:
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("agent: Error wrong response from apply in DeleteCalendar")
+}
+
+// applySetMaintenanceWindow stores a maintenance window through raft.
+func (a *Agent) applySetMaintenanceWindow(window *typesv1.MaintenanceWindow) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetMaintenanceWindowType, &typesv1.SetMaintenanceWindowRequest{Window: window})
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applyDeleteMaintenanceWindow deletes a maintenance window through raft,
+// returning the deleted window.
+func (a *Agent) applyDeleteMaintenanceWindow(name string) (*MaintenanceWindow, error) {
+	if a.raft == nil {
+		return nil, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeleteMaintenanceWindowType, &typesv1.DeleteMaintenanceWindowRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return nil, res
+	case *MaintenanceWindow:
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("agent: Error wrong response from apply in DeleteMaintenanceWindow")
+}
+
+// applySetSuppression records a run suppressed by a maintenance window through raft.
+func (a *Agent) applySetSuppression(suppression *typesv1.Suppression) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetSuppressionType, &typesv1.SetSuppressionRequest{Suppression: suppression})
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applySetPause pauses job submissions through raft.
+func (a *Agent) applySetPause(pause *typesv1.Pause) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetPauseType, &typesv1.SetPauseRequest{Pause: pause})
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applyDeletePause lifts the pause with the given id, or all the pauses when
+// the id is empty, through raft, returning the lifted pauses.
+func (a *Agent) applyDeletePause(id string) ([]*Pause, error) {
+	if a.raft == nil {
+		return nil, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeletePauseType, &typesv1.DeletePauseRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return nil, res
+	case []*Pause:
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("agent: Error wrong response from apply in DeletePause")
+}
+
+// applySetWebhookTrigger stores a webhook trigger through raft.
+func (a *Agent) applySetWebhookTrigger(trigger *typesv1.WebhookTrigger) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetWebhookTriggerType, &typesv1.SetWebhookTriggerRequest{Trigger: trigger})
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applyDeleteWebhookTrigger deletes a webhook trigger through raft,
+// returning the deleted trigger.
+func (a *Agent) applyDeleteWebhookTrigger(id string) (*WebhookTrigger, error) {
+	if a.raft == nil {
+		return nil, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeleteWebhookTriggerType, &typesv1.DeleteWebhookTriggerRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return nil, res
+	case *WebhookTrigger:
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("agent: Error wrong response from apply in DeleteWebhookTrigger")
+}
+
+// applySetJobTemplate stores a job template through raft.
+func (a *Agent) applySetJobTemplate(template *typesv1.JobTemplate) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetJobTemplateType, &typesv1.SetJobTemplateRequest{Template: template})
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applyDeleteJobTemplate deletes a job template through raft, returning the
+// deleted template.
+func (a *Agent) applyDeleteJobTemplate(name string) (*JobTemplate, error) {
+	if a.raft == nil {
+		return nil, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeleteJobTemplateType, &typesv1.DeleteJobTemplateRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return nil, res
+	case *JobTemplate:
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("agent: Error wrong response from apply in DeleteJobTemplate")
+}
+
+// applySetNamespace stores a namespace through raft.
+func (a *Agent) applySetNamespace(namespace *typesv1.Namespace) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetNamespaceType, &typesv1.SetNamespaceRequest{Namespace: namespace})
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applyDeleteNamespace deletes a namespace through raft, returning the
+// deleted namespace.
+func (a *Agent) applyDeleteNamespace(name string) (*Namespace, error) {
+	if a.raft == nil {
+		return nil, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeleteNamespaceType, &typesv1.DeleteNamespaceRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return nil, res
+	case *Namespace:
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("agent: Error wrong response from apply in DeleteNamespace")
+}
+
+// applyParentJobDone records through raft that a parent of a job with several
+// parents finished successfully in a workflow run, returning whether the job
+// is ready to run.
+func (a *Agent) applyParentJobDone(jobName, parentName string, run int64) (bool, error) {
+	if a.raft == nil {
+		return false, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(ParentJobDoneType, &typesv1.ParentJobDoneRequest{
+		JobName:     jobName,
+		ParentJob:   parentName,
+		WorkflowRun: run,
+	})
+	if err != nil {
+		return false, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return false, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return false, res
+	case bool:
+		return res, nil
+	}
+
+	return false, nil
+}
+
+// applyQueueExecution queues an execution of a job through raft, returning
+// false when the job queue is full.
+func (a *Agent) applyQueueExecution(execution *Execution, maxDepth int) (bool, error) {
+	if a.raft == nil {
+		return false, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(QueueExecutionType, &typesv1.QueueExecutionRequest{
+		Execution: execution.ToProto(),
+		MaxDepth:  int32(maxDepth),
+	})
+	if err != nil {
+		return false, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return false, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return false, res
+	case bool:
+		return res, nil
+	}
+
+	return false, nil
+}
+
+// applyDequeueExecution takes the oldest queued execution of a job out of its
+// queue through raft, returning nil when the queue is empty.
+func (a *Agent) applyDequeueExecution(jobName string) (*Execution, error) {
+	if a.raft == nil {
+		return nil, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DequeueExecutionType, &typesv1.DequeueExecutionRequest{
+		JobName: jobName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return nil, res
+	case *Execution:
+		return res, nil
+	}
+
+	return nil, nil
+}
+
+// applyClaimSlot claims through raft the run of a job at a scheduled time,
+// it returns false if the slot was already claimed.
+func (a *Agent) applyClaimSlot(jobName string, scheduledAt time.Time) (bool, error) {
+	if a.raft == nil {
+		return false, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(ClaimSlotType, &typesv1.ClaimSlotRequest{
+		JobName:     jobName,
+		ScheduledAt: timestamppb.New(scheduledAt),
+	})
+	if err != nil {
+		return false, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return false, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return false, res
+	case bool:
+		return res, nil
+	}
+
+	return false, nil
+}
+
+// applySetPendingRetry stores a pending retry through raft.
+func (a *Agent) applySetPendingRetry(retry *PendingRetry) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetPendingRetryType, retry.ToProto())
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applyDeletePendingRetry deletes a pending retry through raft, returning
+// whether it existed.
+func (a *Agent) applyDeletePendingRetry(jobName, id string) (bool, error) {
+	if a.raft == nil {
+		return false, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeletePendingRetryType, &typesv1.DeletePendingRetryRequest{
+		JobName:     jobName,
+		ExecutionId: id,
+	})
+	if err != nil {
+		return false, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return false, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return false, res
+	case bool:
+		return res, nil
+	}
+
+	return false, nil
+}
+
+// applySetDeferredRun stores a run deferred by a maintenance window through raft.
+func (a *Agent) applySetDeferredRun(run *DeferredRun) error {
+	if a.raft == nil {
+		return fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(SetDeferredRunType, run.ToProto())
+	if err != nil {
+		return err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return err
+	}
+	if err, ok := af.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// applyDeleteDeferredRun deletes a deferred run through raft, returning
+// whether it existed.
+func (a *Agent) applyDeleteDeferredRun(jobName, id string) (bool, error) {
+	if a.raft == nil {
+		return false, fmt.Errorf("raft not initialized")
+	}
+	cmd, err := Encode(DeleteDeferredRunType, &typesv1.DeleteDeferredRunRequest{
+		JobName: jobName,
+		Id:      id,
+	})
+	if err != nil {
+		return false, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return false, err
+	}
+	switch res := af.Response().(type) {
+	case error:
+		return false, res
+	case bool:
+		return res, nil
+	}
+
+	return false, nil
+}
+
 // RaftApply applies a command to the Raft log
 func (a *Agent) RaftApply(cmd []byte) raft.ApplyFuture {
 	if a.raft == nil {
@@ -907,16 +1441,36 @@ func (a *Agent) GetActiveExecutions() ([]*typesv1.Execution, error) {
 }
 
 func (a *Agent) recursiveSetJob(jobs []*Job) []string {
+	return a.setJobTree(jobs, make(map[string]bool))
+}
+
+// setJobTree creates the given jobs and their children, a child job is only
+// created once all of its parents were successfully created.
+func (a *Agent) setJobTree(jobs []*Job, created map[string]bool) []string {
 	result := make([]string, 0)
 	for _, job := range jobs {
-		err := a.GRPCClient.SetJob(job)
+		if _, ok := created[job.Name]; ok {
+			continue
+		}
+		// Wait for the remaining parents, the last one to be created
+		// will get here again.
+		ready := true
+		for _, p := range job.parents() {
+			ready = ready && created[p]
+		}
+		if !ready {
+			continue
+		}
+
+		err := a.GRPCClient.SetJob(job, 0)
+		created[job.Name] = err == nil
 		if err != nil {
 			result = append(result, "fail create "+job.Name)
 			continue
 		} else {
 			result = append(result, "success create "+job.Name)
 			if len(job.ChildJobs) > 0 {
-				recursiveResult := a.recursiveSetJob(job.ChildJobs)
+				recursiveResult := a.setJobTree(job.ChildJobs, created)
 				result = append(result, recursiveResult...)
 			}
 		}
@@ -969,26 +1523,3 @@ func (a *Agent) startReporter() {
 		}
 	}()
 }
-
-// PauseNewJobs pauses new job submissions
-func (a *Agent) PauseNewJobs() {
-	a.pauseMu.Lock()
-	defer a.pauseMu.Unlock()
-	a.pauseNewJobs = true
-	a.logger.Info("agent: New job submissions paused")
-}
-
-// UnpauseNewJobs resumes new job submissions
-func (a *Agent) UnpauseNewJobs() {
-	a.pauseMu.Lock()
-	defer a.pauseMu.Unlock()
-	a.pauseNewJobs = false
-	a.logger.Info("agent: New job submissions resumed")
-}
-
-// IsNewJobsPaused returns whether new job submissions are paused
-func (a *Agent) IsNewJobsPaused() bool {
-	a.pauseMu.RLock()
-	defer a.pauseMu.RUnlock()
-	return a.pauseNewJobs
-}
@@ -196,7 +196,7 @@ func Test_getTargetNodes(t *testing.T) {
 	t.Run("Test cardinality of 2 returns correct nodes", func(t *testing.T) {
 		tags := map[string]string{"tag": "test:2"}
 
-		nodes := a1.getTargetNodes(tags, lastSelector)
+		nodes := a1.getTargetNodes(tags, nil, lastSelector)
 
 		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
 		assert.Exactly(t, "test1", nodes[0].Name)
@@ -207,7 +207,7 @@ func Test_getTargetNodes(t *testing.T) {
 	t.Run("Test cardinality of 1 with two qualified nodes returns 1 node", func(t *testing.T) {
 		tags2 := map[string]string{"tag": "test:1"}
 
-		nodes := a1.getTargetNodes(tags2, defaultSelector)
+		nodes := a1.getTargetNodes(tags2, nil, defaultSelector)
 
 		assert.Len(t, nodes, 1)
 	})
@@ -215,7 +215,7 @@ func Test_getTargetNodes(t *testing.T) {
 	t.Run("Test no cardinality specified, all nodes returned", func(t *testing.T) {
 		var tags3 map[string]string
 
-		nodes := a1.getTargetNodes(tags3, lastSelector)
+		nodes := a1.getTargetNodes(tags3, nil, lastSelector)
 
 		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
 		assert.Len(t, nodes, 3)
@@ -227,7 +227,7 @@ func Test_getTargetNodes(t *testing.T) {
 	t.Run("Test exclusive tag returns correct node", func(t *testing.T) {
 		tags4 := map[string]string{"tag": "test_client:1"}
 
-		nodes := a1.getTargetNodes(tags4, defaultSelector)
+		nodes := a1.getTargetNodes(tags4, nil, defaultSelector)
 
 		assert.Len(t, nodes, 1)
 		assert.Exactly(t, "test3", nodes[0].Name)
@@ -236,7 +236,7 @@ func Test_getTargetNodes(t *testing.T) {
 	t.Run("Test existing tag but no matching value returns no nodes", func(t *testing.T) {
 		tags5 := map[string]string{"tag": "no_tag"}
 
-		nodes := a1.getTargetNodes(tags5, defaultSelector)
+		nodes := a1.getTargetNodes(tags5, nil, defaultSelector)
 
 		assert.Len(t, nodes, 0)
 	})
@@ -247,7 +247,7 @@ func Test_getTargetNodes(t *testing.T) {
 			"tag": "test:2",
 		}
 
-		nodes := a1.getTargetNodes(tags6, defaultSelector)
+		nodes := a1.getTargetNodes(tags6, nil, defaultSelector)
 
 		assert.Len(t, nodes, 0)
 	})
@@ -258,7 +258,7 @@ func Test_getTargetNodes(t *testing.T) {
 			"extra": "tag:2",
 		}
 
-		nodes := a1.getTargetNodes(tags7, defaultSelector)
+		nodes := a1.getTargetNodes(tags7, nil, defaultSelector)
 
 		assert.Len(t, nodes, 1)
 		assert.Exactly(t, "test2", nodes[0].Name)
@@ -269,11 +269,21 @@ func Test_getTargetNodes(t *testing.T) {
 			"tag": "test:invalid",
 		}
 
-		nodes := a1.getTargetNodes(tags9, defaultSelector)
+		nodes := a1.getTargetNodes(tags9, nil, defaultSelector)
 
 		assert.Len(t, nodes, 0)
 	})
 
+	t.Run("Test constraints filter the matching nodes", func(t *testing.T) {
+		constraints, err := parseTagConstraints([]string{"extra exists", "tag != test_client"})
+		require.NoError(t, err)
+
+		nodes := a1.getTargetNodes(map[string]string{}, constraints, defaultSelector)
+
+		assert.Len(t, nodes, 1)
+		assert.Exactly(t, "test2", nodes[0].Name)
+	})
+
 	t.Run("Test two tags matching same 3 servers and cardinality of 1 should always return 1 server", func(t *testing.T) {
 		// Do this multiple times: an old bug caused this to sometimes succeed and
 		// sometimes fail (=return no nodes at all) due to the use of math.rand
@@ -565,6 +575,95 @@ func Test_getQualifyingNodes(t *testing.T) {
 	}
 }
 
+func Test_getRetryNodes(t *testing.T) {
+	n1 := Node{
+		Name:   "node1",
+		Status: serf.StatusAlive,
+		Tags:   map[string]string{"region": "global", "role": "web"},
+	}
+	n2 := Node{
+		Name:   "node2",
+		Status: serf.StatusAlive,
+		Tags:   map[string]string{"region": "global", "role": "web"},
+	}
+	n3 := Node{
+		Name:   "node3",
+		Status: serf.StatusAlive,
+		Tags:   map[string]string{"region": "global", "role": "db"},
+	}
+	gone := Node{
+		Name:   "node1",
+		Status: serf.StatusFailed,
+		Tags:   map[string]string{"region": "global", "role": "web"},
+	}
+	tests := []struct {
+		name      string
+		placement string
+		inNodes   []Node
+		want      []Node
+		wantErr   bool
+	}{
+		{
+			name:    "Same node by default",
+			inNodes: []Node{n1, n2, n3},
+			want:    []Node{n1},
+		},
+		{
+			name:    "Another node by default when the node is gone",
+			inNodes: []Node{gone, n2, n3},
+			want:    []Node{n2},
+		},
+		{
+			name:      "Same node is gone",
+			placement: RetryPlacementSameNode,
+			inNodes:   []Node{gone, n2, n3},
+			wantErr:   true,
+		},
+		{
+			name:      "Any node prefers the same node",
+			placement: RetryPlacementAnyNode,
+			inNodes:   []Node{n1, n2, n3},
+			want:      []Node{n1},
+		},
+		{
+			name:      "Any node moves to a node matching the tags",
+			placement: RetryPlacementAnyNode,
+			inNodes:   []Node{gone, n2, n3},
+			want:      []Node{n2},
+		},
+		{
+			name:      "Different node excludes the failed node",
+			placement: RetryPlacementDifferentNode,
+			inNodes:   []Node{n1, n2, n3},
+			want:      []Node{n2},
+		},
+		{
+			name:      "Different node without other nodes",
+			placement: RetryPlacementDifferentNode,
+			inNodes:   []Node{n1, n3},
+			want:      []Node{},
+		},
+	}
+	agentStub := NewAgent(DefaultConfig())
+	ex := &Execution{JobName: "test", NodeName: "node1", Attempt: 2}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &Job{
+				Name:           "test",
+				Tags:           map[string]string{"role": "web"},
+				RetryPlacement: tt.placement,
+			}
+			actual, err := agentStub.getRetryNodes(tt.inNodes, job, nil, ex)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, actual)
+		})
+	}
+}
+
 func Test_filterArray(t *testing.T) {
 	n1 := Node{Name: "node1"}
 	n2 := Node{Name: "node2"}
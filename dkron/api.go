@@ -8,8 +8,11 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/distribworks/dkron/v4/extcron"
 	typesv1 "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/ntime"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/expvar"
 	"github.com/gin-gonic/gin"
@@ -24,6 +27,13 @@ import (
 const (
 	pretty        = "pretty"
 	apiPathPrefix = "v1"
+
+	// defaultSchedulePreview is the number of fire times returned by the
+	// schedule preview endpoints when not set.
+	defaultSchedulePreview = 10
+	// maxSchedulePreview is the maximum number of fire times returned by the
+	// schedule preview endpoints.
+	maxSchedulePreview = 1000
 )
 
 // Transport is the interface that wraps the ServeHTTP method.
@@ -98,6 +108,9 @@ func (h *HTTPTransport) APIRoutes(r *gin.RouterGroup, middleware ...gin.HandlerF
 	}
 
 	r.GET("/v1", h.indexHandler)
+	// Webhooks are authenticated by the secret of their trigger instead of
+	// the API middleware
+	r.POST("/v1/hooks/:trigger", h.hookHandler)
 	v1 := r.Group("/v1")
 	v1.Use(middleware...)
 	v1.GET("/", h.indexHandler)
@@ -108,28 +121,99 @@ func (h *HTTPTransport) APIRoutes(r *gin.RouterGroup, middleware ...gin.HandlerF
 	v1.POST("/restore", h.restoreHandler)
 
 	v1.GET("/busy", h.busyHandler)
+	v1.GET("/retries", h.retriesHandler)
+	v1.GET("/forecast", h.forecastHandler)
+	v1.POST("/schedule/validate", h.scheduleValidateHandler)
+
+	v1.POST("/calendars", h.calendarCreateOrUpdateHandler)
+	v1.GET("/calendars", h.calendarsHandler)
+
+	calendars := v1.Group("/calendars")
+	calendars.PUT("/:calendar", h.calendarCreateOrUpdateHandler)
+	calendars.GET("/:calendar", h.calendarGetHandler)
+	calendars.DELETE("/:calendar", h.calendarDeleteHandler)
+
+	v1.POST("/templates", h.templateCreateOrUpdateHandler)
+	v1.GET("/templates", h.templatesHandler)
+
+	templates := v1.Group("/templates")
+	templates.PUT("/:template", h.templateCreateOrUpdateHandler)
+	templates.GET("/:template", h.templateGetHandler)
+	templates.DELETE("/:template", h.templateDeleteHandler)
+
+	v1.POST("/namespaces", h.namespaceCreateOrUpdateHandler)
+	v1.GET("/namespaces", h.namespacesHandler)
+
+	namespaces := v1.Group("/namespaces")
+	namespaces.PUT("/:namespace", h.namespaceCreateOrUpdateHandler)
+	namespaces.GET("/:namespace", h.namespaceGetHandler)
+	namespaces.DELETE("/:namespace", h.namespaceDeleteHandler)
+
+	v1.POST("/maintenance-windows", h.windowCreateOrUpdateHandler)
+	v1.GET("/maintenance-windows", h.windowsHandler)
+
+	windows := v1.Group("/maintenance-windows")
+	windows.PUT("/:window", h.windowCreateOrUpdateHandler)
+	windows.GET("/:window", h.windowGetHandler)
+	windows.DELETE("/:window", h.windowDeleteHandler)
+	v1.GET("/suppressions", h.suppressionsHandler)
 
 	v1.GET("/pause", h.pauseStatusHandler)
 	v1.POST("/pause", h.pauseHandler)
 	v1.POST("/unpause", h.unpauseHandler)
 
+	v1.POST("/hooks", h.hookCreateOrUpdateHandler)
+	v1.GET("/hooks", h.hooksHandler)
+
+	hooks := v1.Group("/hooks")
+	hooks.PUT("/:trigger", h.hookCreateOrUpdateHandler)
+	hooks.GET("/:trigger", h.hookGetHandler)
+	hooks.DELETE("/:trigger", h.hookDeleteHandler)
+
 	v1.POST("/jobs", h.jobCreateOrUpdateHandler)
 	v1.PATCH("/jobs", h.jobCreateOrUpdateHandler)
 	// Place fallback routes last
 	v1.GET("/jobs", h.jobsHandler)
 
 	jobs := v1.Group("/jobs")
+	jobs.Use(namespaceMiddleware())
 	jobs.DELETE("/:job", h.jobDeleteHandler)
 	jobs.POST("/:job", h.jobRunHandler)
 	jobs.POST("/:job/run", h.jobRunHandler)
 	jobs.POST("/:job/toggle", h.jobToggleHandler)
 	jobs.PUT("/:job", h.jobCreateOrUpdateHandler)
+	jobs.PATCH("/:job", h.jobPatchHandler)
 
 	// Place fallback routes last
 	jobs.GET("/:job", h.jobGetHandler)
 	jobs.GET("/:job/executions", h.executionsHandler)
 	jobs.DELETE("/:job/executions", h.executionsDeleteHandler)
 	jobs.GET("/:job/executions/:execution", h.executionHandler)
+	jobs.DELETE("/:job/executions/:execution", h.executionCancelHandler)
+	jobs.GET("/:job/retries", h.retriesHandler)
+	jobs.GET("/:job/suppressions", h.suppressionsHandler)
+	jobs.GET("/:job/hooks", h.hooksHandler)
+	jobs.GET("/:job/schedule", h.jobScheduleHandler)
+	jobs.GET("/:job/versions", h.jobVersionsHandler)
+	jobs.GET("/:job/versions/:version", h.jobVersionHandler)
+	jobs.POST("/:job/versions/:version/rollback", h.jobRollbackHandler)
+	jobs.GET("/:job/diff", h.jobDiffHandler)
+}
+
+// namespaceMiddleware qualifies the job name of the path with the namespace
+// query parameter, so the jobs of a namespace are addressed by their name in
+// the namespace.
+func namespaceMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if namespace := c.Query("namespace"); namespace != "" {
+			for i, p := range c.Params {
+				if p.Key == "job" && !strings.Contains(p.Value, namespaceSeparator) {
+					c.Params[i].Value = qualifiedJobName(namespace, p.Value)
+				}
+			}
+		}
+		c.Next()
+	}
 }
 
 // MetaMiddleware adds middleware to the gin Context.
@@ -173,12 +257,13 @@ func (h *HTTPTransport) jobsHandler(c *gin.Context) {
 	q := c.Query("q")
 
 	jobs, err := h.agent.Store.GetJobs(c.Request.Context(), &JobOptions{
-		Metadata: metadata,
-		Sort:     sort,
-		Order:    order,
-		Query:    q,
-		Status:   c.Query("status"),
-		Disabled: c.Query("disabled"),
+		Metadata:  metadata,
+		Sort:      sort,
+		Order:     order,
+		Query:     q,
+		Status:    c.Query("status"),
+		Disabled:  c.Query("disabled"),
+		Namespace: c.Query("namespace"),
 	})
 	if err != nil {
 		h.logger.WithError(err).Error("api: Unable to get jobs, store not reachable.")
@@ -218,17 +303,26 @@ func (h *HTTPTransport) jobGetHandler(c *gin.Context) {
 		c.AbortWithStatus(http.StatusNotFound)
 		return
 	}
+	c.Header("ETag", jobETag(job))
 	renderJSON(c, http.StatusOK, job)
 }
 
-func (h *HTTPTransport) jobCreateOrUpdateHandler(c *gin.Context) {
-	// Check if new job submissions are paused
-	if h.agent.IsNewJobsPaused() {
-		c.AbortWithStatus(http.StatusServiceUnavailable)
-		_, _ = c.Writer.WriteString("New job submissions are currently paused")
-		return
+// jobETag returns the ETag of a job, its revision.
+func jobETag(job *Job) string {
+	return fmt.Sprintf(`"%d"`, job.Revision)
+}
+
+// expectedRevision returns the job revision in the If-Match header of the
+// request, zero when the header is not set.
+func expectedRevision(c *gin.Context) (uint64, error) {
+	etag := strings.TrimPrefix(strings.TrimSpace(c.GetHeader("If-Match")), "W/")
+	if etag == "" {
+		return 0, nil
 	}
+	return strconv.ParseUint(strings.Trim(etag, `"`), 10, 64)
+}
 
+func (h *HTTPTransport) jobCreateOrUpdateHandler(c *gin.Context) {
 	// Init the Job object with defaults
 	job := Job{
 		Concurrency: ConcurrencyAllow,
@@ -255,40 +349,130 @@ func (h *HTTPTransport) jobCreateOrUpdateHandler(c *gin.Context) {
 		}
 	}
 
+	// The accessor from the ACL middleware, if any, is recorded as the
+	// author of the change
+	job.UpdatedBy = c.GetString("accessor")
+
+	// The namespace of the job defaults to the namespace query parameter
+	if job.Namespace == "" {
+		job.Namespace = c.Query("namespace")
+	}
+
 	// Validate job
+	if err := job.qualify(); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Job validation failed: %s.", err))
+		return
+	}
 	if err := job.Validate(); err != nil {
 		c.AbortWithStatus(http.StatusBadRequest)
 		_, _ = c.Writer.WriteString(fmt.Sprintf("Job validation failed: %s.", err))
 		return
 	}
 
-	// Call gRPC SetJob
-	if err := h.agent.GRPCClient.SetJob(&job); err != nil {
-		s := status.Convert(err)
-
-		if s.Message() == ErrParentJobNotFound.Error() {
-			c.Status(http.StatusNotFound)
-		} else {
-			c.Status(http.StatusInternalServerError)
-		}
+	revision, err := expectedRevision(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid If-Match revision: %s.", err))
+		return
+	}
 
-		_, _ = c.Writer.WriteString(s.Message())
+	// Call gRPC SetJob
+	if err := h.agent.GRPCClient.SetJob(&job, revision); err != nil {
+		writeSetJobError(c, err)
 		return
 	}
 
 	// Immediately run the job if so requested
 	if _, exists := c.GetQuery("runoncreate"); exists {
 		go func() {
-			if _, err := h.agent.GRPCClient.RunJob(job.Name); err != nil {
+			if _, err := h.agent.GRPCClient.RunJob(job.Name, nil, TriggerManual); err != nil {
 				h.logger.WithError(err).Error("api: Unable to run job.")
 			}
 		}()
 	}
 
 	c.Header("Location", fmt.Sprintf("%s/%s", c.Request.RequestURI, job.Name))
+	c.Header("ETag", jobETag(&job))
 	renderJSON(c, http.StatusCreated, &job)
 }
 
+// jobPatchHandler applies a JSON merge patch (RFC 7386) or a JSON patch
+// (RFC 6902) to a job, depending on the content type of the request. The
+// patch is applied on the leader to the stored job.
+func (h *HTTPTransport) jobPatchHandler(c *gin.Context) {
+	jobName := c.Param("job")
+
+	var patchType string
+	switch c.ContentType() {
+	case "application/merge-patch+json", "application/json":
+		patchType = PatchTypeMerge
+	case "application/json-patch+json":
+		patchType = PatchTypeJSON
+	default:
+		c.AbortWithStatus(http.StatusUnsupportedMediaType)
+		_, _ = c.Writer.WriteString("Use application/merge-patch+json or application/json-patch+json.")
+		return
+	}
+
+	revision, err := expectedRevision(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid If-Match revision: %s.", err))
+		return
+	}
+
+	patch, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to read payload: %s.", err))
+		return
+	}
+
+	if _, err := h.agent.Store.GetJob(c.Request.Context(), jobName, nil); err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	// Call gRPC PatchJob
+	job, err := h.agent.GRPCClient.PatchJob(jobName, patchType, patch, revision, c.GetString("accessor"))
+	if err != nil {
+		writeSetJobError(c, err)
+		return
+	}
+
+	c.Header("ETag", jobETag(job))
+	renderJSON(c, http.StatusOK, job)
+}
+
+// writeSetJobError writes the response of a failed SetJob call.
+func writeSetJobError(c *gin.Context, err error) {
+	s := status.Convert(err)
+
+	if s.Message() == ErrParentJobNotFound.Error() || s.Message() == ErrCalendarNotFound.Error() ||
+		s.Message() == ErrJobTemplateNotFound.Error() || s.Message() == buntdb.ErrNotFound.Error() {
+		c.Status(http.StatusNotFound)
+	} else if strings.HasPrefix(s.Message(), ErrInvalidPatch.Error()) {
+		c.Status(http.StatusBadRequest)
+	} else if s.Message() == ErrDependencyCycle.Error() || s.Message() == ErrIndependentParents.Error() {
+		c.Status(http.StatusUnprocessableEntity)
+	} else if s.Message() == ErrRevisionMismatch.Error() {
+		c.Status(http.StatusConflict)
+	} else if strings.HasPrefix(s.Message(), ErrSubmissionsPaused.Error()) {
+		c.Status(http.StatusServiceUnavailable)
+	} else if s.Message() == ErrNamespaceNotFound.Error() {
+		c.Status(http.StatusNotFound)
+	} else if s.Message() == ErrNamespaceMaxJobs.Error() {
+		c.Status(http.StatusForbidden)
+	} else if s.Message() == ErrNamespaceMismatch.Error() || s.Message() == ErrCrossNamespaceParent.Error() {
+		c.Status(http.StatusBadRequest)
+	} else {
+		c.Status(http.StatusInternalServerError)
+	}
+
+	_, _ = c.Writer.WriteString(s.Message())
+}
+
 func (h *HTTPTransport) jobDeleteHandler(c *gin.Context) {
 	jobName := c.Param("job")
 
@@ -304,8 +488,28 @@ func (h *HTTPTransport) jobDeleteHandler(c *gin.Context) {
 func (h *HTTPTransport) jobRunHandler(c *gin.Context) {
 	jobName := c.Param("job")
 
+	// The request body is optional and carries the run parameters
+	var req jobRunRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&req); err != nil {
+			_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+			return
+		}
+	}
+
+	j, err := h.agent.Store.GetJob(c.Request.Context(), jobName, nil)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+	if _, err := j.resolveParameters(req.Parameters); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(err.Error())
+		return
+	}
+
 	// Call gRPC RunJob
-	job, err := h.agent.GRPCClient.RunJob(jobName)
+	job, err := h.agent.GRPCClient.RunJob(jobName, req.Parameters, TriggerManual)
 	if err != nil {
 		_ = c.AbortWithError(http.StatusNotFound, err)
 		return
@@ -354,6 +558,23 @@ func (h *HTTPTransport) restoreHandler(c *gin.Context) {
 	renderJSON(c, http.StatusOK, string(resp))
 }
 
+// jobRunRequest is the optional body of a job run request.
+type jobRunRequest struct {
+	Parameters map[string]string `json:"parameters"`
+}
+
+type pauseRequest struct {
+	Reason    string             `json:"reason"`
+	Actor     string             `json:"actor"`
+	Selector  map[string]string  `json:"selector"`
+	ExpiresAt ntime.NullableTime `json:"expires_at"`
+	TTL       string             `json:"ttl"`
+}
+
+type unpauseRequest struct {
+	ID string `json:"id"`
+}
+
 type apiExecution struct {
 	*Execution
 	OutputTruncated bool `json:"output_truncated"`
@@ -448,6 +669,33 @@ func (h *HTTPTransport) executionHandler(c *gin.Context) {
 	renderJSON(c, http.StatusOK, execution)
 }
 
+func (h *HTTPTransport) executionCancelHandler(c *gin.Context) {
+	jobName := c.Param("job")
+	executionName := c.Param("execution")
+
+	// Call gRPC CancelExecution
+	execution, err := h.agent.GRPCClient.CancelExecution(string(h.agent.raft.Leader()), jobName, executionName)
+	if err != nil {
+		s := status.Convert(err)
+
+		switch s.Message() {
+		case ErrExecutionNotFound.Error():
+			c.Status(http.StatusNotFound)
+		case ErrExecutionNotRunning.Error():
+			c.Status(http.StatusConflict)
+		case ErrExecutionNotCancellable.Error():
+			c.Status(http.StatusNotImplemented)
+		default:
+			c.Status(http.StatusInternalServerError)
+		}
+
+		_, _ = c.Writer.WriteString(s.Message())
+		return
+	}
+
+	renderJSON(c, http.StatusAccepted, execution)
+}
+
 func (h *HTTPTransport) membersHandler(c *gin.Context) {
 	mems := []*typesv1.Member{}
 	for _, m := range h.agent.serf.Members() {
@@ -501,14 +749,16 @@ func (h *HTTPTransport) jobToggleHandler(c *gin.Context) {
 
 	// Toggle job status
 	job.Disabled = !job.Disabled
+	job.UpdatedBy = c.GetString("accessor")
 
 	// Call gRPC SetJob
-	if err := h.agent.GRPCClient.SetJob(job); err != nil {
+	if err := h.agent.GRPCClient.SetJob(job, 0); err != nil {
 		_ = c.AbortWithError(http.StatusUnprocessableEntity, err)
 		return
 	}
 
 	c.Header("Location", c.Request.RequestURI)
+	c.Header("ETag", jobETag(job))
 	renderJSON(c, http.StatusOK, job)
 }
 
@@ -533,17 +783,818 @@ func (h *HTTPTransport) busyHandler(c *gin.Context) {
 	renderJSON(c, http.StatusOK, executions)
 }
 
-func (h *HTTPTransport) pauseHandler(c *gin.Context) {
-	h.agent.PauseNewJobs()
-	renderJSON(c, http.StatusOK, gin.H{"paused": true})
+// retriesHandler lists the pending retries of a job, or of all jobs.
+func (h *HTTPTransport) retriesHandler(c *gin.Context) {
+	jobName := c.Param("job")
+
+	if jobName != "" {
+		if _, err := h.agent.Store.GetJob(c.Request.Context(), jobName, nil); err != nil {
+			_ = c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+	}
+
+	retries, err := h.agent.Store.GetPendingRetries(c.Request.Context(), jobName)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(retries)))
+	renderJSON(c, http.StatusOK, retries)
 }
 
-func (h *HTTPTransport) unpauseHandler(c *gin.Context) {
-	h.agent.UnpauseNewJobs()
-	renderJSON(c, http.StatusOK, gin.H{"paused": false})
+// scheduleResponse is the preview of the next fire times of a schedule.
+type scheduleResponse struct {
+	Schedule string      `json:"schedule"`
+	Timezone string      `json:"timezone,omitempty"`
+	Calendar string      `json:"calendar,omitempty"`
+	Next     []time.Time `json:"next"`
 }
 
-func (h *HTTPTransport) pauseStatusHandler(c *gin.Context) {
-	paused := h.agent.IsNewJobsPaused()
-	renderJSON(c, http.StatusOK, gin.H{"paused": paused})
+// scheduleValidateRequest is the body of a schedule validation request. The
+// job name is only used to resolve the hash symbol of the schedule.
+type scheduleValidateRequest struct {
+	Schedule       string `json:"schedule"`
+	Timezone       string `json:"timezone"`
+	Calendar       string `json:"calendar"`
+	CalendarPolicy string `json:"calendar_policy"`
+	JobName        string `json:"job_name"`
+	Count          int    `json:"count"`
+}
+
+// scheduleValidateResponse is the result of a schedule validation, with the
+// next fire times when the schedule is valid.
+type scheduleValidateResponse struct {
+	Valid bool        `json:"valid"`
+	Error string      `json:"error,omitempty"`
+	Next  []time.Time `json:"next,omitempty"`
+}
+
+// previewCount returns the number of fire times asked to preview.
+func previewCount(count int) int {
+	if count <= 0 {
+		return defaultSchedulePreview
+	}
+	if count > maxSchedulePreview {
+		return maxSchedulePreview
+	}
+	return count
+}
+
+func (h *HTTPTransport) jobScheduleHandler(c *gin.Context) {
+	jobName := c.Param("job")
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "0"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid count: %s.", err))
+		return
+	}
+
+	job, err := h.agent.Store.GetJob(c.Request.Context(), jobName, nil)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	cal, err := jobCalendar(c.Request.Context(), h.agent.Store, job)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	next, err := job.nextRuns(cal, time.Now(), time.Time{}, previewCount(count))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if next == nil {
+		next = []time.Time{}
+	}
+
+	renderJSON(c, http.StatusOK, &scheduleResponse{
+		Schedule: job.Schedule,
+		Timezone: job.Timezone,
+		Calendar: job.Calendar,
+		Next:     next,
+	})
+}
+
+// jobVersionsHandler lists the recorded versions of a job, oldest first.
+func (h *HTTPTransport) jobVersionsHandler(c *gin.Context) {
+	jobName := c.Param("job")
+
+	if _, err := h.agent.Store.GetJob(c.Request.Context(), jobName, nil); err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	versions, err := h.agent.Store.GetJobVersions(c.Request.Context(), jobName)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(versions)))
+	renderJSON(c, http.StatusOK, versions)
+}
+
+func (h *HTTPTransport) jobVersionHandler(c *gin.Context) {
+	version, err := strconv.ParseInt(c.Param("version"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid version: %s.", err))
+		return
+	}
+
+	v, err := h.agent.Store.GetJobVersion(c.Request.Context(), c.Param("job"), version)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, v)
+}
+
+// jobRollbackHandler sets the job back to the definition of one of its
+// versions, the rollback is recorded as a new version.
+func (h *HTTPTransport) jobRollbackHandler(c *gin.Context) {
+	version, err := strconv.ParseInt(c.Param("version"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid version: %s.", err))
+		return
+	}
+
+	revision, err := expectedRevision(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid If-Match revision: %s.", err))
+		return
+	}
+
+	v, err := h.agent.Store.GetJobVersion(c.Request.Context(), c.Param("job"), version)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	job := v.Job
+	job.UpdatedBy = c.GetString("accessor")
+
+	// Call gRPC SetJob
+	if err := h.agent.GRPCClient.SetJob(job, revision); err != nil {
+		writeSetJobError(c, err)
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/%s/jobs/%s", apiPathPrefix, job.Name))
+	c.Header("ETag", jobETag(job))
+	renderJSON(c, http.StatusOK, job)
+}
+
+// jobDiffResponse is the list of fields changed between two versions of a job.
+type jobDiffResponse struct {
+	From    int64       `json:"from"`
+	To      int64       `json:"to"`
+	Changes []JobChange `json:"changes"`
+}
+
+// jobDiffHandler returns the changes between two versions of a job. The
+// versions default to the current one and the one before it.
+func (h *HTTPTransport) jobDiffHandler(c *gin.Context) {
+	jobName := c.Param("job")
+
+	job, err := h.agent.Store.GetJob(c.Request.Context(), jobName, nil)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	to, err := strconv.ParseInt(c.DefaultQuery("to", strconv.FormatInt(job.Version, 10)), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid to version: %s.", err))
+		return
+	}
+	from, err := strconv.ParseInt(c.DefaultQuery("from", strconv.FormatInt(to-1, 10)), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid from version: %s.", err))
+		return
+	}
+
+	fromVersion, err := h.agent.Store.GetJobVersion(c.Request.Context(), jobName, from)
+	if err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Version %d not found.", from))
+		return
+	}
+	toVersion, err := h.agent.Store.GetJobVersion(c.Request.Context(), jobName, to)
+	if err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Version %d not found.", to))
+		return
+	}
+
+	changes, err := diffJobs(fromVersion.Job, toVersion.Job)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	renderJSON(c, http.StatusOK, &jobDiffResponse{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	})
+}
+
+func (h *HTTPTransport) scheduleValidateHandler(c *gin.Context) {
+	var req scheduleValidateRequest
+	if err := c.BindJSON(&req); err != nil {
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		return
+	}
+
+	job := &Job{
+		Name:           req.JobName,
+		Schedule:       req.Schedule,
+		Timezone:       req.Timezone,
+		Calendar:       req.Calendar,
+		CalendarPolicy: req.CalendarPolicy,
+	}
+
+	resp := &scheduleValidateResponse{}
+	cal, calErr := jobCalendar(c.Request.Context(), h.agent.Store, job)
+	if req.Schedule == "" {
+		resp.Error = ErrScheduleParse.Error() + ": empty schedule"
+	} else if _, err := extcron.Parse(job.scheduleHash()); err != nil {
+		resp.Error = fmt.Sprintf("%s: %s", ErrScheduleParse.Error(), err)
+	} else if calErr != nil {
+		resp.Error = calErr.Error()
+	} else if next, err := job.nextRuns(cal, time.Now(), time.Time{}, previewCount(req.Count)); err != nil {
+		resp.Error = err.Error()
+	} else {
+		resp.Valid = true
+		resp.Next = next
+	}
+
+	renderJSON(c, http.StatusOK, resp)
+}
+
+func (h *HTTPTransport) forecastHandler(c *gin.Context) {
+	from := time.Now()
+	if v, ok := c.GetQuery("from"); ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid from time: %s.", err))
+			return
+		}
+		from = t
+	}
+
+	to := from.Add(time.Hour)
+	if v, ok := c.GetQuery("to"); ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid to time: %s.", err))
+			return
+		}
+		to = t
+	}
+
+	if !to.After(from) || to.Sub(from) > maxForecastWindow {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid time window, to must be after from and the window up to %s.", maxForecastWindow))
+		return
+	}
+
+	jobs, err := h.agent.Store.GetJobs(c.Request.Context(), nil)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	calendars, err := h.agent.Store.GetCalendars(c.Request.Context())
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	byName := make(map[string]*Calendar, len(calendars))
+	for _, cal := range calendars {
+		byName[cal.Name] = cal
+	}
+
+	f, err := forecast(jobs, byName, from, to)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	renderJSON(c, http.StatusOK, f)
+}
+
+func (h *HTTPTransport) calendarsHandler(c *gin.Context) {
+	calendars, err := h.agent.Store.GetCalendars(c.Request.Context())
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(calendars)))
+	renderJSON(c, http.StatusOK, calendars)
+}
+
+func (h *HTTPTransport) calendarGetHandler(c *gin.Context) {
+	calendar, err := h.agent.Store.GetCalendar(c.Request.Context(), c.Param("calendar"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, calendar)
+}
+
+func (h *HTTPTransport) calendarCreateOrUpdateHandler(c *gin.Context) {
+	var calendar Calendar
+	if err := c.BindJSON(&calendar); err != nil {
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		return
+	}
+	if name := c.Param("calendar"); name != "" {
+		calendar.Name = name
+	}
+
+	if err := calendar.Validate(); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Calendar validation failed: %s.", err))
+		return
+	}
+
+	// Call gRPC SetCalendar
+	if err := h.agent.GRPCClient.SetCalendar(&calendar); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_, _ = c.Writer.WriteString(status.Convert(err).Message())
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/%s/calendars/%s", apiPathPrefix, calendar.Name))
+	renderJSON(c, http.StatusCreated, &calendar)
+}
+
+func (h *HTTPTransport) calendarDeleteHandler(c *gin.Context) {
+	// Call gRPC DeleteCalendar
+	calendar, err := h.agent.GRPCClient.DeleteCalendar(c.Param("calendar"))
+	if err != nil {
+		s := status.Convert(err)
+		switch s.Message() {
+		case ErrCalendarInUse.Error():
+			c.Status(http.StatusConflict)
+		case buntdb.ErrNotFound.Error():
+			c.Status(http.StatusNotFound)
+		default:
+			c.Status(http.StatusInternalServerError)
+		}
+		_, _ = c.Writer.WriteString(s.Message())
+		return
+	}
+	renderJSON(c, http.StatusOK, calendar)
+}
+
+func (h *HTTPTransport) templatesHandler(c *gin.Context) {
+	templates, err := h.agent.Store.GetJobTemplates(c.Request.Context())
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(templates)))
+	renderJSON(c, http.StatusOK, templates)
+}
+
+func (h *HTTPTransport) templateGetHandler(c *gin.Context) {
+	template, err := h.agent.Store.GetJobTemplate(c.Request.Context(), c.Param("template"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, template)
+}
+
+func (h *HTTPTransport) templateCreateOrUpdateHandler(c *gin.Context) {
+	var template JobTemplate
+	if err := c.BindJSON(&template); err != nil {
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		return
+	}
+	if name := c.Param("template"); name != "" {
+		template.Name = name
+	}
+
+	if err := template.Validate(); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Job template validation failed: %s.", err))
+		return
+	}
+
+	// Call gRPC SetJobTemplate
+	if err := h.agent.GRPCClient.SetJobTemplate(&template); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_, _ = c.Writer.WriteString(status.Convert(err).Message())
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/%s/templates/%s", apiPathPrefix, template.Name))
+	renderJSON(c, http.StatusCreated, &template)
+}
+
+func (h *HTTPTransport) templateDeleteHandler(c *gin.Context) {
+	// Call gRPC DeleteJobTemplate
+	template, err := h.agent.GRPCClient.DeleteJobTemplate(c.Param("template"))
+	if err != nil {
+		s := status.Convert(err)
+		switch s.Message() {
+		case ErrJobTemplateInUse.Error():
+			c.Status(http.StatusConflict)
+		case buntdb.ErrNotFound.Error():
+			c.Status(http.StatusNotFound)
+		default:
+			c.Status(http.StatusInternalServerError)
+		}
+		_, _ = c.Writer.WriteString(s.Message())
+		return
+	}
+	renderJSON(c, http.StatusOK, template)
+}
+
+func (h *HTTPTransport) namespacesHandler(c *gin.Context) {
+	namespaces, err := h.agent.Store.GetNamespaces(c.Request.Context())
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(namespaces)))
+	renderJSON(c, http.StatusOK, namespaces)
+}
+
+func (h *HTTPTransport) namespaceGetHandler(c *gin.Context) {
+	namespace, err := h.agent.Store.GetNamespace(c.Request.Context(), c.Param("namespace"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, namespace)
+}
+
+func (h *HTTPTransport) namespaceCreateOrUpdateHandler(c *gin.Context) {
+	var namespace Namespace
+	if err := c.BindJSON(&namespace); err != nil {
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		return
+	}
+	if name := c.Param("namespace"); name != "" {
+		namespace.Name = name
+	}
+
+	if err := namespace.Validate(); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Namespace validation failed: %s.", err))
+		return
+	}
+
+	// Call gRPC SetNamespace
+	if err := h.agent.GRPCClient.SetNamespace(&namespace); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_, _ = c.Writer.WriteString(status.Convert(err).Message())
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/%s/namespaces/%s", apiPathPrefix, namespace.Name))
+	renderJSON(c, http.StatusCreated, &namespace)
+}
+
+func (h *HTTPTransport) namespaceDeleteHandler(c *gin.Context) {
+	// Call gRPC DeleteNamespace
+	namespace, err := h.agent.GRPCClient.DeleteNamespace(c.Param("namespace"))
+	if err != nil {
+		s := status.Convert(err)
+		switch s.Message() {
+		case ErrNamespaceInUse.Error():
+			c.Status(http.StatusConflict)
+		case buntdb.ErrNotFound.Error():
+			c.Status(http.StatusNotFound)
+		default:
+			c.Status(http.StatusInternalServerError)
+		}
+		_, _ = c.Writer.WriteString(s.Message())
+		return
+	}
+	renderJSON(c, http.StatusOK, namespace)
+}
+
+func (h *HTTPTransport) windowsHandler(c *gin.Context) {
+	windows, err := h.agent.Store.GetMaintenanceWindows(c.Request.Context())
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(windows)))
+	renderJSON(c, http.StatusOK, windows)
+}
+
+func (h *HTTPTransport) windowGetHandler(c *gin.Context) {
+	window, err := h.agent.Store.GetMaintenanceWindow(c.Request.Context(), c.Param("window"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, window)
+}
+
+func (h *HTTPTransport) windowCreateOrUpdateHandler(c *gin.Context) {
+	var window MaintenanceWindow
+	if err := c.BindJSON(&window); err != nil {
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		return
+	}
+	if name := c.Param("window"); name != "" {
+		window.Name = name
+	}
+
+	if err := window.Validate(); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Maintenance window validation failed: %s.", err))
+		return
+	}
+
+	// Call gRPC SetMaintenanceWindow
+	if err := h.agent.GRPCClient.SetMaintenanceWindow(&window); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_, _ = c.Writer.WriteString(status.Convert(err).Message())
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/%s/maintenance-windows/%s", apiPathPrefix, window.Name))
+	renderJSON(c, http.StatusCreated, &window)
+}
+
+func (h *HTTPTransport) windowDeleteHandler(c *gin.Context) {
+	// Call gRPC DeleteMaintenanceWindow
+	window, err := h.agent.GRPCClient.DeleteMaintenanceWindow(c.Param("window"))
+	if err != nil {
+		s := status.Convert(err)
+		if s.Message() == buntdb.ErrNotFound.Error() {
+			c.Status(http.StatusNotFound)
+		} else {
+			c.Status(http.StatusInternalServerError)
+		}
+		_, _ = c.Writer.WriteString(s.Message())
+		return
+	}
+	renderJSON(c, http.StatusOK, window)
+}
+
+// suppressionsHandler lists the runs of a job suppressed by maintenance
+// windows, or of all jobs.
+func (h *HTTPTransport) suppressionsHandler(c *gin.Context) {
+	jobName := c.Param("job")
+
+	if jobName != "" {
+		if _, err := h.agent.Store.GetJob(c.Request.Context(), jobName, nil); err != nil {
+			_ = c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+	}
+
+	suppressions, err := h.agent.Store.GetSuppressions(c.Request.Context(), jobName)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(suppressions)))
+	renderJSON(c, http.StatusOK, suppressions)
+}
+
+func (h *HTTPTransport) pauseHandler(c *gin.Context) {
+	// The request body is optional, without it all the jobs are paused
+	var req pauseRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&req); err != nil {
+			_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+			return
+		}
+	}
+
+	pause := &Pause{
+		Reason:    req.Reason,
+		Actor:     req.Actor,
+		Selector:  req.Selector,
+		CreatedAt: time.Now(),
+		ExpiresAt: req.ExpiresAt,
+	}
+	if req.TTL != "" {
+		ttl, err := time.ParseDuration(req.TTL)
+		if err != nil || ttl <= 0 {
+			c.AbortWithStatus(http.StatusBadRequest)
+			_, _ = c.Writer.WriteString(fmt.Sprintf("Invalid ttl %q.", req.TTL))
+			return
+		}
+		pause.ExpiresAt.Set(pause.CreatedAt.Add(ttl))
+	}
+	if pause.ExpiresAt.HasValue() && !pause.ExpiresAt.Get().After(pause.CreatedAt) {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString("The pause expiry must be in the future.")
+		return
+	}
+
+	// Get the actor from the context, if it exists
+	// this is coming from the ACL middleware
+	if accessor := c.GetString("accessor"); accessor != "" {
+		pause.Actor = accessor
+	}
+
+	// Call gRPC SetPause
+	if err := h.agent.PauseJobs(pause); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_, _ = c.Writer.WriteString(status.Convert(err).Message())
+		return
+	}
+
+	renderJSON(c, http.StatusOK, gin.H{"paused": true, "pause": pause})
+}
+
+func (h *HTTPTransport) unpauseHandler(c *gin.Context) {
+	// The request body is optional, without it all the pauses are lifted
+	var req unpauseRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&req); err != nil {
+			_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+			return
+		}
+	}
+
+	// Call gRPC DeletePause
+	lifted, err := h.agent.UnpauseJobs(req.ID)
+	if err != nil {
+		s := status.Convert(err)
+		if s.Message() == buntdb.ErrNotFound.Error() {
+			c.Status(http.StatusNotFound)
+		} else if s.Message() == ErrWrongPauseID.Error() {
+			c.Status(http.StatusBadRequest)
+		} else {
+			c.Status(http.StatusInternalServerError)
+		}
+		_, _ = c.Writer.WriteString(s.Message())
+		return
+	}
+
+	renderJSON(c, http.StatusOK, gin.H{"paused": h.agent.IsNewJobsPaused(), "lifted": lifted})
+}
+
+func (h *HTTPTransport) pauseStatusHandler(c *gin.Context) {
+	pauses, err := h.agent.Store.GetPauses(c.Request.Context())
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	renderJSON(c, http.StatusOK, gin.H{"paused": h.agent.IsNewJobsPaused(), "pauses": pauses})
+}
+
+func (h *HTTPTransport) hooksHandler(c *gin.Context) {
+	triggers, err := h.agent.Store.GetWebhookTriggers(c.Request.Context(), c.Param("job"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	redacted := make([]*WebhookTrigger, 0, len(triggers))
+	for _, t := range triggers {
+		redacted = append(redacted, t.redacted())
+	}
+
+	c.Header("X-Total-Count", strconv.Itoa(len(redacted)))
+	renderJSON(c, http.StatusOK, redacted)
+}
+
+func (h *HTTPTransport) hookGetHandler(c *gin.Context) {
+	trigger, err := h.agent.Store.GetWebhookTrigger(c.Request.Context(), c.Param("trigger"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, trigger.redacted())
+}
+
+func (h *HTTPTransport) hookCreateOrUpdateHandler(c *gin.Context) {
+	var trigger WebhookTrigger
+	if err := c.BindJSON(&trigger); err != nil {
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		return
+	}
+	if id := c.Param("trigger"); id != "" {
+		trigger.ID = id
+	}
+
+	// Generate the id and the secret when not given, the secret is only
+	// returned in this response
+	if trigger.ID == "" {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		trigger.ID = id
+	}
+	if trigger.Secret == "" {
+		secret, err := uuid.GenerateUUID()
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		trigger.Secret = secret
+	}
+
+	if err := trigger.Validate(); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Webhook trigger validation failed: %s.", err))
+		return
+	}
+	if _, err := h.agent.Store.GetJob(c.Request.Context(), trigger.JobName, nil); err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		_, _ = c.Writer.WriteString(fmt.Sprintf("Job %s not found.", trigger.JobName))
+		return
+	}
+
+	// Call gRPC SetWebhookTrigger
+	if err := h.agent.GRPCClient.SetWebhookTrigger(&trigger); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_, _ = c.Writer.WriteString(status.Convert(err).Message())
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/%s/hooks/%s", apiPathPrefix, trigger.ID))
+	renderJSON(c, http.StatusCreated, &trigger)
+}
+
+func (h *HTTPTransport) hookDeleteHandler(c *gin.Context) {
+	// Call gRPC DeleteWebhookTrigger
+	trigger, err := h.agent.GRPCClient.DeleteWebhookTrigger(c.Param("trigger"))
+	if err != nil {
+		s := status.Convert(err)
+		if s.Message() == buntdb.ErrNotFound.Error() {
+			c.Status(http.StatusNotFound)
+		} else {
+			c.Status(http.StatusInternalServerError)
+		}
+		_, _ = c.Writer.WriteString(s.Message())
+		return
+	}
+	renderJSON(c, http.StatusOK, trigger.redacted())
+}
+
+// hookHandler runs the job of a webhook trigger when the call carries the
+// trigger secret, passing the body and the headers of the call as parameters.
+func (h *HTTPTransport) hookHandler(c *gin.Context) {
+	trigger, err := h.agent.Store.GetWebhookTrigger(c.Request.Context(), c.Param("trigger"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBody))
+	if err != nil {
+		c.AbortWithStatus(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if !trigger.authenticate(c.Request.Header, body) {
+		h.logger.WithFields(logrus.Fields{
+			"trigger": trigger.ID,
+			"remote":  c.ClientIP(),
+		}).Warn("api: Webhook call with an invalid secret")
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	// Only the parameters declared by the job are passed to the run
+	job, err := h.agent.Store.GetJob(c.Request.Context(), trigger.JobName, nil)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	// Call gRPC RunJob
+	job, err = h.agent.GRPCClient.RunJob(trigger.JobName, trigger.parameters(c.Request.Header, body, job.Parameters), TriggerWebhook)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	renderJSON(c, http.StatusAccepted, gin.H{"trigger": trigger.ID, "job": job.Name})
 }
@@ -345,6 +345,583 @@ func TestAPIJobCreateUpdateJobWithInvalidParentIsNotCreated(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestAPIJobRunParameters(t *testing.T) {
+	port := "8112"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	jsonStr := []byte(`{
+		"name": "test_job",
+		"schedule": "@manually",
+		"executor": "shell",
+		"executor_config": {"command": "echo {{.Parameters.date}} {{.Parameters.mode}}"},
+		"parameters": {
+			"date": {"required": true},
+			"mode": {"default": "full"}
+		}
+	}`)
+	resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jsonStr))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// Missing required parameter
+	resp, err = http.Post(baseURL+"/jobs/test_job/run", "application/json", nil)
+	require.NoError(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(body), ErrMissingParameter.Error())
+
+	// Unknown parameter
+	resp, err = http.Post(baseURL+"/jobs/test_job/run", "application/json",
+		bytes.NewBufferString(`{"parameters": {"date": "2024-01-31", "other": "x"}}`))
+	require.NoError(t, err)
+	body, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(body), ErrUnknownParameter.Error())
+
+	resp, err = http.Post(baseURL+"/jobs/test_job/run", "application/json",
+		bytes.NewBufferString(`{"parameters": {"date": "2024-01-31"}}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The execution records the resolved parameters
+	var executions []*Execution
+	assert.Eventually(t, func() bool {
+		resp, err := http.Get(baseURL + "/jobs/test_job/executions")
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		executions = nil
+		if err := json.NewDecoder(resp.Body).Decode(&executions); err != nil {
+			return false
+		}
+		return len(executions) > 0 && !executions[0].FinishedAt.IsZero()
+	}, 10*time.Second, 100*time.Millisecond)
+	require.NotEmpty(t, executions)
+	assert.Equal(t, map[string]string{"date": "2024-01-31", "mode": "full"}, executions[0].Parameters)
+}
+
+func TestAPIJobTemplate(t *testing.T) {
+	port := "8115"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	jobJSON := []byte(`{
+		"name": "test_job",
+		"schedule": "@manually",
+		"template": "batch",
+		"executor_config": {"command": "echo report"}
+	}`)
+
+	// Jobs need an existing template
+	resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jobJSON))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPut, baseURL+"/templates/batch", bytes.NewBufferString(`{
+		"owner_email": "platform@example.com",
+		"retries": 2,
+		"executor": "shell",
+		"executor_config": {"timeout": "1h"}
+	}`))
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, err = http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jobJSON))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// The job is returned resolved with the template
+	resp, err = http.Get(baseURL + "/jobs/test_job")
+	require.NoError(t, err)
+	var job Job
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+	resp.Body.Close()
+	assert.Equal(t, "platform@example.com", job.OwnerEmail)
+	assert.Equal(t, uint(2), job.Retries)
+	assert.Equal(t, "shell", job.Executor)
+	assert.Equal(t, "1h", job.ExecutorConfig["timeout"])
+	assert.Equal(t, "echo report", job.ExecutorConfig["command"])
+
+	resp, err = http.Get(baseURL + "/templates")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "1", resp.Header.Get("X-Total-Count"))
+
+	// Templates in use can't be deleted
+	req, err = http.NewRequest(http.MethodDelete, baseURL+"/templates/batch", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+func TestAPIJobVersions(t *testing.T) {
+	port := "8116"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	for _, command := range []string{"echo v1", "echo v2"} {
+		jobJSON := fmt.Sprintf(`{
+			"name": "test_job",
+			"schedule": "@manually",
+			"executor": "shell",
+			"executor_config": {"command": %q}
+		}`, command)
+		resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBufferString(jobJSON))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, err := http.Get(baseURL + "/jobs/test_job/versions")
+	require.NoError(t, err)
+	var versions []*JobVersion
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&versions))
+	resp.Body.Close()
+	assert.Equal(t, "2", resp.Header.Get("X-Total-Count"))
+	require.Len(t, versions, 2)
+	assert.Equal(t, "echo v1", versions[0].Job.ExecutorConfig["command"])
+
+	// The diff defaults to the last change
+	resp, err = http.Get(baseURL + "/jobs/test_job/diff")
+	require.NoError(t, err)
+	var diff jobDiffResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&diff))
+	resp.Body.Close()
+	assert.Equal(t, int64(1), diff.From)
+	assert.Equal(t, int64(2), diff.To)
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, "executor_config", diff.Changes[0].Field)
+
+	resp, err = http.Get(baseURL + "/jobs/test_job/diff?from=5")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Rolling back records a new version with the old definition
+	resp, err = http.Post(baseURL+"/jobs/test_job/versions/1/rollback", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(baseURL + "/jobs/test_job")
+	require.NoError(t, err)
+	var job Job
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+	resp.Body.Close()
+	assert.Equal(t, int64(3), job.Version)
+	assert.Equal(t, "echo v1", job.ExecutorConfig["command"])
+
+	resp, err = http.Get(baseURL + "/jobs/test_job/versions/3")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Post(baseURL+"/jobs/test_job/versions/9/rollback", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAPIJobIfMatch(t *testing.T) {
+	port := "8117"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	putJob := func(command, ifMatch string) *http.Response {
+		req, err := http.NewRequest(http.MethodPut, baseURL+"/jobs/test_job", bytes.NewBufferString(fmt.Sprintf(`{
+			"name": "test_job",
+			"schedule": "@manually",
+			"executor": "shell",
+			"executor_config": {"command": %q}
+		}`, command)))
+		require.NoError(t, err)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	getJob := func() (*http.Response, *Job) {
+		resp, err := http.Get(baseURL + "/jobs/test_job")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var job Job
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+		return resp, &job
+	}
+
+	resp := putJob("echo v1", "")
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+
+	// The revision is the raft index of the last change to the job
+	resp, job := getJob()
+	assert.Equal(t, etag, resp.Header.Get("ETag"))
+	assert.Equal(t, fmt.Sprintf(`"%d"`, job.Revision), etag)
+	assert.NotZero(t, job.Revision)
+	assert.LessOrEqual(t, job.Revision, a.raft.AppliedIndex())
+	assert.Equal(t, int64(1), job.Version)
+
+	// Updates expecting the current revision succeed and change it
+	resp = putJob("echo v2", etag)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	_, job = getJob()
+	assert.Equal(t, fmt.Sprintf(`"%d"`, job.Revision), resp.Header.Get("ETag"))
+	assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+	assert.Equal(t, int64(2), job.Version)
+
+	// Updates expecting an older revision conflict
+	resp = putJob("echo v3", etag)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp = putJob("echo v3", "not-a-revision")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	_, job = getJob()
+	assert.Equal(t, "echo v2", job.ExecutorConfig["command"])
+
+	// Updates that don't change the job keep its revision
+	etag = fmt.Sprintf(`"%d"`, job.Revision)
+	resp = putJob("echo v2", etag)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, etag, resp.Header.Get("ETag"))
+
+	// A job created again doesn't match the revisions of the deleted one
+	req, err := http.NewRequest(http.MethodDelete, baseURL+"/jobs/test_job", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = putJob("echo v1", "")
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+	_, recreated := getJob()
+	assert.Greater(t, recreated.Revision, job.Revision)
+}
+
+func TestAPIJobPatch(t *testing.T) {
+	port := "8118"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBufferString(`{
+		"name": "test_job",
+		"schedule": "@every 1m",
+		"executor": "shell",
+		"executor_config": {"command": "echo hello", "shell": "true"}
+	}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	patchJob := func(contentType, patch, ifMatch string) (*http.Response, *Job) {
+		req, err := http.NewRequest(http.MethodPatch, baseURL+"/jobs/test_job", bytes.NewBufferString(patch))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var job Job
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+		}
+		return resp, &job
+	}
+
+	// Merge patches change only the given fields
+	resp, job := patchJob("application/merge-patch+json", `{"schedule": "@every 5m", "executor_config": {"command": "echo bye"}}`, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "@every 5m", job.Schedule)
+	assert.Equal(t, "echo bye", job.ExecutorConfig["command"])
+	assert.Equal(t, "true", job.ExecutorConfig["shell"])
+	etag := resp.Header.Get("ETag")
+
+	resp, job = patchJob("application/json-patch+json", `[
+		{"op": "test", "path": "/executor_config/command", "value": "echo bye"},
+		{"op": "remove", "path": "/executor_config/shell"}
+	]`, etag)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "echo bye", job.ExecutorConfig["command"])
+	assert.NotContains(t, job.ExecutorConfig, "shell")
+
+	stored, err := a.Store.GetJob(context.Background(), "test_job", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "@every 5m", stored.Schedule)
+	assert.NotContains(t, stored.ExecutorConfig, "shell")
+
+	// Patches based on an older revision conflict
+	resp, _ = patchJob("application/merge-patch+json", `{"disabled": true}`, etag)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, _ = patchJob("application/json-patch+json", `[{"op": "test", "path": "/schedule", "value": "@daily"}]`, "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = patchJob("application/merge-patch+json", `{"name": "other_job"}`, "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = patchJob("text/plain", `schedule=@daily`, "")
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+
+	// Pauses are checked on the patched job
+	resp, err = http.Post(baseURL+"/pause", "application/json", bytes.NewBufferString(`{"selector": {"team": "billing"}}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, _ = patchJob("application/merge-patch+json", `{"metadata": {"team": "billing"}}`, "")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPatch, baseURL+"/jobs/missing_job", bytes.NewBufferString(`{}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAPINamespaces(t *testing.T) {
+	port := "8119"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	jobJSON := `{
+		"name": "test_job",
+		"schedule": "@manually",
+		"executor": "shell",
+		"executor_config": {"command": "echo hello"}
+	}`
+
+	// Jobs need an existing namespace
+	resp, err := http.Post(baseURL+"/jobs?namespace=team-a", "application/json", bytes.NewBufferString(jobJSON))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPut, baseURL+"/namespaces/team-a", bytes.NewBufferString(`{
+		"defaults": {"owner_email": "team-a@example.com"},
+		"max_jobs": 1
+	}`))
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// The same name can be used in each namespace
+	for _, url := range []string{baseURL + "/jobs", baseURL + "/jobs?namespace=team-a"} {
+		resp, err = http.Post(url, "application/json", bytes.NewBufferString(jobJSON))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, err = http.Get(baseURL + "/jobs/test_job?namespace=team-a")
+	require.NoError(t, err)
+	var job Job
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+	resp.Body.Close()
+	assert.Equal(t, "team-a/test_job", job.Name)
+	assert.Equal(t, "team-a", job.Namespace)
+	assert.Equal(t, "team-a@example.com", job.OwnerEmail)
+
+	resp, err = http.Get(baseURL + "/jobs/test_job")
+	require.NoError(t, err)
+	job = Job{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+	resp.Body.Close()
+	assert.Equal(t, "test_job", job.Name)
+	assert.Equal(t, DefaultNamespace, job.Namespace)
+
+	resp, err = http.Get(baseURL + "/jobs?namespace=team-a")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "1", resp.Header.Get("X-Total-Count"))
+
+	resp, err = http.Get(baseURL + "/jobs")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "2", resp.Header.Get("X-Total-Count"))
+
+	// The namespace has reached its max jobs
+	resp, err = http.Post(baseURL+"/jobs?namespace=team-a", "application/json", bytes.NewBufferString(`{
+		"name": "other_job",
+		"schedule": "@manually",
+		"executor": "shell",
+		"executor_config": {"command": "echo hello"}
+	}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// Namespaces with jobs can't be deleted
+	req, err = http.NewRequest(http.MethodDelete, baseURL+"/namespaces/team-a", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodDelete, baseURL+"/jobs/test_job?namespace=team-a", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodDelete, baseURL+"/namespaces/team-a", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAPIWebhookTrigger(t *testing.T) {
+	port := "8114"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	jsonStr := []byte(`{
+		"name": "test_job",
+		"schedule": "@manually",
+		"executor": "shell",
+		"executor_config": {"command": "echo {{.Parameters.webhook_body}}"},
+		"parameters": {
+			"mode": {"default": "full"},
+			"webhook_body": {},
+			"webhook_header_x_github_event": {}
+		}
+	}`)
+	resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jsonStr))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// Triggers need an existing job
+	resp, err = http.Post(baseURL+"/hooks", "application/json", bytes.NewBufferString(`{"job_name": "missing"}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// The secret is generated and only returned on creation
+	resp, err = http.Post(baseURL+"/hooks", "application/json", bytes.NewBufferString(`{"id": "deploy", "job_name": "test_job"}`))
+	require.NoError(t, err)
+	var trigger WebhookTrigger
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&trigger))
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.NotEmpty(t, trigger.Secret)
+
+	resp, err = http.Get(baseURL + "/jobs/test_job/hooks")
+	require.NoError(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "1", resp.Header.Get("X-Total-Count"))
+	assert.Contains(t, string(body), `"deploy"`)
+	assert.NotContains(t, string(body), trigger.Secret)
+
+	call := func(secret string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, baseURL+"/hooks/deploy", bytes.NewBufferString("payload"))
+		require.NoError(t, err)
+		req.Header.Set("X-Github-Event", "push")
+		if secret != "" {
+			req.Header.Set("X-Dkron-Webhook-Token", secret)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, call("").StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, call("wrong").StatusCode)
+	assert.Equal(t, http.StatusAccepted, call(trigger.Secret).StatusCode)
+
+	// The execution gets the body and the headers of the call
+	var executions []*Execution
+	assert.Eventually(t, func() bool {
+		resp, err := http.Get(baseURL + "/jobs/test_job/executions")
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		executions = nil
+		if err := json.NewDecoder(resp.Body).Decode(&executions); err != nil {
+			return false
+		}
+		return len(executions) > 0 && !executions[0].FinishedAt.IsZero()
+	}, 10*time.Second, 100*time.Millisecond)
+	require.NotEmpty(t, executions)
+	assert.Equal(t, TriggerWebhook, executions[0].Trigger)
+	assert.Equal(t, "payload", executions[0].Parameters["webhook_body"])
+	assert.Equal(t, "push", executions[0].Parameters["webhook_header_x_github_event"])
+	assert.Equal(t, "full", executions[0].Parameters["mode"])
+	assert.NotContains(t, executions[0].Parameters, "webhook_header_x_dkron_webhook_token")
+	assert.NotContains(t, executions[0].Parameters, "webhook_header_user_agent")
+
+	req, err := http.NewRequest(http.MethodDelete, baseURL+"/hooks/deploy", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, http.StatusNotFound, call(trigger.Secret).StatusCode)
+
+	// The triggers are deleted with their job
+	resp, err = http.Post(baseURL+"/hooks", "application/json", bytes.NewBufferString(`{"id": "deploy", "job_name": "test_job"}`))
+	require.NoError(t, err)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&trigger))
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodDelete, baseURL+"/jobs/test_job", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jsonStr))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, http.StatusNotFound, call(trigger.Secret).StatusCode)
+}
+
 func TestAPIJobRestore(t *testing.T) {
 	port := "8109"
 	baseURL := fmt.Sprintf("http://localhost:%s/v1/restore", port)
@@ -477,6 +1054,113 @@ func TestAPIJobOutputTruncate(t *testing.T) {
 	assert.Equal(t, 1705, len(execution.Output))
 }
 
+func TestAPIJobSchedulePreview(t *testing.T) {
+	port := "8113"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	jsonStr := []byte(`{
+		"name": "test_job",
+		"schedule": "0 0 * * * *",
+		"timezone": "Europe/Berlin",
+		"executor": "shell",
+		"executor_config": {"command": "true"}
+	}`)
+	resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jsonStr))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, err = http.Get(baseURL + "/jobs/test_job/schedule?count=3")
+	require.NoError(t, err)
+	var preview scheduleResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&preview))
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, preview.Next, 3)
+	assert.Equal(t, time.Hour, preview.Next[1].Sub(preview.Next[0]))
+
+	resp, err = http.Get(baseURL + "/jobs/missing/schedule")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Validate arbitrary schedules
+	resp, err = http.Post(baseURL+"/schedule/validate", "application/json",
+		bytes.NewBufferString(`{"schedule": "@every 1m", "count": 2}`))
+	require.NoError(t, err)
+	var valid scheduleValidateResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&valid))
+	resp.Body.Close()
+	assert.True(t, valid.Valid)
+	assert.Len(t, valid.Next, 2)
+
+	resp, err = http.Post(baseURL+"/schedule/validate", "application/json",
+		bytes.NewBufferString(`{"schedule": "61 * * * * *"}`))
+	require.NoError(t, err)
+	valid = scheduleValidateResponse{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&valid))
+	resp.Body.Close()
+	assert.False(t, valid.Valid)
+	assert.Contains(t, valid.Error, ErrScheduleParse.Error())
+
+	// Forecast the cluster executions
+	from := time.Now().Truncate(time.Hour).Add(time.Hour)
+	resp, err = http.Get(fmt.Sprintf("%s/forecast?from=%s&to=%s", baseURL,
+		from.Add(-time.Second).UTC().Format(time.RFC3339), from.Add(time.Second).UTC().Format(time.RFC3339)))
+	require.NoError(t, err)
+	var f Forecast
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&f))
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, f.Total)
+	require.Len(t, f.Minutes, 1)
+	assert.Equal(t, []string{"test_job"}, f.Minutes[0].Jobs)
+
+	resp, err = http.Get(baseURL + "/forecast?from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestAPIJobRRule(t *testing.T) {
+	port := "8120"
+	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
+	dir, a := setupAPITest(t, port)
+	defer os.RemoveAll(dir)
+	defer a.Stop() // nolint: errcheck
+
+	// Recurrence rules don't need a start date
+	jsonStr := []byte(`{
+		"name": "test_job",
+		"schedule": "@rrule FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=18",
+		"timezone": "Europe/Berlin",
+		"executor": "shell",
+		"executor_config": {"command": "true"}
+	}`)
+	resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jsonStr))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, err = http.Get(baseURL + "/jobs/test_job")
+	require.NoError(t, err)
+	var job Job
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+	resp.Body.Close()
+	assert.False(t, job.CreatedAt.IsZero())
+
+	// The next run is the last Friday of the month at 18:00
+	require.False(t, job.Next.IsZero())
+	next := job.Next.In(job.GetTimeLocation())
+	assert.True(t, next.After(time.Now()))
+	assert.Equal(t, time.Friday, next.Weekday())
+	assert.Equal(t, "18:00:00", next.Format(time.TimeOnly))
+	assert.NotEqual(t, next.Month(), next.AddDate(0, 0, 7).Month())
+}
+
 // postJob POSTs the given json to the jobs endpoint and returns the response
 func postJob(t *testing.T, port string, jsonStr []byte) *http.Response {
 	baseURL := fmt.Sprintf("http://localhost:%s/v1", port)
@@ -607,4 +1291,112 @@ func TestAPIPauseUnpause(t *testing.T) {
 	require.NoError(t, err)
 	resp.Body.Close()
 	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// Rollbacks are job changes too
+	resp, err = http.Post(baseURL+"/pause", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	resp, err = http.Post(baseURL+"/jobs/test_job_paused/versions/1/rollback", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	resp, err = http.Post(baseURL+"/unpause", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// Pause only the jobs matching a selector
+	resp, err = http.Post(baseURL+"/pause", "application/json", bytes.NewBufferString(`{
+		"reason": "billing incident",
+		"actor": "oncall",
+		"selector": {"team": "billing"},
+		"ttl": "1h"
+	}`))
+	require.NoError(t, err)
+	var paused struct {
+		Pause Pause `json:"pause"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&paused))
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "oncall", paused.Pause.Actor)
+	assert.True(t, paused.Pause.ExpiresAt.HasValue())
+
+	// The cluster isn't paused as a whole
+	resp, err = http.Get(baseURL + "/pause")
+	require.NoError(t, err)
+	body, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), `"paused":false`)
+	assert.Contains(t, string(body), "billing incident")
+
+	resp, err = http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(jsonStr))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	billingJob := []byte(`{
+		"name": "test_job_billing",
+		"schedule": "@every 1m",
+		"executor": "shell",
+		"executor_config": {"command": "date"},
+		"metadata": {"team": "billing"}
+	}`)
+	resp, err = http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(billingJob))
+	require.NoError(t, err)
+	body, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Contains(t, string(body), "billing incident")
+
+	// The metadata inherited from the job template matches too
+	resp, err = http.Post(baseURL+"/templates", "application/json", bytes.NewBufferString(`{
+		"name": "billing",
+		"metadata": {"team": "billing"}
+	}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, err = http.Post(baseURL+"/jobs", "application/json", bytes.NewBufferString(`{
+		"name": "test_job_template",
+		"schedule": "@every 1m",
+		"executor": "shell",
+		"executor_config": {"command": "date"},
+		"template": "billing"
+	}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	// Patches are checked once applied
+	req, err := http.NewRequest(http.MethodPatch, baseURL+"/jobs/test_job_paused", bytes.NewBufferString(`{"metadata": {"team": "billing"}}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	// Lift the pause by id
+	resp, err = http.Post(baseURL+"/unpause", "application/json", bytes.NewBufferString(`{"id": "missing"}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Post(baseURL+"/unpause", "application/json", bytes.NewBufferString(`{"id": "*"}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post(baseURL+"/unpause", "application/json", bytes.NewBufferString(fmt.Sprintf(`{"id": %q}`, paused.Pause.ID)))
+	require.NoError(t, err)
+	body, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), paused.Pause.ID)
+
+	resp, err = http.Post(baseURL+"/jobs", "application/json", bytes.NewBuffer(billingJob))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
 }
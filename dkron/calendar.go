@@ -0,0 +1,113 @@
+package dkron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/distribworks/dkron/v4/extcron"
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/tidwall/buntdb"
+)
+
+const (
+	// CalendarSkip doesn't run the job on the days excluded by its calendar.
+	CalendarSkip = "skip"
+	// CalendarShift moves the runs of the days excluded by the job calendar to
+	// the same time of the next day that is not excluded.
+	CalendarShift = "shift"
+)
+
+var (
+	// ErrCalendarNotFound is returned when the calendar of a job is not found.
+	ErrCalendarNotFound = errors.New("specified calendar not found")
+	// ErrCalendarInUse is returned when deleting a calendar used by some jobs.
+	ErrCalendarInUse = errors.New("the calendar is used by some jobs")
+	// ErrWrongCalendarPolicy is returned when the calendar policy is set to a non existing setting.
+	ErrWrongCalendarPolicy = errors.New("invalid calendar policy value, use \"skip\" or \"shift\"")
+)
+
+// Calendar is a named set of days excluded from the schedule of the jobs
+// using it, like bank holidays or weekends.
+type Calendar struct {
+	// Calendar name. Must be unique, acts as the id.
+	Name string `json:"name"`
+
+	// Description of the calendar.
+	Description string `json:"description"`
+
+	// The timezone the days are evaluated in. Empty means the timezone of
+	// the job schedule.
+	Timezone string `json:"timezone"`
+
+	// Excluded dates, in "2006-01-02" format.
+	Holidays []string `json:"holidays"`
+
+	// Excluded days of the week, like "saturday" or "sat".
+	ExcludedWeekdays []string `json:"excluded_weekdays"`
+}
+
+// NewCalendarFromProto maps a proto.Calendar to a Calendar object
+func NewCalendarFromProto(in *proto.Calendar) *Calendar {
+	return &Calendar{
+		Name:             in.Name,
+		Description:      in.Description,
+		Timezone:         in.Timezone,
+		Holidays:         in.Holidays,
+		ExcludedWeekdays: in.ExcludedWeekdays,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current calendar.
+func (c *Calendar) ToProto() *proto.Calendar {
+	return &proto.Calendar{
+		Name:             c.Name,
+		Description:      c.Description,
+		Timezone:         c.Timezone,
+		Holidays:         c.Holidays,
+		ExcludedWeekdays: c.ExcludedWeekdays,
+	}
+}
+
+// Validate validates the calendar.
+func (c *Calendar) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if valid, chr := isSlug(c.Name); !valid {
+		return fmt.Errorf("name contains illegal character '%s'", chr)
+	}
+
+	_, err := c.exclusions(nil)
+	return err
+}
+
+// jobCalendar returns the calendar of the given job, or nil if it has none.
+func jobCalendar(ctx context.Context, store Storage, job *Job) (*Calendar, error) {
+	if job.Calendar == "" {
+		return nil, nil
+	}
+
+	cal, err := store.GetCalendar(ctx, job.Calendar)
+	if err == buntdb.ErrNotFound {
+		return nil, ErrCalendarNotFound
+	}
+	return cal, err
+}
+
+// exclusions returns the days excluded by the calendar, evaluated in the
+// calendar timezone or in the given location when it has none.
+func (c *Calendar) exclusions(loc *time.Location) (*extcron.Calendar, error) {
+	if c.Timezone != "" {
+		l, err := time.LoadLocation(c.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		loc = l
+	}
+
+	return extcron.NewCalendar(c.Holidays, c.ExcludedWeekdays, loc)
+}
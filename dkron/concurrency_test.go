@@ -2,9 +2,11 @@ package dkron
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/serf/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
@@ -208,5 +210,253 @@ func TestConcurrencyAllow_NotAffected(t *testing.T) {
 
 	// The key point: with ConcurrencyAllow, the job should still be runnable
 	// This is tested indirectly - the GetRunningExecutions check is only
-	// applied when Concurrency == ConcurrencyForbid in isRunnable()
+	// applied in isRunnable() when the job has a concurrency limit
+}
+
+// TestConcurrency_MaxConcurrency tests the default concurrency limit of every
+// policy and that an execution running on several nodes counts once.
+func TestConcurrency_MaxConcurrency(t *testing.T) {
+	testCases := []struct {
+		concurrency string
+		max         int
+		want        int
+	}{
+		{"", 0, 0},
+		{ConcurrencyAllow, 0, 0},
+		{ConcurrencyAllow, 3, 3},
+		{ConcurrencyForbid, 0, 1},
+		{ConcurrencyReplace, 0, 1},
+		{ConcurrencyQueue, 0, 1},
+		{ConcurrencyQueue, 2, 2},
+	}
+	for _, tc := range testCases {
+		job := &Job{Concurrency: tc.concurrency, MaxConcurrency: tc.max}
+		assert.Equal(t, tc.want, job.maxConcurrency(), "%s/%d", tc.concurrency, tc.max)
+	}
+
+	log := getTestLogger()
+	s, err := NewStore(log, otel.Tracer("test"))
+	require.NoError(t, err)
+	defer s.Shutdown() // nolint: errcheck
+
+	ctx := context.Background()
+
+	testJob := &Job{
+		Name:           "max-job",
+		Schedule:       "@every 5s",
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"command": "/bin/true"},
+		Concurrency:    ConcurrencyAllow,
+		MaxConcurrency: 2,
+	}
+	require.NoError(t, s.SetJob(ctx, testJob, true))
+
+	group := time.Now().UnixNano()
+	for _, ex := range []*Execution{
+		{JobName: "max-job", StartedAt: time.Now().UTC(), NodeName: "node-1", Group: group, Attempt: 1},
+		{JobName: "max-job", StartedAt: time.Now().UTC(), NodeName: "node-2", Group: group, Attempt: 1},
+		{JobName: "max-job", StartedAt: time.Now().UTC(), FinishedAt: time.Now().UTC(), NodeName: "node-1", Group: group + 1, Attempt: 1},
+	} {
+		_, err := s.SetExecution(ctx, ex)
+		require.NoError(t, err)
+	}
+
+	testJob.Agent = &Agent{Store: s}
+	running, err := testJob.storedRunningGroups(ctx)
+	require.NoError(t, err)
+	assert.Len(t, running, 1)
+	assert.Len(t, running[group], 2)
+}
+
+// TestConcurrencyQueue_Store tests that queued executions are returned in
+// arrival order, the queue depth is respected and the queue is removed with the job.
+func TestConcurrencyQueue_Store(t *testing.T) {
+	log := getTestLogger()
+	s, err := NewStore(log, otel.Tracer("test"))
+	require.NoError(t, err)
+	defer s.Shutdown() // nolint: errcheck
+
+	ctx := context.Background()
+
+	testJob := &Job{
+		Name:           "queue-job",
+		Schedule:       "@every 5s",
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"command": "/bin/true"},
+		Concurrency:    ConcurrencyQueue,
+		MaxQueueDepth:  2,
+	}
+	require.NoError(t, s.SetJob(ctx, testJob, true))
+
+	ex, err := s.DequeueExecution(ctx, "queue-job")
+	require.NoError(t, err)
+	assert.Nil(t, ex, "Empty queue should return no execution")
+
+	first := NewExecution("queue-job", TriggerCron)
+	first.Parameters = map[string]string{"date": "today"}
+	// Executions of the same group don't overwrite each other
+	second := NewExecution("queue-job", TriggerCron)
+	second.Group = first.Group
+	third := NewExecution("queue-job", TriggerCron)
+	third.Group = first.Group + 1
+
+	for i, ex := range []*Execution{first, second} {
+		queued, err := s.QueueExecution(ctx, ex, testJob.maxQueueDepth(), uint64(i+1))
+		require.NoError(t, err)
+		assert.True(t, queued)
+	}
+	queued, err := s.QueueExecution(ctx, third, testJob.maxQueueDepth(), 3)
+	require.NoError(t, err)
+	assert.False(t, queued, "Full queue should not accept more executions")
+
+	exs, err := s.GetQueuedExecutions(ctx, "queue-job")
+	require.NoError(t, err)
+	assert.Len(t, exs, 2)
+
+	ex, err = s.DequeueExecution(ctx, "queue-job")
+	require.NoError(t, err)
+	require.NotNil(t, ex)
+	assert.Equal(t, first.Group, ex.Group)
+	assert.Equal(t, "today", ex.Parameters["date"])
+
+	_, err = s.DeleteJob(ctx, "queue-job")
+	require.NoError(t, err)
+	exs, err = s.GetQueuedExecutions(ctx, "queue-job")
+	require.NoError(t, err)
+	assert.Empty(t, exs, "Queue should be removed with the job")
+}
+
+// failingCancelClient fails to reach the agents to cancel their executions.
+type failingCancelClient struct {
+	gRPCClientMock
+}
+
+func (failingCancelClient) AgentCancel(addr string, e string) (bool, error) {
+	return false, errors.New("connection refused")
+}
+
+func TestConcurrencyReplace_CancelFails(t *testing.T) {
+	ip1, returnFn1 := testutil.TakeIP()
+	defer returnFn1()
+
+	c := DefaultConfig()
+	c.BindAddr = ip1.String()
+	c.NodeName = "test-replace"
+	c.Server = true
+	c.LogLevel = logLevel
+	c.DevMode = true
+
+	a := NewAgent(c)
+	a.GRPCClient = &failingCancelClient{}
+	require.NoError(t, a.Start())
+	defer a.Stop() // nolint: errcheck
+
+	ctx := context.Background()
+	job := &Job{
+		Name:           "replace-job",
+		Schedule:       "@every 5s",
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"command": "/bin/true"},
+		Concurrency:    ConcurrencyReplace,
+		Agent:          a,
+		logger:         getTestLogger(),
+	}
+	require.NoError(t, a.Store.SetJob(ctx, job, true))
+
+	running := &Execution{
+		JobName:   job.Name,
+		StartedAt: time.Now(),
+		NodeName:  c.NodeName,
+		Group:     time.Now().UnixNano(),
+		Attempt:   1,
+	}
+	_, err := a.Store.SetExecution(ctx, running)
+	require.NoError(t, err)
+
+	// The new execution doesn't run when the running one can't be cancelled
+	assert.False(t, job.applyConcurrencyPolicy(NewExecution(job.Name, TriggerCron)))
+
+	// Executions of nodes that are gone are not running anymore
+	running.NodeName = "gone-node"
+	running.StartedAt = running.StartedAt.Add(time.Second)
+	require.NoError(t, a.Store.DeleteExecutions(ctx, job.Name))
+	_, err = a.Store.SetExecution(ctx, running)
+	require.NoError(t, err)
+	assert.True(t, job.applyConcurrencyPolicy(NewExecution(job.Name, TriggerCron)))
+}
+
+// slowCancelClient cancels executions that take a while to finish.
+type slowCancelClient struct {
+	gRPCClientMock
+	cancel func(key string)
+}
+
+func (c *slowCancelClient) AgentCancel(addr string, e string) (bool, error) {
+	go c.cancel(e)
+	return true, nil
+}
+
+func TestConcurrencyReplace_WaitsForCancelled(t *testing.T) {
+	ip1, returnFn1 := testutil.TakeIP()
+	defer returnFn1()
+
+	c := DefaultConfig()
+	c.BindAddr = ip1.String()
+	c.NodeName = "test-replace-wait"
+	c.Server = true
+	c.LogLevel = logLevel
+	c.DevMode = true
+
+	a := NewAgent(c)
+	client := &slowCancelClient{}
+	a.GRPCClient = client
+	require.NoError(t, a.Start())
+	defer a.Stop() // nolint: errcheck
+
+	ctx := context.Background()
+	job := &Job{
+		Name:           "replace-wait-job",
+		Schedule:       "@every 5s",
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"command": "/bin/true"},
+		Concurrency:    ConcurrencyReplace,
+		Agent:          a,
+		logger:         getTestLogger(),
+	}
+	require.NoError(t, a.Store.SetJob(ctx, job, true))
+
+	running := &Execution{
+		JobName:   job.Name,
+		StartedAt: time.Now(),
+		NodeName:  c.NodeName,
+		Group:     time.Now().UnixNano(),
+		Attempt:   1,
+	}
+	_, err := a.Store.SetExecution(ctx, running)
+	require.NoError(t, err)
+
+	// The cancelled execution reports it finished a while after the cancel
+	finished := make(chan time.Time, 1)
+	client.cancel = func(key string) {
+		time.Sleep(time.Second)
+		done := *running
+		done.FinishedAt = time.Now()
+		_, err := a.Store.SetExecution(ctx, &done)
+		assert.NoError(t, err)
+		finished <- done.FinishedAt
+	}
+
+	assert.True(t, job.applyConcurrencyPolicy(NewExecution(job.Name, TriggerCron)))
+	started := time.Now()
+	assert.False(t, started.Before(<-finished), "the new execution must not overlap the replaced one")
+
+	// The new execution is skipped when the cancelled one never finishes
+	replaceTimeout = 500 * time.Millisecond
+	defer func() { replaceTimeout = 30 * time.Second }()
+	client.cancel = func(key string) {}
+	running.StartedAt = running.StartedAt.Add(time.Second)
+	running.Group++
+	_, err = a.Store.SetExecution(ctx, running)
+	require.NoError(t, err)
+	assert.False(t, job.applyConcurrencyPolicy(NewExecution(job.Name, TriggerCron)))
 }
@@ -0,0 +1,258 @@
+package dkron
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	typesv1 "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/plugin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// messageParamPrefix prefixes the parameters holding the consumed messages.
+	messageParamPrefix = "message_"
+
+	// defaultAckTimeout is how long a trigger waits for the result of a run
+	// before delivering its messages again.
+	defaultAckTimeout = time.Hour
+
+	// consumerRestartDelay is how long to wait before restarting a trigger
+	// consumer that stopped with an error.
+	consumerRestartDelay = 5 * time.Second
+)
+
+// ackTimeout returns the ack_timeout of a trigger config, how long to wait
+// for the result of a run before delivering its messages again.
+func ackTimeout(config map[string]string) (time.Duration, error) {
+	v := config["ack_timeout"]
+	if v == "" {
+		return defaultAckTimeout, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid ack_timeout %q", v)
+	}
+	return d, nil
+}
+
+// messageParameters returns the run parameters exposing consumed messages:
+// the payloads as message_payload, one per line, their number as
+// message_count and their source as message_source. Single messages also
+// get their key as message_key and every header as message_header_<name>.
+func messageParameters(messages []*typesv1.TriggerMessage) map[string]string {
+	payloads := make([]string, 0, len(messages))
+	for _, m := range messages {
+		payloads = append(payloads, string(m.Payload))
+	}
+	params := map[string]string{
+		messageParamPrefix + "payload": strings.Join(payloads, "\n"),
+		messageParamPrefix + "count":   strconv.Itoa(len(messages)),
+	}
+	if len(messages) == 0 {
+		return params
+	}
+	params[messageParamPrefix+"source"] = messages[0].Source
+
+	if len(messages) == 1 {
+		params[messageParamPrefix+"key"] = messages[0].Key
+		for name, value := range messages[0].Headers {
+			key := strings.ReplaceAll(strings.ToLower(name), "-", "_")
+			params[messageParamPrefix+"header_"+key] = value
+		}
+	}
+	return params
+}
+
+// startConsumers starts the trigger consumers of the jobs, it is only
+// called on the leader.
+func (a *Agent) startConsumers(jobs []*Job) {
+	for _, job := range jobs {
+		a.startConsumer(job)
+	}
+}
+
+// startConsumer starts, or restarts with its new config, the trigger
+// consumer of the job. Consumers of disabled jobs are stopped.
+func (a *Agent) startConsumer(job *Job) {
+	a.stopConsumer(job.Name)
+	if job.Trigger == "" || job.Disabled {
+		return
+	}
+
+	log := a.logger.WithFields(logrus.Fields{
+		"job":     job.Name,
+		"trigger": job.Trigger,
+	})
+	trigger, ok := a.TriggerPlugins[job.Trigger]
+	if !ok {
+		log.Error("agent: Specified trigger plugin not found")
+		return
+	}
+	timeout, err := ackTimeout(job.TriggerConfig)
+	if err != nil {
+		log.WithError(err).Error("agent: Error parsing trigger config")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.consumersLock.Lock()
+	if a.consumers == nil {
+		a.consumers = make(map[string]context.CancelFunc)
+	}
+	a.consumers[job.Name] = cancel
+	a.consumersLock.Unlock()
+
+	h := &messageHandler{agent: a, jobName: job.Name, timeout: timeout, stopped: ctx.Done()}
+	req := &typesv1.ConsumeRequest{JobName: job.Name, Config: job.TriggerConfig}
+	go a.consume(ctx, log, trigger, req, h)
+}
+
+// consume runs the trigger consumer until the context is cancelled,
+// restarting it when it stops with an error.
+func (a *Agent) consume(ctx context.Context, log *logrus.Entry, trigger plugin.Trigger, req *typesv1.ConsumeRequest, h plugin.MessageHandler) {
+	log.Info("agent: Starting trigger consumer")
+	for {
+		err := trigger.Consume(ctx, req, h)
+		if ctx.Err() != nil {
+			log.Info("agent: Stopped trigger consumer")
+			return
+		}
+		log.WithError(err).Error("agent: Trigger consumer stopped, restarting")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(consumerRestartDelay):
+		}
+	}
+}
+
+// stopConsumer stops the trigger consumer of the job, if any.
+func (a *Agent) stopConsumer(jobName string) {
+	a.consumersLock.Lock()
+	defer a.consumersLock.Unlock()
+
+	if cancel, ok := a.consumers[jobName]; ok {
+		cancel()
+		delete(a.consumers, jobName)
+	}
+}
+
+// stopConsumers stops all the trigger consumers when losing leadership.
+func (a *Agent) stopConsumers() {
+	a.consumersLock.Lock()
+	defer a.consumersLock.Unlock()
+
+	for name, cancel := range a.consumers {
+		cancel()
+		delete(a.consumers, name)
+	}
+}
+
+// waiterKey returns the key of the waiter of an execution, the runs on all
+// the target nodes and their retries share it.
+func waiterKey(ex *Execution) string {
+	return fmt.Sprintf("%s/%d", ex.JobName, ex.Group)
+}
+
+// waitExecution registers a waiter for the result of the execution, the
+// last attempts of all its nodes.
+func (a *Agent) waitExecution(ex *Execution) <-chan []*Execution {
+	a.executionWaitersLock.Lock()
+	defer a.executionWaitersLock.Unlock()
+
+	if a.executionWaiters == nil {
+		a.executionWaiters = make(map[string]chan []*Execution)
+	}
+	ch := make(chan []*Execution, 1)
+	a.executionWaiters[waiterKey(ex)] = ch
+	return ch
+}
+
+// stopWaiting unregisters the waiter of the execution.
+func (a *Agent) stopWaiting(ex *Execution) {
+	a.executionWaitersLock.Lock()
+	defer a.executionWaitersLock.Unlock()
+
+	delete(a.executionWaiters, waiterKey(ex))
+}
+
+// executionFinished passes the final result of an execution group, the
+// last attempts of all its nodes, to its waiter if any.
+func (a *Agent) executionFinished(ex *Execution, last []*Execution) {
+	a.executionWaitersLock.Lock()
+	defer a.executionWaitersLock.Unlock()
+
+	if ch, ok := a.executionWaiters[waiterKey(ex)]; ok {
+		select {
+		case ch <- last:
+		default:
+		}
+	}
+}
+
+// messageHandler runs a job for the messages consumed by its trigger and
+// waits for the result of the run.
+type messageHandler struct {
+	agent   *Agent
+	jobName string
+	timeout time.Duration
+
+	// stopped is closed when the consumer stops, like when losing the
+	// leadership, the results of the runs are reported to the new leader.
+	stopped <-chan struct{}
+}
+
+// Handle runs the job with the messages as parameters, like a scheduled run,
+// it returns nil once the run succeeded on all its nodes so the trigger
+// acknowledges the messages.
+func (h *messageHandler) Handle(ctx context.Context, messages []*typesv1.TriggerMessage) error {
+	job, err := h.agent.Store.GetJob(ctx, h.jobName, nil)
+	if err != nil {
+		return err
+	}
+	job.Agent = h.agent
+
+	ex := NewExecution(h.jobName, TriggerMessage)
+	ex.Parameters = messageParameters(messages)
+
+	done := h.agent.waitExecution(ex)
+	defer h.agent.stopWaiting(ex)
+
+	started, err := job.start(ctx, ex)
+	if err != nil {
+		return err
+	}
+	if !started {
+		return fmt.Errorf("job %s can't run now", h.jobName)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	select {
+	case last := <-done:
+		success := groupStatus(last) == StatusSuccess
+		metrics.IncrCounterWithLabels([]string{"trigger", "messages"}, float32(len(messages)), jobLabels("job_name", h.jobName,
+			metrics.Label{Name: "success", Value: strconv.FormatBool(success)},
+		))
+		if !success {
+			var failed []string
+			for _, ex := range last {
+				if !ex.Success {
+					failed = append(failed, ex.NodeName)
+				}
+			}
+			return fmt.Errorf("job %s run failed on %s", h.jobName, strings.Join(failed, ", "))
+		}
+		return nil
+	case <-h.stopped:
+		return fmt.Errorf("waiting for job %s run: consumer stopped", h.jobName)
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for job %s run: %w", h.jobName, ctx.Err())
+	}
+}
@@ -0,0 +1,181 @@
+package dkron
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	typesv1 "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/plugin"
+	"github.com/hashicorp/serf/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageParameters(t *testing.T) {
+	params := messageParameters([]*typesv1.TriggerMessage{{
+		Source:  "orders",
+		Key:     "42",
+		Payload: []byte(`{"id":42}`),
+		Headers: map[string]string{"Content-Type": "application/json"},
+	}})
+	assert.Equal(t, map[string]string{
+		"message_payload":             `{"id":42}`,
+		"message_count":               "1",
+		"message_source":              "orders",
+		"message_key":                 "42",
+		"message_header_content_type": "application/json",
+	}, params)
+
+	// Batches get the payloads one per line
+	params = messageParameters([]*typesv1.TriggerMessage{
+		{Source: "orders", Key: "1", Payload: []byte("a")},
+		{Source: "orders", Key: "2", Payload: []byte("b")},
+	})
+	assert.Equal(t, map[string]string{
+		"message_payload": "a\nb",
+		"message_count":   "2",
+		"message_source":  "orders",
+	}, params)
+
+	// Undeclared message parameters are kept
+	job := &Job{Name: "test_job", Parameters: map[string]*JobParameter{"env": {Default: "prod"}}}
+	resolved, err := job.resolveEventParameters(params)
+	require.NoError(t, err)
+	assert.Equal(t, "a\nb", resolved["message_payload"])
+	assert.Equal(t, "prod", resolved["env"])
+}
+
+func TestAckTimeout(t *testing.T) {
+	d, err := ackTimeout(nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultAckTimeout, d)
+
+	d, err = ackTimeout(map[string]string{"ack_timeout": "5m"})
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, d)
+
+	_, err = ackTimeout(map[string]string{"ack_timeout": "-1s"})
+	assert.Error(t, err)
+
+	job := &Job{Name: "test_job", Schedule: "@manually", Trigger: "kafka", TriggerConfig: map[string]string{"ack_timeout": "soon"}}
+	assert.Error(t, job.Validate())
+}
+
+// payloadExecutor succeeds when the rendered payload config is "ok".
+type payloadExecutor struct{}
+
+func (e *payloadExecutor) Execute(args *typesv1.ExecuteRequest, cb plugin.StatusHelper) (*typesv1.ExecuteResponse, error) {
+	if args.Config["payload"] != "ok" {
+		return &typesv1.ExecuteResponse{Error: "bad payload"}, nil
+	}
+	return &typesv1.ExecuteResponse{Output: []byte("ok")}, nil
+}
+
+// fakeTrigger passes the messages sent to it to the handler, one at a time,
+// and reports the result of the handler.
+type fakeTrigger struct {
+	messages chan *typesv1.TriggerMessage
+	results  chan error
+}
+
+func (f *fakeTrigger) Consume(ctx context.Context, args *typesv1.ConsumeRequest, h plugin.MessageHandler) error {
+	for {
+		select {
+		case m := <-f.messages:
+			f.results <- h.Handle(ctx, []*typesv1.TriggerMessage{m})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func TestTriggerConsumer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dkron-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ip1, returnFn1 := testutil.TakeIP()
+	defer returnFn1()
+
+	c := DefaultConfig()
+	c.BindAddr = ip1.String()
+	c.NodeName = "test-trigger"
+	c.Server = true
+	c.LogLevel = logLevel
+	c.BootstrapExpect = 1
+	c.DevMode = true
+	c.DataDir = dir
+
+	trigger := &fakeTrigger{
+		messages: make(chan *typesv1.TriggerMessage),
+		results:  make(chan error),
+	}
+	a := NewAgent(c)
+	a.ExecutorPlugins = map[string]plugin.Executor{"payload": &payloadExecutor{}}
+	a.TriggerPlugins = map[string]plugin.Trigger{"fake": trigger}
+	require.NoError(t, a.Start())
+	defer a.Stop() // nolint: errcheck
+
+	for !a.IsLeader() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	rc := NewGRPCClient(nil, a, getTestLogger())
+	err = rc.SetJob(&Job{
+		Name:           "test_job",
+		Schedule:       "@manually",
+		Executor:       "payload",
+		ExecutorConfig: map[string]string{"payload": "{{.Parameters.message_payload}}"},
+		Trigger:        "fake",
+		TriggerConfig:  map[string]string{"ack_timeout": "10s"},
+	}, 0)
+	require.NoError(t, err)
+
+	// Successful runs acknowledge the message
+	trigger.messages <- &typesv1.TriggerMessage{Source: "test", Payload: []byte("ok")}
+	assert.NoError(t, <-trigger.results)
+
+	// Failed runs have the message delivered again
+	trigger.messages <- &typesv1.TriggerMessage{Source: "test", Payload: []byte("ko")}
+	assert.Error(t, <-trigger.results)
+
+	// Runs go through the job checks, messages suppressed by a
+	// maintenance window are delivered again without running
+	window := &MaintenanceWindow{Name: "upgrade", Policy: WindowDefer}
+	window.StartsAt.Set(time.Now().Add(-time.Hour))
+	window.EndsAt.Set(time.Now().Add(time.Hour))
+	require.NoError(t, a.Store.SetMaintenanceWindow(context.Background(), window))
+	trigger.messages <- &typesv1.TriggerMessage{Source: "test", Payload: []byte("ok")}
+	assert.Error(t, <-trigger.results)
+	_, err = a.Store.DeleteMaintenanceWindow(context.Background(), window.Name)
+	require.NoError(t, err)
+
+	retries, err := a.Store.GetPendingRetries(context.Background(), "test_job")
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+
+	execs, err := a.Store.GetExecutions(context.Background(), "test_job", &ExecutionOptions{})
+	require.NoError(t, err)
+	require.Len(t, execs, 2)
+	for _, ex := range execs {
+		assert.Equal(t, TriggerMessage, ex.Trigger)
+	}
+
+	// Disabling the job stops its consumer
+	err = rc.SetJob(&Job{
+		Name:     "test_job",
+		Schedule: "@manually",
+		Executor: "payload",
+		Trigger:  "fake",
+		Disabled: true,
+	}, 0)
+	require.NoError(t, err)
+	select {
+	case trigger.messages <- &typesv1.TriggerMessage{}:
+		t.Fatal("disabled job consumed a message")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
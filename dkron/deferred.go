@@ -0,0 +1,103 @@
+package dkron
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeferredRun is a run of a job suppressed by a maintenance window with the
+// defer policy, waiting for the window to end. The runs of a job deferred
+// until the same time are merged, the last one replacing the one deferred
+// before. Deferred runs are stored through raft, so a new leader runs the
+// runs deferred by the previous one.
+type DeferredRun struct {
+	// Execution to run, it never started.
+	Execution *Execution `json:"execution"`
+
+	// Time when the run happens, the end of the maintenance windows.
+	RunAt time.Time `json:"run_at"`
+}
+
+// NewDeferredRunFromProto maps a proto.DeferredRun to a DeferredRun object
+func NewDeferredRunFromProto(in *proto.DeferredRun) *DeferredRun {
+	return &DeferredRun{
+		Execution: NewExecutionFromProto(in.GetExecution()),
+		RunAt:     in.GetRunAt().AsTime(),
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the representation of
+// the current deferred run.
+func (r *DeferredRun) ToProto() *proto.DeferredRun {
+	return &proto.DeferredRun{
+		Execution: r.Execution.ToProto(),
+		RunAt:     timestamppb.New(r.RunAt),
+	}
+}
+
+// ID returns the identifier of the deferred run in its job, the time it runs.
+func (r *DeferredRun) ID() string {
+	return strconv.FormatInt(r.RunAt.UnixNano(), 10)
+}
+
+// timerKey returns the key of the deferred run timer.
+func (r *DeferredRun) timerKey() string {
+	return deferredPrefix + ":" + r.Execution.JobName + ":" + r.ID()
+}
+
+// scheduleDeferredRun sets a timer that runs the given deferred run when it
+// is due, replacing the timer of the run deferred until the same time.
+func (a *Agent) scheduleDeferredRun(run *DeferredRun) {
+	a.setRetryTimer(run.timerKey(), run.RunAt, func() {
+		a.runDeferredRun(run)
+	})
+}
+
+// runDeferredRun claims a due deferred run through raft and runs it. Deferred
+// runs go through the job checks and concurrency policy like scheduled runs.
+func (a *Agent) runDeferredRun(run *DeferredRun) {
+	a.deleteRetryTimer(run.timerKey())
+
+	log := a.logger.WithFields(logrus.Fields{
+		"job":    run.Execution.JobName,
+		"run_at": run.RunAt,
+	})
+
+	// Deleting the deferred run claims it, it is not run when it was
+	// already deleted, along with its job for example.
+	found, err := a.applyDeleteDeferredRun(run.Execution.JobName, run.ID())
+	if err != nil {
+		log.WithError(err).Error("agent: Error claiming deferred run")
+		return
+	}
+	if !found {
+		return
+	}
+
+	job, err := a.Store.GetJob(context.Background(), run.Execution.JobName, nil)
+	if err != nil {
+		log.WithError(err).Error("agent: Error retrieving job of deferred run")
+		return
+	}
+	job.Agent = a
+	log.Debug("agent: Running deferred execution")
+	job.run(run.Execution)
+}
+
+// resumeDeferredRuns schedules the deferred runs found in the store, the ones
+// already due run right away.
+func (a *Agent) resumeDeferredRuns(ctx context.Context) error {
+	runs, err := a.Store.GetDeferredRuns(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, run := range runs {
+		a.scheduleDeferredRun(run)
+	}
+	return nil
+}
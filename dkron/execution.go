@@ -12,6 +12,23 @@ import (
 
 const defaultRetryInterval = 500 * time.Millisecond
 
+const (
+	// TriggerCron is the trigger of the executions run by the job schedule.
+	TriggerCron = "cron"
+	// TriggerMisfire is the trigger of the executions missed while there was no
+	// leader, run following the misfire policy.
+	TriggerMisfire = "misfire"
+	// TriggerManual is the trigger of the executions run from the API.
+	TriggerManual = "manual"
+	// TriggerDependency is the trigger of the executions run when a parent job finishes.
+	TriggerDependency = "dependency"
+	// TriggerWebhook is the trigger of the executions run by a webhook call.
+	TriggerWebhook = "webhook"
+	// TriggerMessage is the trigger of the executions run by the messages
+	// consumed by a trigger plugin.
+	TriggerMessage = "message"
+)
+
 // Execution type holds all of the details of a specific Execution.
 type Execution struct {
 	// Id is the Key for this execution
@@ -40,14 +57,39 @@ type Execution struct {
 
 	// Retry attempt of this execution.
 	Attempt uint `json:"attempt,omitempty"`
+
+	// Workflow run this execution belongs to when it was triggered by a parent job.
+	WorkflowRun int64 `json:"workflow_run,omitempty"`
+
+	// Execution of the parent job that triggered this execution.
+	ParentExecution *Execution `json:"parent_execution,omitempty"`
+
+	// Parameters passed to this execution.
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// If this execution was cancelled while running.
+	Cancelled bool `json:"cancelled,omitempty"`
+
+	// When the execution was supposed to start.
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+
+	// What triggered this execution: cron, misfire, manual, dependency, retry, webhook or message.
+	Trigger string `json:"trigger,omitempty"`
+
+	// Number of nodes the execution group was run on.
+	GroupSize uint `json:"group_size,omitempty"`
 }
 
-// NewExecution creates a new execution.
-func NewExecution(jobName string) *Execution {
+// NewExecution creates a new execution with the given trigger, scheduled to
+// start now.
+func NewExecution(jobName string, trigger string) *Execution {
+	now := time.Now()
 	return &Execution{
-		JobName: jobName,
-		Group:   time.Now().UnixNano(),
-		Attempt: 1,
+		JobName:     jobName,
+		Group:       now.UnixNano(),
+		Attempt:     1,
+		ScheduledAt: now,
+		Trigger:     trigger,
 	}
 }
 
@@ -55,16 +97,33 @@ func NewExecution(jobName string) *Execution {
 func NewExecutionFromProto(e *proto.Execution) *Execution {
 	startedAt := e.GetStartedAt().AsTime()
 	finishedAt := e.GetFinishedAt().AsTime()
+	var parent *Execution
+	if e.ParentExecution != nil {
+		parent = NewExecutionFromProto(e.ParentExecution)
+	}
+	// Executions stored before recording the scheduled time don't have it
+	var scheduledAt time.Time
+	if e.ScheduledAt != nil {
+		scheduledAt = e.ScheduledAt.AsTime()
+	}
 	return &Execution{
-		Id:         e.Key(),
-		JobName:    e.JobName,
-		Success:    e.Success,
-		Output:     string(e.Output),
-		NodeName:   e.NodeName,
-		Group:      e.Group,
-		Attempt:    uint(e.Attempt),
-		StartedAt:  startedAt,
-		FinishedAt: finishedAt,
+		Id:          e.Key(),
+		JobName:     e.JobName,
+		Success:     e.Success,
+		Output:      string(e.Output),
+		NodeName:    e.NodeName,
+		Group:       e.Group,
+		Attempt:     uint(e.Attempt),
+		StartedAt:   startedAt,
+		FinishedAt:  finishedAt,
+		WorkflowRun: e.WorkflowRun,
+
+		ParentExecution: parent,
+		Parameters:      e.Parameters,
+		Cancelled:       e.Cancelled,
+		ScheduledAt:     scheduledAt,
+		Trigger:         e.Trigger,
+		GroupSize:       uint(e.GroupSize),
 	}
 }
 
@@ -73,15 +132,31 @@ func NewExecutionFromProto(e *proto.Execution) *Execution {
 func (e *Execution) ToProto() *proto.Execution {
 	startedAt := timestamppb.New(e.StartedAt)
 	finishedAt := timestamppb.New(e.FinishedAt)
+	var parent *proto.Execution
+	if e.ParentExecution != nil {
+		parent = e.ParentExecution.ToProto()
+	}
+	var scheduledAt *timestamppb.Timestamp
+	if !e.ScheduledAt.IsZero() {
+		scheduledAt = timestamppb.New(e.ScheduledAt)
+	}
 	return &proto.Execution{
-		JobName:    e.JobName,
-		Success:    e.Success,
-		Output:     []byte(e.Output),
-		NodeName:   e.NodeName,
-		Group:      e.Group,
-		Attempt:    uint32(e.Attempt),
-		StartedAt:  startedAt,
-		FinishedAt: finishedAt,
+		JobName:     e.JobName,
+		Success:     e.Success,
+		Output:      []byte(e.Output),
+		NodeName:    e.NodeName,
+		Group:       e.Group,
+		Attempt:     uint32(e.Attempt),
+		StartedAt:   startedAt,
+		FinishedAt:  finishedAt,
+		WorkflowRun: e.WorkflowRun,
+
+		ParentExecution: parent,
+		Parameters:      e.Parameters,
+		Cancelled:       e.Cancelled,
+		ScheduledAt:     scheduledAt,
+		Trigger:         e.Trigger,
+		GroupSize:       uint32(e.GroupSize),
 	}
 }
 
@@ -95,6 +170,77 @@ func (e *Execution) GetGroup() string {
 	return strconv.FormatInt(e.Group, 10)
 }
 
+// workflowRun returns the workflow run this execution belongs to. Executions
+// not triggered by a parent job start a new workflow run named after their group.
+func (e *Execution) workflowRun() int64 {
+	if e.WorkflowRun != 0 {
+		return e.WorkflowRun
+	}
+	return e.Group
+}
+
+// asParent returns the summary of the execution passed to the executions of
+// its dependent jobs: what identifies it, its result and the end of its output.
+func (e *Execution) asParent() *Execution {
+	output := e.Output
+	if len(output) > maxParentOutputSize {
+		output = output[len(output)-maxParentOutputSize:]
+	}
+	return &Execution{
+		Id:         e.Id,
+		JobName:    e.JobName,
+		Group:      e.Group,
+		NodeName:   e.NodeName,
+		StartedAt:  e.StartedAt,
+		FinishedAt: e.FinishedAt,
+		Success:    e.Success,
+		Output:     output,
+	}
+}
+
+// groupSize returns the number of nodes the execution group was run on, the
+// executions run before recording it count as a group of one.
+func (e *Execution) groupSize() int {
+	if e.GroupSize == 0 {
+		return 1
+	}
+	return int(e.GroupSize)
+}
+
+// lastAttempts returns the finished executions of a group that won't be
+// retried, one for each node that reported.
+func lastAttempts(executions []*Execution, retries uint) []*Execution {
+	var last []*Execution
+	for _, ex := range executions {
+		if ex.FinishedAt.IsZero() {
+			continue
+		}
+		if ex.Success || ex.Cancelled || ex.Attempt >= retries+1 {
+			last = append(last, ex)
+		}
+	}
+	return last
+}
+
+// groupStatus returns the status of an execution group from the last
+// attempts of its nodes.
+func groupStatus(executions []*Execution) string {
+	success := 0
+	for _, ex := range executions {
+		if ex.Success {
+			success++
+		}
+	}
+	switch success {
+	case len(executions):
+		return StatusSuccess
+	case 0:
+		return StatusFailed
+	default:
+		return StatusPartiallyFailed
+	}
+}
+
 func (e *Execution) CalculateExponentialBackoff() time.Duration {
 	now := time.Now()
 	if now.Before(e.StartedAt) {
@@ -0,0 +1,125 @@
+package dkron
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// maxForecastWindow is the longest time window of a forecast.
+	maxForecastWindow = 7 * 24 * time.Hour
+	// maxForecastRuns is the maximum number of runs of a single job in a forecast.
+	maxForecastRuns = 10000
+	// anyNodeTag groups the runs of the jobs without target node tags.
+	anyNodeTag = "*"
+)
+
+// Forecast holds the upcoming executions of the cluster in a time window.
+type Forecast struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	// Total number of executions in the window.
+	Total int `json:"total"`
+
+	// If some jobs run more than maxForecastRuns times in the window and
+	// only their first runs are counted.
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Executions grouped by minute, only the minutes with executions.
+	Minutes []*ForecastMinute `json:"minutes"`
+}
+
+// ForecastMinute holds the executions starting in a minute.
+type ForecastMinute struct {
+	Time time.Time `json:"time"`
+
+	// Number of executions starting in this minute.
+	Count int `json:"count"`
+
+	// Names of the jobs starting in this minute.
+	Jobs []string `json:"jobs"`
+
+	// Number of executions by target node tag, as "key=value", the jobs
+	// without tags count under "*".
+	Tags map[string]int `json:"tags"`
+}
+
+// forecast returns the executions of the given jobs scheduled from the from
+// time until the to time, grouped by minute and by target node tag, honoring
+// the given calendars by name. Disabled jobs and jobs run by their parents
+// are not included.
+func forecast(jobs []*Job, calendars map[string]*Calendar, from, to time.Time) (*Forecast, error) {
+	f := &Forecast{
+		From:    from,
+		To:      to,
+		Minutes: []*ForecastMinute{},
+	}
+
+	minutes := make(map[time.Time]*ForecastMinute)
+	for _, job := range jobs {
+		if job.Disabled || len(job.parents()) > 0 {
+			continue
+		}
+
+		// The window includes from
+		cal, ok := calendars[job.Calendar]
+		if job.Calendar != "" && !ok {
+			return nil, ErrCalendarNotFound
+		}
+		runs, err := job.nextRuns(cal, from.Add(-time.Nanosecond), to, maxForecastRuns)
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) == maxForecastRuns {
+			f.Truncated = true
+		}
+
+		tags := forecastTags(job.Tags)
+		for _, t := range runs {
+			minute := t.UTC().Truncate(time.Minute)
+			m, ok := minutes[minute]
+			if !ok {
+				m = &ForecastMinute{
+					Time: minute,
+					Tags: make(map[string]int),
+				}
+				minutes[minute] = m
+				f.Minutes = append(f.Minutes, m)
+			}
+
+			m.Count++
+			if !slices.Contains(m.Jobs, job.Name) {
+				m.Jobs = append(m.Jobs, job.Name)
+			}
+			for _, tag := range tags {
+				m.Tags[tag]++
+			}
+			f.Total++
+		}
+	}
+
+	sort.Slice(f.Minutes, func(i, j int) bool {
+		return f.Minutes[i].Time.Before(f.Minutes[j].Time)
+	})
+
+	return f, nil
+}
+
+// forecastTags returns the target node tags of a job as "key=value",
+// without the node count.
+func forecastTags(tags map[string]string) []string {
+	if len(tags) == 0 {
+		return []string{anyNodeTag}
+	}
+
+	var out []string
+	for k, v := range tags {
+		v, _, _ = strings.Cut(v, ":")
+		out = append(out, k+"="+v)
+	}
+	sort.Strings(out)
+	return out
+}
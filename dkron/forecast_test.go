@@ -0,0 +1,38 @@
+package dkron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForecast(t *testing.T) {
+	from := time.Date(2024, 1, 1, 10, 0, 30, 0, time.UTC)
+	to := from.Add(2 * time.Minute)
+
+	jobs := []*Job{
+		{Name: "every_minute", Schedule: "0 * * * * *", Tags: map[string]string{"role": "web:1"}},
+		{Name: "every_30s", Schedule: "*/30 * * * * *"},
+		{Name: "disabled", Schedule: "0 * * * * *", Disabled: true},
+		{Name: "child", ParentJob: "every_minute"},
+		{Name: "manual", Schedule: "@manually"},
+	}
+
+	f, err := forecast(jobs, nil, from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, f.Total)
+	assert.False(t, f.Truncated)
+	require.Len(t, f.Minutes, 3)
+
+	minute := time.Date(2024, 1, 1, 10, 1, 0, 0, time.UTC)
+	assert.Equal(t, minute, f.Minutes[1].Time)
+	assert.Equal(t, 3, f.Minutes[1].Count)
+	assert.ElementsMatch(t, []string{"every_minute", "every_30s"}, f.Minutes[1].Jobs)
+	assert.Equal(t, map[string]int{"role=web": 1, anyNodeTag: 2}, f.Minutes[1].Tags)
+
+	// The first minute of the window only has the runs after from
+	assert.Equal(t, 1, f.Minutes[0].Count)
+}
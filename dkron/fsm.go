@@ -3,6 +3,7 @@ package dkron
 import (
 	"context"
 	"io"
+	"time"
 
 	dkronpb "github.com/distribworks/dkron/v4/gen/proto/types/v1"
 	"github.com/hashicorp/raft"
@@ -25,6 +26,57 @@ const (
 	// ExecutionDoneType is the command to perform the logic needed once an execution
 	// is done.
 	ExecutionDoneType
+	// ParentJobDoneType is the command used to record that a parent of a job with
+	// several parents finished successfully in a workflow run.
+	ParentJobDoneType
+	// QueueExecutionType is the command used to queue an execution of a job
+	// using the queue concurrency policy.
+	QueueExecutionType
+	// DequeueExecutionType is the command used to take the oldest queued
+	// execution of a job out of its queue.
+	DequeueExecutionType
+	// SetPendingRetryType is the command used to store a retry of a failed
+	// execution waiting for its backoff delay.
+	SetPendingRetryType
+	// DeletePendingRetryType is the command used to delete a pending retry,
+	// either to run it or because it is no longer needed.
+	DeletePendingRetryType
+	// ClaimSlotType is the command used to claim the run of a job at a
+	// scheduled time, so that it runs at most once.
+	ClaimSlotType
+	// SetCalendarType is the command used to store a calendar.
+	SetCalendarType
+	// DeleteCalendarType is the command used to delete a calendar.
+	DeleteCalendarType
+	// SetMaintenanceWindowType is the command used to store a maintenance window.
+	SetMaintenanceWindowType
+	// DeleteMaintenanceWindowType is the command used to delete a maintenance window.
+	DeleteMaintenanceWindowType
+	// SetSuppressionType is the command used to record a run suppressed by a
+	// maintenance window.
+	SetSuppressionType
+	// SetPauseType is the command used to pause job submissions.
+	SetPauseType
+	// DeletePauseType is the command used to lift job submission pauses.
+	DeletePauseType
+	// SetWebhookTriggerType is the command used to store a webhook trigger.
+	SetWebhookTriggerType
+	// DeleteWebhookTriggerType is the command used to delete a webhook trigger.
+	DeleteWebhookTriggerType
+	// SetJobTemplateType is the command used to store a job template.
+	SetJobTemplateType
+	// DeleteJobTemplateType is the command used to delete a job template.
+	DeleteJobTemplateType
+	// SetNamespaceType is the command used to store a namespace.
+	SetNamespaceType
+	// DeleteNamespaceType is the command used to delete a namespace.
+	DeleteNamespaceType
+	// SetDeferredRunType is the command used to store a run deferred by a
+	// maintenance window until the window ends.
+	SetDeferredRunType
+	// DeleteDeferredRunType is the command used to delete a deferred run,
+	// either to run it or because it is no longer needed.
+	DeleteDeferredRunType
 )
 
 // LogApplier is the definition of a function that can apply a Raft log
@@ -62,7 +114,7 @@ func (d *dkronFSM) Apply(l *raft.Log) interface{} {
 
 	switch msgType {
 	case SetJobType:
-		return d.applySetJob(ctx, buf[1:])
+		return d.applySetJob(ctx, buf[1:], l.Index, l.AppendedAt)
 	case DeleteJobType:
 		return d.applyDeleteJob(ctx, buf[1:])
 	case DeleteExecutionsType:
@@ -71,6 +123,48 @@ func (d *dkronFSM) Apply(l *raft.Log) interface{} {
 		return d.applyExecutionDone(ctx, buf[1:])
 	case SetExecutionType:
 		return d.applySetExecution(ctx, buf[1:])
+	case ParentJobDoneType:
+		return d.applyParentJobDone(ctx, buf[1:])
+	case QueueExecutionType:
+		return d.applyQueueExecution(ctx, buf[1:], l.Index)
+	case DequeueExecutionType:
+		return d.applyDequeueExecution(ctx, buf[1:])
+	case SetPendingRetryType:
+		return d.applySetPendingRetry(ctx, buf[1:])
+	case DeletePendingRetryType:
+		return d.applyDeletePendingRetry(ctx, buf[1:])
+	case ClaimSlotType:
+		return d.applyClaimSlot(ctx, buf[1:])
+	case SetCalendarType:
+		return d.applySetCalendar(ctx, buf[1:])
+	case DeleteCalendarType:
+		return d.applyDeleteCalendar(ctx, buf[1:])
+	case SetMaintenanceWindowType:
+		return d.applySetMaintenanceWindow(ctx, buf[1:])
+	case DeleteMaintenanceWindowType:
+		return d.applyDeleteMaintenanceWindow(ctx, buf[1:])
+	case SetSuppressionType:
+		return d.applySetSuppression(ctx, buf[1:])
+	case SetPauseType:
+		return d.applySetPause(ctx, buf[1:])
+	case DeletePauseType:
+		return d.applyDeletePause(ctx, buf[1:])
+	case SetWebhookTriggerType:
+		return d.applySetWebhookTrigger(ctx, buf[1:])
+	case DeleteWebhookTriggerType:
+		return d.applyDeleteWebhookTrigger(ctx, buf[1:])
+	case SetJobTemplateType:
+		return d.applySetJobTemplate(ctx, buf[1:])
+	case DeleteJobTemplateType:
+		return d.applyDeleteJobTemplate(ctx, buf[1:])
+	case SetNamespaceType:
+		return d.applySetNamespace(ctx, buf[1:])
+	case DeleteNamespaceType:
+		return d.applyDeleteNamespace(ctx, buf[1:])
+	case SetDeferredRunType:
+		return d.applySetDeferredRun(ctx, buf[1:])
+	case DeleteDeferredRunType:
+		return d.applyDeleteDeferredRun(ctx, buf[1:])
 	}
 
 	// Check enterprise only message types.
@@ -81,12 +175,19 @@ func (d *dkronFSM) Apply(l *raft.Log) interface{} {
 	return nil
 }
 
-func (d *dkronFSM) applySetJob(ctx context.Context, buf []byte) interface{} {
+func (d *dkronFSM) applySetJob(ctx context.Context, buf []byte, index uint64, appendedAt time.Time) interface{} {
 	var pj dkronpb.Job
 	if err := proto.Unmarshal(buf, &pj); err != nil {
 		return err
 	}
 	job := NewJobFromProto(&pj, d.logger)
+	// The log index is the revision of the job when its definition changes
+	job.Revision = index
+	// Jobs not stamped by the leader take the time of their log entry,
+	// the same on all the nodes
+	if job.UpdatedAt.IsZero() {
+		job.UpdatedAt = appendedAt
+	}
 	if err := d.store.SetJob(ctx, job, true); err != nil {
 		return err
 	}
@@ -150,6 +251,223 @@ func (d *dkronFSM) applySetExecution(ctx context.Context, buf []byte) interface{
 	return key
 }
 
+func (d *dkronFSM) applyParentJobDone(ctx context.Context, buf []byte) interface{} {
+	var pjdr dkronpb.ParentJobDoneRequest
+	if err := proto.Unmarshal(buf, &pjdr); err != nil {
+		return err
+	}
+	ready, err := d.store.ParentJobDone(ctx, pjdr.GetJobName(), pjdr.GetParentJob(), pjdr.GetWorkflowRun())
+	if err != nil {
+		return err
+	}
+	return ready
+}
+
+func (d *dkronFSM) applyQueueExecution(ctx context.Context, buf []byte, index uint64) interface{} {
+	var qer dkronpb.QueueExecutionRequest
+	if err := proto.Unmarshal(buf, &qer); err != nil {
+		return err
+	}
+	execution := NewExecutionFromProto(qer.GetExecution())
+	queued, err := d.store.QueueExecution(ctx, execution, int(qer.GetMaxDepth()), index)
+	if err != nil {
+		return err
+	}
+	return queued
+}
+
+func (d *dkronFSM) applyDequeueExecution(ctx context.Context, buf []byte) interface{} {
+	var der dkronpb.DequeueExecutionRequest
+	if err := proto.Unmarshal(buf, &der); err != nil {
+		return err
+	}
+	execution, err := d.store.DequeueExecution(ctx, der.GetJobName())
+	if err != nil {
+		return err
+	}
+	return execution
+}
+
+func (d *dkronFSM) applySetPendingRetry(ctx context.Context, buf []byte) interface{} {
+	var pr dkronpb.PendingRetry
+	if err := proto.Unmarshal(buf, &pr); err != nil {
+		return err
+	}
+	return d.store.SetPendingRetry(ctx, NewPendingRetryFromProto(&pr))
+}
+
+func (d *dkronFSM) applyDeletePendingRetry(ctx context.Context, buf []byte) interface{} {
+	var dpr dkronpb.DeletePendingRetryRequest
+	if err := proto.Unmarshal(buf, &dpr); err != nil {
+		return err
+	}
+	found, err := d.store.DeletePendingRetry(ctx, dpr.GetJobName(), dpr.GetExecutionId())
+	if err != nil {
+		return err
+	}
+	return found
+}
+
+func (d *dkronFSM) applyClaimSlot(ctx context.Context, buf []byte) interface{} {
+	var csr dkronpb.ClaimSlotRequest
+	if err := proto.Unmarshal(buf, &csr); err != nil {
+		return err
+	}
+	claimed, err := d.store.ClaimSlot(ctx, csr.GetJobName(), csr.GetScheduledAt().AsTime())
+	if err != nil {
+		return err
+	}
+	return claimed
+}
+
+func (d *dkronFSM) applySetCalendar(ctx context.Context, buf []byte) interface{} {
+	var scr dkronpb.SetCalendarRequest
+	if err := proto.Unmarshal(buf, &scr); err != nil {
+		return err
+	}
+	return d.store.SetCalendar(ctx, NewCalendarFromProto(scr.GetCalendar()))
+}
+
+func (d *dkronFSM) applyDeleteCalendar(ctx context.Context, buf []byte) interface{} {
+	var dcr dkronpb.DeleteCalendarRequest
+	if err := proto.Unmarshal(buf, &dcr); err != nil {
+		return err
+	}
+	calendar, err := d.store.DeleteCalendar(ctx, dcr.GetName())
+	if err != nil {
+		return err
+	}
+	return calendar
+}
+
+func (d *dkronFSM) applySetMaintenanceWindow(ctx context.Context, buf []byte) interface{} {
+	var swr dkronpb.SetMaintenanceWindowRequest
+	if err := proto.Unmarshal(buf, &swr); err != nil {
+		return err
+	}
+	return d.store.SetMaintenanceWindow(ctx, NewMaintenanceWindowFromProto(swr.GetWindow()))
+}
+
+func (d *dkronFSM) applyDeleteMaintenanceWindow(ctx context.Context, buf []byte) interface{} {
+	var dwr dkronpb.DeleteMaintenanceWindowRequest
+	if err := proto.Unmarshal(buf, &dwr); err != nil {
+		return err
+	}
+	window, err := d.store.DeleteMaintenanceWindow(ctx, dwr.GetName())
+	if err != nil {
+		return err
+	}
+	return window
+}
+
+func (d *dkronFSM) applySetSuppression(ctx context.Context, buf []byte) interface{} {
+	var ssr dkronpb.SetSuppressionRequest
+	if err := proto.Unmarshal(buf, &ssr); err != nil {
+		return err
+	}
+	return d.store.SetSuppression(ctx, NewSuppressionFromProto(ssr.GetSuppression()))
+}
+
+func (d *dkronFSM) applySetDeferredRun(ctx context.Context, buf []byte) interface{} {
+	var dr dkronpb.DeferredRun
+	if err := proto.Unmarshal(buf, &dr); err != nil {
+		return err
+	}
+	return d.store.SetDeferredRun(ctx, NewDeferredRunFromProto(&dr))
+}
+
+func (d *dkronFSM) applyDeleteDeferredRun(ctx context.Context, buf []byte) interface{} {
+	var ddr dkronpb.DeleteDeferredRunRequest
+	if err := proto.Unmarshal(buf, &ddr); err != nil {
+		return err
+	}
+	found, err := d.store.DeleteDeferredRun(ctx, ddr.GetJobName(), ddr.GetId())
+	if err != nil {
+		return err
+	}
+	return found
+}
+
+func (d *dkronFSM) applySetPause(ctx context.Context, buf []byte) interface{} {
+	var spr dkronpb.SetPauseRequest
+	if err := proto.Unmarshal(buf, &spr); err != nil {
+		return err
+	}
+	return d.store.SetPause(ctx, NewPauseFromProto(spr.GetPause()))
+}
+
+func (d *dkronFSM) applyDeletePause(ctx context.Context, buf []byte) interface{} {
+	var dpr dkronpb.DeletePauseRequest
+	if err := proto.Unmarshal(buf, &dpr); err != nil {
+		return err
+	}
+	pauses, err := d.store.DeletePause(ctx, dpr.GetId())
+	if err != nil {
+		return err
+	}
+	return pauses
+}
+
+func (d *dkronFSM) applySetWebhookTrigger(ctx context.Context, buf []byte) interface{} {
+	var swr dkronpb.SetWebhookTriggerRequest
+	if err := proto.Unmarshal(buf, &swr); err != nil {
+		return err
+	}
+	return d.store.SetWebhookTrigger(ctx, NewWebhookTriggerFromProto(swr.GetTrigger()))
+}
+
+func (d *dkronFSM) applyDeleteWebhookTrigger(ctx context.Context, buf []byte) interface{} {
+	var dwr dkronpb.DeleteWebhookTriggerRequest
+	if err := proto.Unmarshal(buf, &dwr); err != nil {
+		return err
+	}
+	trigger, err := d.store.DeleteWebhookTrigger(ctx, dwr.GetId())
+	if err != nil {
+		return err
+	}
+	return trigger
+}
+
+func (d *dkronFSM) applySetJobTemplate(ctx context.Context, buf []byte) interface{} {
+	var str dkronpb.SetJobTemplateRequest
+	if err := proto.Unmarshal(buf, &str); err != nil {
+		return err
+	}
+	return d.store.SetJobTemplate(ctx, NewJobTemplateFromProto(str.GetTemplate()))
+}
+
+func (d *dkronFSM) applyDeleteJobTemplate(ctx context.Context, buf []byte) interface{} {
+	var dtr dkronpb.DeleteJobTemplateRequest
+	if err := proto.Unmarshal(buf, &dtr); err != nil {
+		return err
+	}
+	template, err := d.store.DeleteJobTemplate(ctx, dtr.GetName())
+	if err != nil {
+		return err
+	}
+	return template
+}
+
+func (d *dkronFSM) applySetNamespace(ctx context.Context, buf []byte) interface{} {
+	var snr dkronpb.SetNamespaceRequest
+	if err := proto.Unmarshal(buf, &snr); err != nil {
+		return err
+	}
+	return d.store.SetNamespace(ctx, NewNamespaceFromProto(snr.GetNamespace()))
+}
+
+func (d *dkronFSM) applyDeleteNamespace(ctx context.Context, buf []byte) interface{} {
+	var dnr dkronpb.DeleteNamespaceRequest
+	if err := proto.Unmarshal(buf, &dnr); err != nil {
+		return err
+	}
+	namespace, err := d.store.DeleteNamespace(ctx, dnr.GetName())
+	if err != nil {
+		return err
+	}
+	return namespace
+}
+
 // Snapshot returns a snapshot of the key-value store. We wrap
 // the things we need in dkronSnapshot and then send that over to Persist.
 // Persist encodes the needed data from dkronSnapshot and transport it to
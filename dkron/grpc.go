@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -13,11 +14,13 @@ import (
 	"github.com/hashicorp/raft"
 	"github.com/hashicorp/serf/serf"
 	"github.com/sirupsen/logrus"
+	"github.com/tidwall/buntdb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -31,6 +34,13 @@ var (
 	ErrNotLeader = errors.New("grpc: Error, server is not leader, this operation should be run on the leader")
 	// ErrBrokenStream is the error that indicates a sudden disconnection of the agent streaming an execution
 	ErrBrokenStream = errors.New("grpc: Error on execution streaming, agent connection was abruptly terminated")
+	// ErrExecutionNotFound is returned when the execution to cancel doesn't exist.
+	ErrExecutionNotFound = errors.New("grpc: Execution not found")
+	// ErrExecutionNotRunning is returned when the execution to cancel is not running anymore.
+	ErrExecutionNotRunning = errors.New("grpc: Execution is not running")
+	// ErrExecutionNotCancellable is returned when the executor of the execution to
+	// cancel doesn't support cancelling it.
+	ErrExecutionNotCancellable = errors.New("grpc: Execution is not cancellable, its executor doesn't support it")
 )
 
 // DkronGRPCServer defines the basics that a gRPC server should implement.
@@ -88,18 +98,106 @@ func (grpcs *GRPCServer) SetJob(ctx context.Context, setJobReq *typesv1.SetJobRe
 		"job": setJobReq.Job.Name,
 	}).Debug("grpc: Received SetJob")
 
-	if err := grpcs.agent.applySetJob(setJobReq.Job); err != nil {
+	// Qualify the job name with its namespace before looking the job up
+	job := NewJobFromProto(setJobReq.Job, grpcs.logger)
+	if err := job.qualify(); err != nil {
 		return nil, err
 	}
+	setJobReq.Job = job.ToProto()
 
-	// If everything is ok, add the job to the scheduler
-	job := NewJobFromProto(setJobReq.Job, grpcs.logger)
+	grpcs.agent.setJobLock.Lock()
+	defer grpcs.agent.setJobLock.Unlock()
+
+	if rev := setJobReq.GetExpectedRevision(); rev != 0 {
+		ej, err := grpcs.agent.Store.GetJob(ctx, setJobReq.Job.GetName(), nil)
+		if err != nil && !errors.Is(err, buntdb.ErrNotFound) {
+			return nil, err
+		}
+		if ej == nil || ej.Revision != rev {
+			return nil, ErrRevisionMismatch
+		}
+	}
+
+	job, err := grpcs.setJob(ctx, setJobReq.Job)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesv1.SetJobResponse{Job: job.ToProto()}, nil
+}
+
+// PatchJob applies a JSON merge patch or a JSON patch to the stored job and
+// broadcasts the patched job like SetJob. This only works on the leader.
+func (grpcs *GRPCServer) PatchJob(ctx context.Context, req *typesv1.PatchJobRequest) (*typesv1.PatchJobResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "patch_job"}, time.Now())
+	grpcs.logger.WithField("job", req.GetJobName()).Debug("grpc: Received PatchJob")
+
+	grpcs.agent.setJobLock.Lock()
+	defer grpcs.agent.setJobLock.Unlock()
+
+	ej, err := grpcs.agent.Store.GetJob(ctx, req.GetJobName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if rev := req.GetExpectedRevision(); rev != 0 && ej.Revision != rev {
+		return nil, ErrRevisionMismatch
+	}
+
+	patched, err := patchJob(ej, req.GetPatchType(), req.GetPatch())
+	if err != nil {
+		return nil, err
+	}
+	patched.UpdatedBy = req.GetActor()
+
+	job, err := grpcs.setJob(ctx, patched.ToProto())
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesv1.PatchJobResponse{Job: job.ToProto()}, nil
+}
+
+// setJob stores the job through raft and schedules it, returning the stored
+// job. The caller holds the setJobLock of the agent.
+func (grpcs *GRPCServer) setJob(ctx context.Context, pbj *typesv1.Job) (*Job, error) {
+	// Check if the submission of the job is paused, matching the metadata
+	// it inherits from its template and its namespace too
+	job := NewJobFromProto(pbj, grpcs.logger)
+	defaults, err := inheritedDefaults(ctx, grpcs.agent.Store, job)
+	if err != nil && !errors.Is(err, ErrJobTemplateNotFound) {
+		return nil, err
+	}
+	if defaults != nil {
+		defaults.apply(job)
+	}
+	pause, err := grpcs.agent.jobPause(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if pause != nil {
+		return nil, pause.err()
+	}
+
+	// Set the time of the change on the leader so all the nodes record
+	// the same time in the job version.
+	pbj.UpdatedAt = timestamppb.Now()
+	if err := grpcs.agent.applySetJob(pbj); err != nil {
+		return nil, err
+	}
+
+	// If everything is ok, add the job to the scheduler, resolved with the
+	// fields inherited from its template and with its new version
+	job, err = grpcs.agent.Store.GetJob(ctx, pbj.GetName(), nil)
+	if err != nil {
+		return nil, err
+	}
 	job.Agent = grpcs.agent
 	if err := grpcs.agent.sched.AddJob(job); err != nil {
 		return nil, err
 	}
+	grpcs.agent.startConsumer(job)
 
-	return &typesv1.SetJobResponse{}, nil
+	return job, nil
 }
 
 // DeleteJob broadcast a state change to the cluster members that will delete the job.
@@ -125,6 +223,7 @@ func (grpcs *GRPCServer) DeleteJob(ctx context.Context, delJobReq *typesv1.Delet
 
 	// If everything is ok, remove the job
 	grpcs.agent.sched.RemoveJob(job.Name)
+	grpcs.agent.stopConsumer(job.Name)
 	if job.Ephemeral {
 		grpcs.logger.WithField("job", job.Name).Info("grpc: Done deleting ephemeral job")
 	}
@@ -231,7 +330,7 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *typesv1
 
 	// If the execution failed, retry it until retries limit (default: don't retry)
 	execution := NewExecutionFromProto(pbex)
-	if !execution.Success &&
+	if !execution.Success && !execution.Cancelled &&
 		uint(execution.Attempt) < job.Retries+1 {
 		// Increment the attempt counter
 		execution.Attempt++
@@ -239,25 +338,29 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *typesv1
 		// Keep all execution properties intact except the last output
 		execution.Output = ""
 
-		eb := execution.CalculateExponentialBackoff()
+		// Store the retry through raft instead of waiting here, the leader
+		// runs it once due, or the next one after a leadership change.
+		retry := &PendingRetry{
+			Execution: execution,
+			RunAt:     time.Now().Add(job.retryDelay(execution)),
+		}
+		execution.ScheduledAt = retry.RunAt
 		grpcs.logger.WithFields(logrus.Fields{
 			"attempt":   execution.Attempt,
 			"execution": execution,
-			"backoff":   eb,
-		}).Debug("grpc: Retrying execution")
-
-		time.Sleep(eb)
+			"run_at":    retry.RunAt,
+		}).Debug("grpc: Scheduling execution retry")
 
-		if _, err := grpcs.agent.Run(ctx, job.Name, execution); err != nil {
+		if err := grpcs.agent.applySetPendingRetry(retry); err != nil {
 			return nil, err
 		}
+		grpcs.agent.scheduleRetry(retry)
 
 		return &typesv1.ExecutionDoneResponse{
 			From:    grpcs.agent.config.NodeName,
 			Payload: []byte("retry"),
 		}, nil
 	}
-
 	exg, err := grpcs.agent.Store.GetExecutionGroup(ctx, execution, &ExecutionOptions{
 		Timezone: job.GetTimeLocation(),
 	})
@@ -271,20 +374,31 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *typesv1
 		return nil, err
 	}
 
-	// Jobs that have dependent jobs are a bit more expensive because we need to call the Status() method for every execution.
-	// Check first if there's dependent jobs and then check for the job status to begin execution dependent jobs on success.
-	if len(job.DependentJobs) > 0 && job.Status == StatusSuccess {
-		for _, djn := range job.DependentJobs {
-			dj, err := grpcs.agent.Store.GetJob(ctx, djn, nil)
-			if err != nil {
+	// The execution group is finished once every node reported the last
+	// attempt of its execution
+	last := lastAttempts(exg, job.Retries)
+	finished := len(last) >= execution.groupSize()
+	if finished {
+		grpcs.agent.executionFinished(execution, last)
+	}
+
+	// The dependent jobs are triggered once per execution group, by the node
+	// reporting last, with the status of the last attempt of every node.
+	// Cancelled executions stop the workflow.
+	if len(job.DependentJobs) > 0 {
+		cancelled := slices.ContainsFunc(last, func(ex *Execution) bool { return ex.Cancelled })
+		if finished && !cancelled {
+			if err := grpcs.runDependentJobs(ctx, job, execution, groupStatus(last)); err != nil {
 				return nil, err
 			}
-			dj.Agent = grpcs.agent
-			grpcs.logger.WithField("job", djn).Debug("grpc: Running dependent job")
-			dj.Run()
 		}
 	}
 
+	// Start the queued executions the finished one was holding back
+	if job.Concurrency == ConcurrencyQueue {
+		grpcs.agent.runQueued(ctx, job)
+	}
+
 	if job.Ephemeral && job.Status == StatusSuccess {
 		if _, err := grpcs.DeleteJob(ctx, &typesv1.DeleteJobRequest{JobName: job.Name}); err != nil {
 			return nil, err
@@ -301,6 +415,275 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *typesv1
 	}, nil
 }
 
+// runDependentJobs runs the dependent jobs of a finished job whose trigger
+// condition matches the status of the execution group. Dependent jobs are only
+// run when the conditions for all of their parents matched in the same workflow
+// run, and only once per run. This state is stored through raft so it survives
+// a leader change.
+func (grpcs *GRPCServer) runDependentJobs(ctx context.Context, job *Job, execution *Execution, status string) error {
+	run := execution.workflowRun()
+	for _, djn := range job.DependentJobs {
+		dj, err := grpcs.agent.Store.GetJob(ctx, djn, nil)
+		if err != nil {
+			return err
+		}
+
+		if !dj.triggeredBy(job.Name, status) {
+			continue
+		}
+
+		ready, err := grpcs.agent.applyParentJobDone(dj.Name, job.Name, run)
+		if err != nil {
+			return err
+		}
+		if !ready {
+			grpcs.logger.WithFields(logrus.Fields{
+				"job":          djn,
+				"workflow_run": run,
+			}).Debug("grpc: Dependent job waiting for other parents or already run")
+			continue
+		}
+
+		dj.Agent = grpcs.agent
+		grpcs.logger.WithField("job", djn).Debug("grpc: Running dependent job")
+		ex := NewExecution(dj.Name, TriggerDependency)
+		ex.WorkflowRun = run
+		ex.ParentExecution = execution.asParent()
+		dj.run(ex)
+	}
+	return nil
+}
+
+// CancelExecution cancels a running execution, calling the agent that runs it.
+func (grpcs *GRPCServer) CancelExecution(ctx context.Context, req *typesv1.CancelExecutionRequest) (*typesv1.CancelExecutionResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "cancel_execution"}, time.Now())
+	grpcs.logger.WithFields(logrus.Fields{
+		"job":       req.JobName,
+		"execution": req.ExecutionId,
+	}).Debug("grpc: Received CancelExecution")
+
+	execution, err := grpcs.agent.Store.GetExecution(ctx, req.JobName, req.ExecutionId)
+	if err != nil {
+		if err == buntdb.ErrNotFound {
+			return nil, ErrExecutionNotFound
+		}
+		return nil, err
+	}
+	if !execution.FinishedAt.IsZero() {
+		return nil, ErrExecutionNotRunning
+	}
+
+	if err := grpcs.agent.cancelExecution(execution); err != nil {
+		return nil, err
+	}
+
+	return &typesv1.CancelExecutionResponse{Execution: execution.ToProto()}, nil
+}
+
+// SetCalendar stores a calendar through raft and reschedules the jobs using it.
+// This only works on the leader
+func (grpcs *GRPCServer) SetCalendar(ctx context.Context, req *typesv1.SetCalendarRequest) (*typesv1.SetCalendarResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "set_calendar"}, time.Now())
+	grpcs.logger.WithField("calendar", req.Calendar.GetName()).Debug("grpc: Received SetCalendar")
+
+	if err := grpcs.agent.applySetCalendar(req.Calendar); err != nil {
+		return nil, err
+	}
+
+	jobs, err := grpcs.agent.Store.GetJobs(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		if job.Calendar != req.Calendar.GetName() {
+			continue
+		}
+		job.Agent = grpcs.agent
+		if err := grpcs.agent.sched.AddJob(job); err != nil {
+			return nil, err
+		}
+	}
+
+	return &typesv1.SetCalendarResponse{Calendar: req.Calendar}, nil
+}
+
+// DeleteCalendar deletes a calendar through raft, calendars used by some jobs
+// can't be deleted.
+// This only works on the leader
+func (grpcs *GRPCServer) DeleteCalendar(ctx context.Context, req *typesv1.DeleteCalendarRequest) (*typesv1.DeleteCalendarResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "delete_calendar"}, time.Now())
+	grpcs.logger.WithField("calendar", req.GetName()).Debug("grpc: Received DeleteCalendar")
+
+	calendar, err := grpcs.agent.applyDeleteCalendar(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesv1.DeleteCalendarResponse{Calendar: calendar.ToProto()}, nil
+}
+
+// SetMaintenanceWindow stores a maintenance window through raft.
+func (grpcs *GRPCServer) SetMaintenanceWindow(ctx context.Context, req *typesv1.SetMaintenanceWindowRequest) (*typesv1.SetMaintenanceWindowResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "set_maintenance_window"}, time.Now())
+	grpcs.logger.WithField("window", req.Window.GetName()).Debug("grpc: Received SetMaintenanceWindow")
+
+	if err := grpcs.agent.applySetMaintenanceWindow(req.Window); err != nil {
+		return nil, err
+	}
+
+	return &typesv1.SetMaintenanceWindowResponse{Window: req.Window}, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window through raft.
+func (grpcs *GRPCServer) DeleteMaintenanceWindow(ctx context.Context, req *typesv1.DeleteMaintenanceWindowRequest) (*typesv1.DeleteMaintenanceWindowResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "delete_maintenance_window"}, time.Now())
+	grpcs.logger.WithField("window", req.GetName()).Debug("grpc: Received DeleteMaintenanceWindow")
+
+	window, err := grpcs.agent.applyDeleteMaintenanceWindow(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesv1.DeleteMaintenanceWindowResponse{Window: window.ToProto()}, nil
+}
+
+// SetPause pauses job submissions through raft.
+func (grpcs *GRPCServer) SetPause(ctx context.Context, req *typesv1.SetPauseRequest) (*typesv1.SetPauseResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "set_pause"}, time.Now())
+	grpcs.logger.WithField("pause", req.Pause.GetId()).Debug("grpc: Received SetPause")
+
+	if err := grpcs.agent.applySetPause(req.Pause); err != nil {
+		return nil, err
+	}
+
+	return &typesv1.SetPauseResponse{Pause: req.Pause}, nil
+}
+
+// DeletePause lifts job submission pauses through raft.
+func (grpcs *GRPCServer) DeletePause(ctx context.Context, req *typesv1.DeletePauseRequest) (*typesv1.DeletePauseResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "delete_pause"}, time.Now())
+	grpcs.logger.WithField("pause", req.GetId()).Debug("grpc: Received DeletePause")
+
+	pauses, err := grpcs.agent.applyDeletePause(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &typesv1.DeletePauseResponse{}
+	for _, p := range pauses {
+		resp.Pauses = append(resp.Pauses, p.ToProto())
+	}
+	return resp, nil
+}
+
+// SetWebhookTrigger stores a webhook trigger through raft.
+func (grpcs *GRPCServer) SetWebhookTrigger(ctx context.Context, req *typesv1.SetWebhookTriggerRequest) (*typesv1.SetWebhookTriggerResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "set_webhook_trigger"}, time.Now())
+	grpcs.logger.WithField("trigger", req.Trigger.GetId()).Debug("grpc: Received SetWebhookTrigger")
+
+	if err := grpcs.agent.applySetWebhookTrigger(req.Trigger); err != nil {
+		return nil, err
+	}
+
+	return &typesv1.SetWebhookTriggerResponse{Trigger: req.Trigger}, nil
+}
+
+// DeleteWebhookTrigger deletes a webhook trigger through raft.
+func (grpcs *GRPCServer) DeleteWebhookTrigger(ctx context.Context, req *typesv1.DeleteWebhookTriggerRequest) (*typesv1.DeleteWebhookTriggerResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "delete_webhook_trigger"}, time.Now())
+	grpcs.logger.WithField("trigger", req.GetId()).Debug("grpc: Received DeleteWebhookTrigger")
+
+	trigger, err := grpcs.agent.applyDeleteWebhookTrigger(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesv1.DeleteWebhookTriggerResponse{Trigger: trigger.ToProto()}, nil
+}
+
+// SetJobTemplate stores a job template through raft and reschedules the jobs
+// inheriting from it.
+// This only works on the leader
+func (grpcs *GRPCServer) SetJobTemplate(ctx context.Context, req *typesv1.SetJobTemplateRequest) (*typesv1.SetJobTemplateResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "set_job_template"}, time.Now())
+	grpcs.logger.WithField("template", req.Template.GetName()).Debug("grpc: Received SetJobTemplate")
+
+	if err := grpcs.agent.applySetJobTemplate(req.Template); err != nil {
+		return nil, err
+	}
+
+	jobs, err := grpcs.agent.Store.GetJobs(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		if job.Template != req.Template.GetName() {
+			continue
+		}
+		job.Agent = grpcs.agent
+		if err := grpcs.agent.sched.AddJob(job); err != nil {
+			return nil, err
+		}
+	}
+
+	return &typesv1.SetJobTemplateResponse{Template: req.Template}, nil
+}
+
+// DeleteJobTemplate deletes a job template through raft, templates used by
+// some jobs can't be deleted.
+// This only works on the leader
+func (grpcs *GRPCServer) DeleteJobTemplate(ctx context.Context, req *typesv1.DeleteJobTemplateRequest) (*typesv1.DeleteJobTemplateResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "delete_job_template"}, time.Now())
+	grpcs.logger.WithField("template", req.GetName()).Debug("grpc: Received DeleteJobTemplate")
+
+	template, err := grpcs.agent.applyDeleteJobTemplate(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesv1.DeleteJobTemplateResponse{Template: template.ToProto()}, nil
+}
+
+// SetNamespace stores a namespace through raft and reschedules its jobs, as
+// they inherit its defaults.
+// This only works on the leader
+func (grpcs *GRPCServer) SetNamespace(ctx context.Context, req *typesv1.SetNamespaceRequest) (*typesv1.SetNamespaceResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "set_namespace"}, time.Now())
+	grpcs.logger.WithField("namespace", req.Namespace.GetName()).Debug("grpc: Received SetNamespace")
+
+	if err := grpcs.agent.applySetNamespace(req.Namespace); err != nil {
+		return nil, err
+	}
+
+	jobs, err := grpcs.agent.Store.GetJobs(ctx, &JobOptions{Sort: "name", Namespace: req.Namespace.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		job.Agent = grpcs.agent
+		if err := grpcs.agent.sched.AddJob(job); err != nil {
+			return nil, err
+		}
+	}
+
+	return &typesv1.SetNamespaceResponse{Namespace: req.Namespace}, nil
+}
+
+// DeleteNamespace deletes a namespace through raft, namespaces that have
+// some jobs can't be deleted.
+// This only works on the leader
+func (grpcs *GRPCServer) DeleteNamespace(ctx context.Context, req *typesv1.DeleteNamespaceRequest) (*typesv1.DeleteNamespaceResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "delete_namespace"}, time.Now())
+	grpcs.logger.WithField("namespace", req.GetName()).Debug("grpc: Received DeleteNamespace")
+
+	namespace, err := grpcs.agent.applyDeleteNamespace(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesv1.DeleteNamespaceResponse{Namespace: namespace.ToProto()}, nil
+}
+
 // Leave calls the Stop method, stopping everything in the server
 func (grpcs *GRPCServer) Leave(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
 	return in, grpcs.agent.Stop()
@@ -308,7 +691,12 @@ func (grpcs *GRPCServer) Leave(ctx context.Context, in *emptypb.Empty) (*emptypb
 
 // RunJob runs a job in the cluster
 func (grpcs *GRPCServer) RunJob(ctx context.Context, req *typesv1.RunJobRequest) (*typesv1.RunJobResponse, error) {
-	ex := NewExecution(req.JobName)
+	trigger := TriggerManual
+	if req.Trigger == TriggerWebhook {
+		trigger = TriggerWebhook
+	}
+	ex := NewExecution(req.JobName, trigger)
+	ex.Parameters = req.Parameters
 	job, err := grpcs.agent.Run(ctx, req.JobName, ex)
 	if err != nil {
 		return nil, err
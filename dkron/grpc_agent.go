@@ -1,12 +1,19 @@
 package dkron
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"text/template"
 	"time"
 
 	"github.com/armon/circbuf"
 	"github.com/armon/go-metrics"
 	typesv1 "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/plugin"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -14,6 +21,11 @@ import (
 const (
 	// maxBufSize limits how much data we collect from a handler.
 	maxBufSize = 256000
+
+	// maxParentOutputSize limits the size of the parent output passed to the
+	// dependent executions. It's replicated through raft with every execution,
+	// and the OS refuses to start processes with too big variables.
+	maxParentOutputSize = 32768
 )
 
 type statusAgentHelper struct {
@@ -32,6 +44,9 @@ func (s *statusAgentHelper) Update(b []byte, c bool) (int64, error) {
 	return 0, nil
 }
 
+// ErrExecutionCancelled is the error reported by the executions that were cancelled.
+var ErrExecutionCancelled = errors.New("grpc_agent: Execution cancelled")
+
 // GRPCAgentServer is the local implementation of the gRPC server interface.
 type AgentServer struct {
 	typesv1.AgentServiceServer
@@ -64,7 +79,6 @@ func (as *AgentServer) AgentRun(req *typesv1.AgentRunRequest, stream typesv1.Age
 	var success bool
 
 	jex := job.Executor
-	exc := job.ExecutorConfig
 
 	// Send the first update with the initial execution state to be stored in the server
 	execution.StartedAt = timestamppb.Now()
@@ -81,17 +95,48 @@ func (as *AgentServer) AgentRun(req *typesv1.AgentRunRequest, stream typesv1.Age
 	}
 
 	// Check if executor exists
-	if executor, ok := as.agent.ExecutorPlugins[jex]; ok {
+	executor, ok := as.agent.ExecutorPlugins[jex]
+	exc, renderErr := renderExecutorConfig(job.ExecutorConfig, execution)
+	if !ok {
+		as.logger.WithField("executor", jex).Error("grpc_agent: Specified executor is not present")
+		_, _ = output.Write([]byte("grpc_agent: Specified executor is not present"))
+	} else if renderErr != nil {
+		as.logger.WithError(renderErr).WithField("job", job.Name).Error("grpc_agent: Error rendering executor config")
+		_, _ = output.Write([]byte(renderErr.Error() + "\n"))
+	} else {
 		as.logger.WithField("plugin", jex).Debug("grpc_agent: calling executor plugin")
 		runningExecutions.Store(execution.GetGroup(), execution)
-		out, err := executor.Execute(&typesv1.ExecuteRequest{
+		req := &typesv1.ExecuteRequest{
 			JobName: job.Name,
 			Config:  exc,
-		}, &statusAgentHelper{
+			Env:     parentExecutionEnv(execution.ParentExecution),
+		}
+		helper := &statusAgentHelper{
 			stream:    stream,
 			execution: execution,
-		})
+		}
+
+		var out *typesv1.ExecuteResponse
+		var err error
+		// Executors that accept a context can be cancelled while running
+		if ce, ok := executor.(plugin.ContextExecutor); ok {
+			ctx, cancel := context.WithCancel(context.Background())
+			executionCancels.Store(execution.Key(), cancel)
+			out, err = ce.ExecuteContext(ctx, req, helper)
+			executionCancels.Delete(execution.Key())
+			execution.Cancelled = ctx.Err() != nil
+			cancel()
+		} else {
+			// Record the execution as running but not cancellable
+			executionCancels.Store(execution.Key(), context.CancelFunc(nil))
+			out, err = executor.Execute(req, helper)
+			executionCancels.Delete(execution.Key())
+		}
 
+		if execution.Cancelled {
+			as.logger.WithField("job", job.Name).Info("grpc_agent: Execution cancelled")
+			err = ErrExecutionCancelled
+		}
 		if err == nil && out.Error != "" {
 			err = errors.New(out.Error)
 		}
@@ -106,9 +151,6 @@ func (as *AgentServer) AgentRun(req *typesv1.AgentRunRequest, stream typesv1.Age
 		if out != nil {
 			_, _ = output.Write(out.Output)
 		}
-	} else {
-		as.logger.WithField("executor", jex).Error("grpc_agent: Specified executor is not present")
-		_, _ = output.Write([]byte("grpc_agent: Specified executor is not present"))
 	}
 
 	execution.FinishedAt = timestamppb.Now()
@@ -132,3 +174,86 @@ func (as *AgentServer) AgentRun(req *typesv1.AgentRunRequest, stream typesv1.Age
 
 	return nil
 }
+
+// AgentCancel cancels an execution running in this agent, the execution
+// finishes as usual once the executor stopped it.
+func (as *AgentServer) AgentCancel(ctx context.Context, req *typesv1.AgentCancelRequest) (*typesv1.AgentCancelResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc_agent", "agent_cancel"}, time.Now())
+
+	v, ok := executionCancels.Load(req.ExecutionId)
+	if !ok {
+		return &typesv1.AgentCancelResponse{}, nil
+	}
+	cancel := v.(context.CancelFunc)
+	if cancel == nil {
+		return &typesv1.AgentCancelResponse{Found: true, NotCancellable: true}, nil
+	}
+
+	as.logger.WithField("execution", req.ExecutionId).Info("grpc_agent: Cancelling execution")
+	cancel()
+	return &typesv1.AgentCancelResponse{Found: true}, nil
+}
+
+// parentExecutionEnv returns the environment variables describing the parent
+// execution that triggered a dependent job execution.
+func parentExecutionEnv(p *typesv1.Execution) map[string]string {
+	if p == nil {
+		return nil
+	}
+
+	output := p.Output
+	if len(output) > maxParentOutputSize {
+		output = output[len(output)-maxParentOutputSize:]
+	}
+
+	return map[string]string{
+		"ENV_PARENT_JOB_NAME": p.JobName,
+		"ENV_PARENT_SUCCESS":  strconv.FormatBool(p.Success),
+		"ENV_PARENT_GROUP":    strconv.FormatInt(p.Group, 10),
+		"ENV_PARENT_NODE":     p.NodeName,
+		"ENV_PARENT_OUTPUT":   string(output),
+	}
+}
+
+// templateDataRegexp matches the executor config values using the variables
+// dkron passes to the templates.
+var templateDataRegexp = regexp.MustCompile(`\{\{[^}]*\.(Parent|Parameters)\b`)
+
+// renderExecutorConfig renders the executor config values as templates having
+// the parent execution available as {{.Parent}} and the run parameters as
+// {{.Parameters}}. Values not using them are passed unchanged, as they may
+// contain templates meant for the executor, while the values using them must
+// render or the execution fails.
+func renderExecutorConfig(config map[string]string, ex *typesv1.Execution) (map[string]string, error) {
+	data := struct {
+		Parent     *Execution
+		Parameters map[string]string
+	}{
+		// The parent is empty when the job is not run by its parent
+		Parent:     &Execution{},
+		Parameters: ex.Parameters,
+	}
+	if ex.ParentExecution != nil {
+		data.Parent = NewExecutionFromProto(ex.ParentExecution)
+	}
+
+	rendered := make(map[string]string, len(config))
+	for k, v := range config {
+		rendered[k] = v
+		if !templateDataRegexp.MatchString(v) {
+			continue
+		}
+
+		t, err := template.New(k).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("grpc_agent: error parsing executor config %s: %w", k, err)
+		}
+		var out bytes.Buffer
+		if err := t.Execute(&out, data); err != nil {
+			return nil, fmt.Errorf("grpc_agent: error rendering executor config %s: %w", k, err)
+		}
+		rendered[k] = out.String()
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,235 @@
+package dkron
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	typesv1 "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/plugin"
+	"github.com/hashicorp/serf/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParentExecutionEnv(t *testing.T) {
+	assert.Nil(t, parentExecutionEnv(nil))
+
+	p := &typesv1.Execution{
+		JobName:  "parent_job",
+		Success:  true,
+		Group:    1234,
+		NodeName: "node1",
+		Output:   []byte("/tmp/report.csv"),
+	}
+	env := parentExecutionEnv(p)
+	assert.Equal(t, "parent_job", env["ENV_PARENT_JOB_NAME"])
+	assert.Equal(t, "true", env["ENV_PARENT_SUCCESS"])
+	assert.Equal(t, "1234", env["ENV_PARENT_GROUP"])
+	assert.Equal(t, "node1", env["ENV_PARENT_NODE"])
+	assert.Equal(t, "/tmp/report.csv", env["ENV_PARENT_OUTPUT"])
+
+	// Big outputs are truncated keeping the end of the output
+	p.Output = []byte(strings.Repeat("a", maxParentOutputSize) + "end")
+	env = parentExecutionEnv(p)
+	assert.Len(t, env["ENV_PARENT_OUTPUT"], maxParentOutputSize)
+	assert.True(t, strings.HasSuffix(env["ENV_PARENT_OUTPUT"], "end"))
+}
+
+func TestExecutionAsParent(t *testing.T) {
+	ex := &Execution{
+		JobName:         "parent_job",
+		Group:           1234,
+		NodeName:        "node1",
+		Success:         true,
+		Output:          strings.Repeat("a", maxBufSize) + "end",
+		Parameters:      map[string]string{"date": "today"},
+		ParentExecution: &Execution{JobName: "grandparent_job"},
+	}
+
+	// Only a summary of the execution is passed to its dependents
+	p := ex.asParent()
+	assert.Equal(t, "parent_job", p.JobName)
+	assert.Equal(t, int64(1234), p.Group)
+	assert.Equal(t, "node1", p.NodeName)
+	assert.True(t, p.Success)
+	assert.Len(t, p.Output, maxParentOutputSize)
+	assert.True(t, strings.HasSuffix(p.Output, "end"))
+	assert.Nil(t, p.Parameters)
+	assert.Nil(t, p.ParentExecution)
+}
+
+func TestRenderExecutorConfig(t *testing.T) {
+	ex := &typesv1.Execution{
+		JobName: "child_job",
+		ParentExecution: &typesv1.Execution{
+			JobName:  "parent_job",
+			Success:  true,
+			NodeName: "node1",
+			Output:   []byte("/tmp/report.csv"),
+		},
+	}
+	config := map[string]string{
+		"url":    "http://example.com/import?file={{.Parent.Output}}&node={{.Parent.NodeName}}",
+		"body":   `{"success": {{.Parent.Success}}}`,
+		"format": "{{.ID}}",
+		"method": "POST",
+	}
+
+	rendered, err := renderExecutorConfig(config, ex)
+	require.NoError(t, err)
+	assert.Equal(t, "http://example.com/import?file=/tmp/report.csv&node=node1", rendered["url"])
+	assert.Equal(t, `{"success": true}`, rendered["body"])
+	// Values that are not templates for dkron are kept unchanged
+	assert.Equal(t, "{{.ID}}", rendered["format"])
+	assert.Equal(t, "POST", rendered["method"])
+	// The job config is not modified
+	assert.Equal(t, "{{.ID}}", config["format"])
+	assert.Contains(t, config["url"], "{{.Parent.Output}}")
+}
+
+func TestRenderExecutorConfigParameters(t *testing.T) {
+	ex := &typesv1.Execution{
+		JobName:    "test_job",
+		Parameters: map[string]string{"date": "2024-01-31", "from": ""},
+	}
+	config := map[string]string{
+		"command": "reprocess --date {{.Parameters.date}}",
+		"empty":   "reprocess --from '{{.Parameters.from}}'",
+		"parent":  "{{.Parent.Output}}",
+	}
+
+	rendered, err := renderExecutorConfig(config, ex)
+	require.NoError(t, err)
+	assert.Equal(t, "reprocess --date 2024-01-31", rendered["command"])
+	assert.Equal(t, "reprocess --from ''", rendered["empty"])
+	// The parent is empty when the job is not run by its parent
+	assert.Equal(t, "", rendered["parent"])
+
+	// Values using undeclared parameters fail the execution
+	config["missing"] = "reprocess --to {{.Parameters.to}}"
+	_, err = renderExecutorConfig(config, ex)
+	assert.Error(t, err)
+}
+
+// blockingExecutor runs until its execution is cancelled.
+type blockingExecutor struct{}
+
+func (e *blockingExecutor) Execute(args *typesv1.ExecuteRequest, cb plugin.StatusHelper) (*typesv1.ExecuteResponse, error) {
+	return e.ExecuteContext(context.Background(), args, cb)
+}
+
+func (e *blockingExecutor) ExecuteContext(ctx context.Context, args *typesv1.ExecuteRequest, cb plugin.StatusHelper) (*typesv1.ExecuteResponse, error) {
+	<-ctx.Done()
+	return &typesv1.ExecuteResponse{Output: []byte("stopped")}, nil
+}
+
+// plainExecutor runs until released, it can't be cancelled.
+type plainExecutor struct {
+	release chan struct{}
+}
+
+func (e *plainExecutor) Execute(args *typesv1.ExecuteRequest, cb plugin.StatusHelper) (*typesv1.ExecuteResponse, error) {
+	<-e.release
+	return &typesv1.ExecuteResponse{Output: []byte("done")}, nil
+}
+
+func TestGRPCCancelExecution(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dkron-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ip1, returnFn1 := testutil.TakeIP()
+	defer returnFn1()
+
+	c := DefaultConfig()
+	c.BindAddr = ip1.String()
+	c.NodeName = "test-cancel"
+	c.Server = true
+	c.LogLevel = logLevel
+	c.BootstrapExpect = 1
+	c.DevMode = true
+	c.DataDir = dir
+
+	a := NewAgent(c)
+	plain := &plainExecutor{release: make(chan struct{})}
+	a.ExecutorPlugins = map[string]plugin.Executor{"blocking": &blockingExecutor{}, "plain": plain}
+	require.NoError(t, a.Start())
+	defer a.Stop() // nolint: errcheck
+
+	for !a.IsLeader() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	ctx := context.Background()
+	rc := NewGRPCClient(nil, a, getTestLogger())
+
+	err = rc.SetJob(&Job{
+		Name:     "test_job",
+		Schedule: "@manually",
+		Executor: "blocking",
+		Retries:  2,
+	}, 0)
+	require.NoError(t, err)
+
+	_, err = rc.CancelExecution(a.advertiseRPCAddr(), "test_job", "not_an_execution")
+	assert.ErrorContains(t, err, ErrExecutionNotFound.Error())
+
+	go rc.RunJob("test_job", nil, TriggerManual) // nolint: errcheck
+
+	var running []*Execution
+	require.Eventually(t, func() bool {
+		running, err = a.Store.GetRunningExecutions(ctx, "test_job")
+		return err == nil && len(running) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	_, err = rc.CancelExecution(a.advertiseRPCAddr(), "test_job", running[0].Id)
+	require.NoError(t, err)
+
+	var ex *Execution
+	require.Eventually(t, func() bool {
+		ex, err = a.Store.GetExecution(ctx, "test_job", running[0].Id)
+		return err == nil && !ex.FinishedAt.IsZero()
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.True(t, ex.Cancelled)
+	assert.False(t, ex.Success)
+	assert.Contains(t, ex.Output, ErrExecutionCancelled.Error())
+
+	// Cancelled executions are not retried
+	time.Sleep(500 * time.Millisecond)
+	execs, err := a.Store.GetExecutions(ctx, "test_job", &ExecutionOptions{})
+	require.NoError(t, err)
+	assert.Len(t, execs, 1)
+
+	_, err = rc.CancelExecution(a.advertiseRPCAddr(), "test_job", running[0].Id)
+	assert.ErrorContains(t, err, ErrExecutionNotRunning.Error())
+
+	// Executors without cancellation support keep running
+	err = rc.SetJob(&Job{
+		Name:     "plain_job",
+		Schedule: "@manually",
+		Executor: "plain",
+	}, 0)
+	require.NoError(t, err)
+
+	go rc.RunJob("plain_job", nil, TriggerManual) // nolint: errcheck
+
+	require.Eventually(t, func() bool {
+		running, err = a.Store.GetRunningExecutions(ctx, "plain_job")
+		return err == nil && len(running) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	_, err = rc.CancelExecution(a.advertiseRPCAddr(), "plain_job", running[0].Id)
+	assert.ErrorContains(t, err, ErrExecutionNotCancellable.Error())
+
+	close(plain.release)
+	require.Eventually(t, func() bool {
+		ex, err = a.Store.GetExecution(ctx, "plain_job", running[0].Id)
+		return err == nil && !ex.FinishedAt.IsZero()
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.True(t, ex.Success)
+	assert.False(t, ex.Cancelled)
+}
@@ -21,16 +21,31 @@ type DkronGRPCClient interface {
 	Connect(string) (*grpc.ClientConn, error)
 	ExecutionDone(string, *Execution) error
 	GetJob(string, string) (*Job, error)
-	SetJob(*Job) error
+	SetJob(*Job, uint64) error
+	PatchJob(string, string, []byte, uint64, string) (*Job, error)
 	DeleteJob(string) (*Job, error)
 	DeleteExecutions(string) (*Job, error)
 	Leave(string) error
-	RunJob(string) (*Job, error)
+	RunJob(string, map[string]string, string) (*Job, error)
 	RaftGetConfiguration(string) (*typesv1.RaftGetConfigurationResponse, error)
 	RaftRemovePeerByID(string, string) error
 	GetActiveExecutions(string) ([]*typesv1.Execution, error)
 	SetExecution(execution *typesv1.Execution) error
 	AgentRun(addr string, job *typesv1.Job, execution *typesv1.Execution) error
+	CancelExecution(addr string, jobName string, executionID string) (*Execution, error)
+	AgentCancel(addr string, executionID string) (bool, error)
+	SetCalendar(*Calendar) error
+	DeleteCalendar(string) (*Calendar, error)
+	SetMaintenanceWindow(*MaintenanceWindow) error
+	DeleteMaintenanceWindow(string) (*MaintenanceWindow, error)
+	SetPause(*Pause) error
+	DeletePause(string) ([]*Pause, error)
+	SetWebhookTrigger(*WebhookTrigger) error
+	DeleteWebhookTrigger(string) (*WebhookTrigger, error)
+	SetJobTemplate(*JobTemplate) error
+	DeleteJobTemplate(string) (*JobTemplate, error)
+	SetNamespace(*Namespace) error
+	DeleteNamespace(string) (*Namespace, error)
 }
 
 // GRPCClient is the local implementation of the DkronGRPCClient interface.
@@ -165,8 +180,11 @@ func (grpcc *GRPCClient) Leave(addr string) error {
 	return nil
 }
 
-// SetJob calls the leader passing the job
-func (grpcc *GRPCClient) SetJob(job *Job) error {
+// SetJob calls the leader passing the job, the update fails with
+// ErrRevisionMismatch when the revision of the stored job is not the
+// expected one. Zero skips the check. The job gets its new version and
+// revision.
+func (grpcc *GRPCClient) SetJob(job *Job, expectedRevision uint64) error {
 	var conn *grpc.ClientConn
 
 	addr := grpcc.agent.raft.Leader()
@@ -184,8 +202,9 @@ func (grpcc *GRPCClient) SetJob(job *Job) error {
 
 	// Synchronous call
 	d := typesv1.NewDkronClient(conn)
-	_, err = d.SetJob(context.Background(), &typesv1.SetJobRequest{
-		Job: job.ToProto(),
+	resp, err := d.SetJob(context.Background(), &typesv1.SetJobRequest{
+		Job:              job.ToProto(),
+		ExpectedRevision: expectedRevision,
 	})
 	if err != nil {
 		grpcc.logger.WithError(err).WithFields(logrus.Fields{
@@ -194,9 +213,59 @@ func (grpcc *GRPCClient) SetJob(job *Job) error {
 		}).Error("grpc: Error calling gRPC method")
 		return err
 	}
+	if sj := resp.GetJob(); sj != nil {
+		job.Version = sj.Version
+		job.Revision = sj.Revision
+		job.UpdatedBy = sj.UpdatedBy
+		if sj.UpdatedAt != nil {
+			job.UpdatedAt = sj.UpdatedAt.AsTime()
+		}
+		if sj.CreatedAt != nil {
+			job.CreatedAt = sj.CreatedAt.AsTime()
+		}
+	}
 	return nil
 }
 
+// PatchJob calls the leader to patch a job on behalf of the actor, returning
+// the patched job. Like SetJob, it fails with ErrRevisionMismatch when the
+// revision of the stored job is not the expected one, zero skips the check.
+func (grpcc *GRPCClient) PatchJob(jobName, patchType string, patch []byte, expectedRevision uint64, actor string) (*Job, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "PatchJob",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.PatchJob(context.Background(), &typesv1.PatchJobRequest{
+		JobName:          jobName,
+		PatchType:        patchType,
+		Patch:            patch,
+		ExpectedRevision: expectedRevision,
+		Actor:            actor,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "PatchJob",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewJobFromProto(res.Job, grpcc.logger), nil
+}
+
 // DeleteJob calls the leader passing the job name
 func (grpcc *GRPCClient) DeleteJob(jobName string) (*Job, error) {
 	var conn *grpc.ClientConn
@@ -271,8 +340,9 @@ func (grpcc *GRPCClient) DeleteExecutions(jobName string) (*Job, error) {
 	return job, nil
 }
 
-// RunJob calls the leader passing the job name
-func (grpcc *GRPCClient) RunJob(jobName string) (*Job, error) {
+// RunJob calls the leader passing the job name, the run parameters and what
+// triggered the run, manual when empty
+func (grpcc *GRPCClient) RunJob(jobName string, params map[string]string, trigger string) (*Job, error) {
 	var conn *grpc.ClientConn
 
 	addr := grpcc.agent.raft.Leader()
@@ -291,7 +361,9 @@ func (grpcc *GRPCClient) RunJob(jobName string) (*Job, error) {
 	// Synchronous call
 	d := typesv1.NewDkronClient(conn)
 	res, err := d.RunJob(context.Background(), &typesv1.RunJobRequest{
-		JobName: jobName,
+		JobName:    jobName,
+		Parameters: params,
+		Trigger:    trigger,
 	})
 	if err != nil {
 		grpcc.logger.WithError(err).WithFields(logrus.Fields{
@@ -504,3 +576,463 @@ func (grpcc *GRPCClient) AgentRun(addr string, job *typesv1.Job, execution *type
 		}
 	}
 }
+
+// CancelExecution calls the server to cancel a running execution
+func (grpcc *GRPCClient) CancelExecution(addr string, jobName string, executionID string) (*Execution, error) {
+	var conn *grpc.ClientConn
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(addr)
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "CancelExecution",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.CancelExecution(context.Background(), &typesv1.CancelExecutionRequest{
+		JobName:     jobName,
+		ExecutionId: executionID,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "CancelExecution",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewExecutionFromProto(res.Execution), nil
+}
+
+// SetCalendar calls the leader passing the calendar
+func (grpcc *GRPCClient) SetCalendar(calendar *Calendar) error {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetCalendar",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	_, err = d.SetCalendar(context.Background(), &typesv1.SetCalendarRequest{
+		Calendar: calendar.ToProto(),
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetCalendar",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// DeleteCalendar calls the leader passing the calendar name
+func (grpcc *GRPCClient) DeleteCalendar(name string) (*Calendar, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteCalendar",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.DeleteCalendar(context.Background(), &typesv1.DeleteCalendarRequest{
+		Name: name,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteCalendar",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewCalendarFromProto(res.Calendar), nil
+}
+
+// SetMaintenanceWindow calls the leader passing the maintenance window
+func (grpcc *GRPCClient) SetMaintenanceWindow(window *MaintenanceWindow) error {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetMaintenanceWindow",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	_, err = d.SetMaintenanceWindow(context.Background(), &typesv1.SetMaintenanceWindowRequest{
+		Window: window.ToProto(),
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetMaintenanceWindow",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// DeleteMaintenanceWindow calls the leader passing the maintenance window name
+func (grpcc *GRPCClient) DeleteMaintenanceWindow(name string) (*MaintenanceWindow, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteMaintenanceWindow",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.DeleteMaintenanceWindow(context.Background(), &typesv1.DeleteMaintenanceWindowRequest{
+		Name: name,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteMaintenanceWindow",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewMaintenanceWindowFromProto(res.Window), nil
+}
+
+// SetPause calls the leader passing the pause of job submissions
+func (grpcc *GRPCClient) SetPause(pause *Pause) error {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetPause",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	_, err = d.SetPause(context.Background(), &typesv1.SetPauseRequest{
+		Pause: pause.ToProto(),
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetPause",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// DeletePause calls the leader passing the id of the pause to lift
+func (grpcc *GRPCClient) DeletePause(id string) ([]*Pause, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeletePause",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.DeletePause(context.Background(), &typesv1.DeletePauseRequest{
+		Id: id,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeletePause",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	pauses := []*Pause{}
+	for _, p := range res.Pauses {
+		pauses = append(pauses, NewPauseFromProto(p))
+	}
+	return pauses, nil
+}
+
+// SetWebhookTrigger calls the leader passing the webhook trigger
+func (grpcc *GRPCClient) SetWebhookTrigger(trigger *WebhookTrigger) error {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetWebhookTrigger",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	_, err = d.SetWebhookTrigger(context.Background(), &typesv1.SetWebhookTriggerRequest{
+		Trigger: trigger.ToProto(),
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetWebhookTrigger",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// DeleteWebhookTrigger calls the leader passing the webhook trigger id
+func (grpcc *GRPCClient) DeleteWebhookTrigger(id string) (*WebhookTrigger, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteWebhookTrigger",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.DeleteWebhookTrigger(context.Background(), &typesv1.DeleteWebhookTriggerRequest{
+		Id: id,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteWebhookTrigger",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewWebhookTriggerFromProto(res.Trigger), nil
+}
+
+// SetJobTemplate calls the leader passing the job template
+func (grpcc *GRPCClient) SetJobTemplate(template *JobTemplate) error {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetJobTemplate",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	_, err = d.SetJobTemplate(context.Background(), &typesv1.SetJobTemplateRequest{
+		Template: template.ToProto(),
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetJobTemplate",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// DeleteJobTemplate calls the leader passing the job template name
+func (grpcc *GRPCClient) DeleteJobTemplate(name string) (*JobTemplate, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteJobTemplate",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.DeleteJobTemplate(context.Background(), &typesv1.DeleteJobTemplateRequest{
+		Name: name,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteJobTemplate",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewJobTemplateFromProto(res.Template), nil
+}
+
+// SetNamespace calls the leader passing the namespace
+func (grpcc *GRPCClient) SetNamespace(namespace *Namespace) error {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetNamespace",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	_, err = d.SetNamespace(context.Background(), &typesv1.SetNamespaceRequest{
+		Namespace: namespace.ToProto(),
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetNamespace",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// DeleteNamespace calls the leader passing the namespace name
+func (grpcc *GRPCClient) DeleteNamespace(name string) (*Namespace, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteNamespace",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := typesv1.NewDkronClient(conn)
+	res, err := d.DeleteNamespace(context.Background(), &typesv1.DeleteNamespaceRequest{
+		Name: name,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteNamespace",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewNamespaceFromProto(res.Namespace), nil
+}
+
+// AgentCancel calls the agent running an execution to cancel it
+func (grpcc *GRPCClient) AgentCancel(addr string, executionID string) (bool, error) {
+	var conn *grpc.ClientConn
+
+	// Initiate a connection with the agent
+	conn, err := grpcc.Connect(addr)
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":     "AgentCancel",
+			"agent_addr": addr,
+		}).Error("grpc: error dialing.")
+		return false, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	a := typesv1.NewAgentServiceClient(conn)
+	res, err := a.AgentCancel(context.Background(), &typesv1.AgentCancelRequest{
+		ExecutionId: executionID,
+	})
+	if err != nil {
+		grpcc.logger.WithError(err).WithFields(logrus.Fields{
+			"method":     "AgentCancel",
+			"agent_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return false, err
+	}
+	if res.NotCancellable {
+		return true, ErrExecutionNotCancellable
+	}
+
+	return res.Found, nil
+}
@@ -181,4 +181,146 @@ func TestGRPCExecutionDone(t *testing.T) {
 		assert.NotNil(t, resp)
 		assert.Equal(t, []byte("retry"), resp.Payload)
 	})
+
+	t.Run("Test job retry is stored as pending retry", func(t *testing.T) {
+		testJob.RetryBackoff = &RetryBackoff{Strategy: RetryBackoffFixed, BaseDelay: "1h"}
+		err = a.Store.SetJob(ctx, testJob, true)
+		require.NoError(t, err)
+
+		testExecution.StartedAt = time.Now()
+		testExecution.Attempt = 1
+		testExecution.Trigger = TriggerWebhook
+
+		resp, err := a.GRPCServer.(*GRPCServer).ExecutionDone(ctx, &types.ExecutionDoneRequest{
+			Execution: testExecution.ToProto(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("retry"), resp.Payload)
+
+		retries, err := a.Store.GetPendingRetries(ctx, testJob.Name)
+		require.NoError(t, err)
+		var retry *PendingRetry
+		for _, r := range retries {
+			if r.ID() == testExecution.Key() {
+				retry = r
+			}
+		}
+		require.NotNil(t, retry)
+		assert.Equal(t, uint(2), retry.Execution.Attempt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), retry.RunAt, time.Minute)
+		assert.Equal(t, TriggerWebhook, retry.Execution.Trigger)
+		assert.True(t, retry.RunAt.Equal(retry.Execution.ScheduledAt))
+
+		// Losing leadership keeps the retry for the next leader
+		a.stopRetries()
+		retries, err = a.Store.GetPendingRetries(ctx, testJob.Name)
+		require.NoError(t, err)
+		assert.NotEmpty(t, retries)
+	})
+}
+
+func TestGRPCExecutionDoneMultiNode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dkron-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	viper.Reset()
+
+	ip1, returnFn1 := testutil.TakeIP()
+	defer returnFn1()
+
+	c := DefaultConfig()
+	c.BindAddr = ip1.String()
+	c.NodeName = "test-grpc-multi"
+	c.Server = true
+	c.LogLevel = logLevel
+	c.BootstrapExpect = 1
+	c.DevMode = true
+	c.DataDir = dir
+
+	a := NewAgent(c)
+	_ = a.Start()
+	defer func() { _ = a.Stop() }()
+
+	for !a.IsLeader() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	ctx := context.Background()
+	require.NoError(t, a.Store.SetJob(ctx, &Job{
+		Name:           "multi",
+		Schedule:       "@manually",
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"command": "/bin/true"},
+		Disabled:       true,
+	}, true))
+	for name, trigger := range map[string]string{
+		"multi-always":  TriggerAlways,
+		"multi-partial": TriggerOnPartialFailure,
+		"multi-success": TriggerOnSuccess,
+	} {
+		require.NoError(t, a.Store.SetJob(ctx, &Job{
+			Name:               name,
+			ParentJob:          "multi",
+			DependencyTriggers: map[string]string{"multi": trigger},
+			Executor:           "shell",
+			ExecutorConfig:     map[string]string{"command": "/bin/true"},
+		}, true))
+	}
+
+	childRuns := func(name string) int {
+		execs, err := a.Store.GetExecutions(ctx, name, &ExecutionOptions{})
+		if err != nil {
+			return 0
+		}
+		return len(execs)
+	}
+
+	group := time.Now().UnixNano()
+	executionDone := func(node string, success bool) {
+		now := time.Now()
+		ex := &Execution{
+			JobName:    "multi",
+			Group:      group,
+			Attempt:    1,
+			GroupSize:  2,
+			StartedAt:  now,
+			FinishedAt: now,
+			NodeName:   node,
+			Success:    success,
+		}
+		_, err := a.GRPCServer.(*GRPCServer).ExecutionDone(ctx, &types.ExecutionDoneRequest{
+			Execution: ex.ToProto(),
+		})
+		require.NoError(t, err)
+	}
+
+	// A running execution of the second node doesn't count as failed
+	_, err = a.Store.SetExecution(ctx, &Execution{
+		JobName:   "multi",
+		Group:     group,
+		StartedAt: time.Now(),
+		NodeName:  "node2",
+	})
+	require.NoError(t, err)
+
+	// The dependents and the trigger waiters wait for every node of the group
+	done := a.waitExecution(&Execution{JobName: "multi", Group: group})
+	executionDone("node1", true)
+	assert.Equal(t, 0, childRuns("multi-always"))
+	assert.Equal(t, 0, childRuns("multi-partial"))
+	assert.Empty(t, done)
+
+	// The last node triggers them once with the status of the group
+	executionDone("node2", false)
+	require.Len(t, done, 1)
+	assert.Equal(t, StatusPartiallyFailed, groupStatus(<-done))
+	assert.Equal(t, 1, childRuns("multi-always"))
+	assert.Equal(t, 1, childRuns("multi-partial"))
+	assert.Equal(t, 0, childRuns("multi-success"))
+
+	// Reporting the group again doesn't run them again
+	executionDone("node2", false)
+	assert.Equal(t, 1, childRuns("multi-always"))
+	assert.Equal(t, 1, childRuns("multi-partial"))
 }
@@ -4,15 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"math/rand"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/distribworks/dkron/v4/extcron"
 	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
 	"github.com/distribworks/dkron/v4/ntime"
 	"github.com/distribworks/dkron/v4/plugin"
+	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/tidwall/buntdb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -34,6 +39,65 @@ const (
 	ConcurrencyAllow = "allow"
 	// ConcurrencyForbid forbids a job from executing concurrency.
 	ConcurrencyForbid = "forbid"
+	// ConcurrencyReplace cancels the running executions of a job to start the new one.
+	ConcurrencyReplace = "replace"
+	// ConcurrencyQueue queues the executions of a job until the running ones finish.
+	ConcurrencyQueue = "queue"
+
+	// defaultMaxQueueDepth is the number of executions a job with the queue
+	// concurrency policy holds when no maximum queue depth is set.
+	defaultMaxQueueDepth = 10
+
+	// TriggerOnSuccess runs a dependent job when its parent execution succeeded.
+	TriggerOnSuccess = "on_success"
+	// TriggerOnFailure runs a dependent job when its parent execution failed on all nodes.
+	TriggerOnFailure = "on_failure"
+	// TriggerOnPartialFailure runs a dependent job when its parent execution failed on only some nodes.
+	TriggerOnPartialFailure = "on_partial_failure"
+	// TriggerAlways runs a dependent job whatever the result of its parent execution.
+	TriggerAlways = "always"
+
+	// RetryBackoffFixed waits the base delay before every retry.
+	RetryBackoffFixed = "fixed"
+	// RetryBackoffLinear waits the base delay times the retry number before every retry.
+	RetryBackoffLinear = "linear"
+	// RetryBackoffExponential doubles the base delay on every retry.
+	RetryBackoffExponential = "exponential"
+
+	// RetryPlacementSameNode retries a failed execution only on the node where it failed.
+	RetryPlacementSameNode = "same_node"
+	// RetryPlacementAnyNode retries a failed execution on the node where it failed
+	// if it is still alive, or on any other node matching the job tags. This is
+	// the default.
+	RetryPlacementAnyNode = "any_node"
+	// RetryPlacementDifferentNode retries a failed execution on a node matching the
+	// job tags other than the one where it failed.
+	RetryPlacementDifferentNode = "different_node"
+
+	// SelectorRandom picks random target nodes.
+	SelectorRandom = "random"
+	// SelectorRoundRobin picks the target nodes in turns.
+	SelectorRoundRobin = "round_robin"
+	// SelectorLeastBusy picks the target nodes with the fewest running executions.
+	SelectorLeastBusy = "least_busy"
+	// SelectorConsistentHash picks the target nodes by hashing the job name, so the
+	// job keeps running in the same nodes.
+	SelectorConsistentHash = "consistent_hash"
+	// SelectorSpread picks the target nodes spread across the values of a node tag,
+	// it is used as "spread:<tag>".
+	SelectorSpread = "spread"
+
+	// MisfireSkip doesn't run the executions missed while there was no leader.
+	MisfireSkip = "skip"
+	// MisfireRunOnce runs the last execution missed while there was no leader.
+	MisfireRunOnce = "run_once"
+	// MisfireRunAll runs all the executions missed while there was no leader, up to
+	// the misfire limit.
+	MisfireRunAll = "run_all"
+
+	// defaultMisfireLimit is the number of missed executions the run_all misfire
+	// policy runs when no limit is set.
+	defaultMisfireLimit = 10
 
 	// HashSymbol is the "magic" character used in scheduled to be replaced with a value based on job name
 	HashSymbol = "~"
@@ -51,7 +115,48 @@ var (
 	// ErrNoCommand is returned when attempting to store a job that has no command.
 	ErrNoCommand = errors.New("unspecified command for job")
 	// ErrWrongConcurrency is returned when Concurrency is set to a non existing setting.
-	ErrWrongConcurrency = errors.New("invalid concurrency policy value, use \"allow\" or \"forbid\"")
+	ErrWrongConcurrency = errors.New("invalid concurrency policy value, use \"allow\", \"forbid\", \"replace\" or \"queue\"")
+	// ErrWrongMaxConcurrency is returned when MaxConcurrency is negative.
+	ErrWrongMaxConcurrency = errors.New("invalid max concurrency value, it can't be negative")
+	// ErrWrongMaxQueueDepth is returned when MaxQueueDepth is negative.
+	ErrWrongMaxQueueDepth = errors.New("invalid max queue depth value, it can't be negative")
+	// ErrDependencyCycle is returned when the job is, directly or indirectly, a parent of itself.
+	ErrDependencyCycle = errors.New("the job dependencies form a cycle")
+	// ErrWrongDependencyTrigger is returned when a dependency trigger is set to a non existing condition.
+	ErrWrongDependencyTrigger = errors.New("invalid dependency trigger value, use \"on_success\", \"on_failure\", \"on_partial_failure\" or \"always\"")
+	// ErrTriggerNotParent is returned when a dependency trigger is set for a job that is not a parent.
+	ErrTriggerNotParent = errors.New("dependency trigger set for a job that is not a parent")
+	// ErrWrongRetryBackoff is returned when the retry backoff strategy is set to a non existing setting.
+	ErrWrongRetryBackoff = errors.New("invalid retry backoff strategy, use \"fixed\", \"linear\" or \"exponential\"")
+	// ErrWrongRetryDelay is returned when a retry backoff delay is not a positive duration.
+	ErrWrongRetryDelay = errors.New("invalid retry backoff delay, use a positive duration like \"10s\"")
+	// ErrWrongRetryJitter is returned when the retry backoff jitter is not between 0 and 1.
+	ErrWrongRetryJitter = errors.New("invalid retry backoff jitter, use a value between 0 and 1")
+	// ErrWrongRetryPlacement is returned when the retry placement is set to a non existing setting.
+	ErrWrongRetryPlacement = errors.New("invalid retry placement value, use \"same_node\", \"any_node\" or \"different_node\"")
+	// ErrWrongSelector is returned when the selector is set to a non existing strategy.
+	ErrWrongSelector = errors.New("invalid selector value, use \"random\", \"round_robin\", \"least_busy\", \"consistent_hash\" or \"spread:<tag>\"")
+	// ErrWrongMisfirePolicy is returned when the misfire policy is set to a non existing setting.
+	ErrWrongMisfirePolicy = errors.New("invalid misfire policy value, use \"skip\", \"run_once\" or \"run_all\"")
+	// ErrWrongMisfireLimit is returned when MisfireLimit is negative.
+	ErrWrongMisfireLimit = errors.New("invalid misfire limit value, it can't be negative")
+	// ErrWrongMisfireGrace is returned when the misfire grace window is not a positive duration.
+	ErrWrongMisfireGrace = errors.New("invalid misfire grace value, use a positive duration like \"1h\"")
+	// ErrIndependentParents is returned when the parents of a job don't
+	// descend from the same root job.
+	ErrIndependentParents = errors.New("the parent jobs must descend from the same root job")
+	// ErrWrongParameterName is returned when a job parameter name can't be used as template variable.
+	ErrWrongParameterName = errors.New("invalid parameter name, use only letters, digits and underscore, not starting with a digit")
+	// ErrUnknownParameter is returned when a run passes a parameter not declared in the job.
+	ErrUnknownParameter = errors.New("unknown job parameter")
+	// ErrMissingParameter is returned when a run doesn't pass a required parameter.
+	ErrMissingParameter = errors.New("missing required job parameter")
+	// ErrScheduledRequiredParameter is returned when a job run by its schedule or
+	// its parents has a required parameter without default value.
+	ErrScheduledRequiredParameter = errors.New("required job parameter without default value in a scheduled or dependent job")
+	// ErrRevisionMismatch is returned when updating a job that changed since
+	// the revision the update expects.
+	ErrRevisionMismatch = errors.New("the job was changed since the expected revision")
 )
 
 // Job describes a scheduled Job.
@@ -59,9 +164,14 @@ type Job struct {
 	// Job id. Must be unique, it's a copy of name.
 	ID string `json:"id"`
 
-	// Job name. Must be unique, acts as the id.
+	// Job name. Must be unique, acts as the id. The name of the jobs of a
+	// namespace other than the default is qualified with the namespace, as
+	// in "namespace/name".
 	Name string `json:"name"`
 
+	// Namespace of the job, the job name is unique in its namespace.
+	Namespace string `json:"namespace"`
+
 	// Display name of the job. If present, displayed instead of the name
 	DisplayName string `json:"displayname"`
 
@@ -72,6 +182,20 @@ type Job struct {
 	// Cron expression for the job. When to run the job.
 	Schedule string `json:"schedule"`
 
+	// Name of the calendar with the days excluded from the schedule.
+	Calendar string `json:"calendar"`
+
+	// What to do with the runs on the days excluded by the calendar
+	// (skip, shift).
+	CalendarPolicy string `json:"calendar_policy"`
+
+	// Name of the job template the job inherits the fields it doesn't set from.
+	Template string `json:"template"`
+
+	// Fields the job sets even to their empty value, instead of inheriting
+	// them from its template, by their JSON name.
+	Overrides []string `json:"overrides"`
+
 	// Arbitrary string indicating the owner of the job.
 	Owner string `json:"owner"`
 
@@ -96,6 +220,14 @@ type Job struct {
 	// Tags of the target servers to run this job against.
 	Tags map[string]string `json:"tags"`
 
+	// Tag constraint expressions the target servers must satisfy, like
+	// "os != windows", "zone in (a,b)", "gpu exists" or "mem_gb >= 16".
+	Constraints []string `json:"constraints"`
+
+	// Strategy to pick the target nodes when the tags match more nodes than needed
+	// (random, round_robin, least_busy, consistent_hash, spread:<tag>).
+	Selector string `json:"selector"`
+
 	// Job metadata describes the job and allows filtering from the API.
 	Metadata map[string]string `json:"metadata"`
 
@@ -105,6 +237,12 @@ type Job struct {
 	// Number of times to retry a job that failed an execution.
 	Retries uint `json:"retries"`
 
+	// How long to wait before retrying a failed execution.
+	RetryBackoff *RetryBackoff `json:"retry_backoff"`
+
+	// Where to retry a failed execution (same_node, any_node, different_node).
+	RetryPlacement string `json:"retry_placement"`
+
 	// Jobs that are dependent upon this one will be run after this job runs.
 	DependentJobs []string `json:"dependent_jobs"`
 
@@ -114,18 +252,55 @@ type Job struct {
 	// Job id of job that this job is dependent upon.
 	ParentJob string `json:"parent_job"`
 
+	// Job ids of jobs that this job is dependent upon, the job will run
+	// once all of them succeeded in the same workflow run.
+	ParentJobs []string `json:"parent_jobs"`
+
+	// Condition on the parent job result that triggers this job, by parent job
+	// id (on_success, on_failure, on_partial_failure, always). Defaults to on_success.
+	DependencyTriggers map[string]string `json:"dependency_triggers"`
+
+	// Parameters that can be passed to a run of this job, by name.
+	Parameters map[string]*JobParameter `json:"parameters"`
+
 	// Processors to use for this job.
 	Processors map[string]plugin.Config `json:"processors"`
 
-	// Concurrency policy for this job (allow, forbid).
+	// Concurrency policy for this job (allow, forbid, replace, queue).
 	Concurrency string `json:"concurrency"`
 
+	// Maximum number of executions of this job running at the same time.
+	// Zero means unlimited for the allow policy and one for the rest.
+	MaxConcurrency int `json:"max_concurrency"`
+
+	// Maximum number of executions waiting in the queue of this job when
+	// using the queue policy. Zero means the default of 10.
+	MaxQueueDepth int `json:"max_queue_depth"`
+
+	// What to do with the executions missed while there was no leader
+	// (skip, run_once, run_all).
+	MisfirePolicy string `json:"misfire_policy"`
+
+	// Maximum number of missed executions to run with the run_all misfire
+	// policy. Zero means the default of 10.
+	MisfireLimit int `json:"misfire_limit"`
+
+	// Only the executions missed within this duration before the new leader
+	// started are run, as a duration string. Empty means no limit.
+	MisfireGrace string `json:"misfire_grace"`
+
 	// Executor plugin to be used in this job.
 	Executor string `json:"executor"`
 
 	// Configuration arguments for the specific executor.
 	ExecutorConfig plugin.ExecutorPluginConfig `json:"executor_config"`
 
+	// Trigger plugin consuming the messages that start runs of this job.
+	Trigger string `json:"trigger"`
+
+	// Configuration arguments for the specific trigger.
+	TriggerConfig map[string]string `json:"trigger_config"`
+
 	// Computed job status.
 	Status string `json:"status"`
 
@@ -141,34 +316,109 @@ type Job struct {
 	// The job will not be executed after this time.
 	ExpiresAt ntime.NullableTime `json:"expires_at"`
 
+	// Computed version of the job definition, increased on every change.
+	Version int64 `json:"version"`
+
+	// Time the job was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Time of the last change to the job definition.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Who made the last change to the job definition.
+	UpdatedBy string `json:"updated_by"`
+
+	// Computed revision of the job, the raft index of the last change to the
+	// job definition.
+	Revision uint64 `json:"revision"`
+
 	logger *logrus.Entry
 }
 
+// RetryBackoff sets the delay before the retries of a failed execution.
+type RetryBackoff struct {
+	// Backoff strategy (fixed, linear, exponential), exponential by default.
+	Strategy string `json:"strategy"`
+
+	// Delay before the first retry, as a duration string. Defaults to 1s.
+	BaseDelay string `json:"base_delay"`
+
+	// Maximum delay between retries, as a duration string. Empty means no maximum.
+	MaxDelay string `json:"max_delay"`
+
+	// Fraction of the delay to randomly add or remove, between 0 and 1.
+	Jitter float64 `json:"jitter"`
+}
+
+// JobParameter declares a parameter that can be passed to a job run.
+// Parameter values are available in the executor config as {{.Parameters.name}},
+// the parameters not passed and without default value are empty.
+type JobParameter struct {
+	// Value used when the parameter is not passed to the run.
+	Default string `json:"default,omitempty"`
+
+	// The run fails if the parameter is not passed and it has no default value.
+	Required bool `json:"required,omitempty"`
+
+	// Description of the parameter.
+	Description string `json:"description,omitempty"`
+}
+
 // NewJobFromProto create a new Job from a PB Job struct
 func NewJobFromProto(in *proto.Job, logger *logrus.Entry) *Job {
 	job := &Job{
-		ID:             in.Name,
-		Name:           in.Name,
-		DisplayName:    in.Displayname,
-		Timezone:       in.Timezone,
-		Schedule:       in.Schedule,
-		Owner:          in.Owner,
-		OwnerEmail:     in.OwnerEmail,
-		SuccessCount:   int(in.SuccessCount),
-		ErrorCount:     int(in.ErrorCount),
-		Disabled:       in.Disabled,
-		Tags:           in.Tags,
-		Retries:        uint(in.Retries),
-		DependentJobs:  in.DependentJobs,
-		ParentJob:      in.ParentJob,
-		Concurrency:    in.Concurrency,
-		Executor:       in.Executor,
-		ExecutorConfig: in.ExecutorConfig,
-		Status:         in.Status,
-		Metadata:       in.Metadata,
-		Next:           in.GetNext().AsTime(),
-		Ephemeral:      in.Ephemeral,
-		logger:         logger,
+		ID:                 in.Name,
+		Name:               in.Name,
+		DisplayName:        in.Displayname,
+		Timezone:           in.Timezone,
+		Schedule:           in.Schedule,
+		Owner:              in.Owner,
+		OwnerEmail:         in.OwnerEmail,
+		SuccessCount:       int(in.SuccessCount),
+		ErrorCount:         int(in.ErrorCount),
+		Disabled:           in.Disabled,
+		Tags:               in.Tags,
+		Constraints:        in.Constraints,
+		Selector:           in.Selector,
+		Retries:            uint(in.Retries),
+		RetryBackoff:       retryBackoffFromProto(in.RetryBackoff),
+		RetryPlacement:     in.RetryPlacement,
+		DependentJobs:      in.DependentJobs,
+		ParentJob:          in.ParentJob,
+		ParentJobs:         in.ParentJobs,
+		Concurrency:        in.Concurrency,
+		MaxConcurrency:     int(in.MaxConcurrency),
+		MaxQueueDepth:      int(in.MaxQueueDepth),
+		MisfirePolicy:      in.MisfirePolicy,
+		MisfireLimit:       int(in.MisfireLimit),
+		MisfireGrace:       in.MisfireGrace,
+		Calendar:           in.Calendar,
+		CalendarPolicy:     in.CalendarPolicy,
+		Template:           in.Template,
+		Overrides:          in.Overrides,
+		DependencyTriggers: in.DependencyTriggers,
+		Executor:           in.Executor,
+		ExecutorConfig:     in.ExecutorConfig,
+		Trigger:            in.Trigger,
+		TriggerConfig:      in.TriggerConfig,
+		Status:             in.Status,
+		Metadata:           in.Metadata,
+		Next:               in.GetNext().AsTime(),
+		Ephemeral:          in.Ephemeral,
+		Version:            in.Version,
+		UpdatedBy:          in.UpdatedBy,
+		Revision:           in.Revision,
+		Namespace:          in.Namespace,
+		logger:             logger,
+	}
+	if job.Namespace == "" {
+		job.Namespace, _ = splitJobName(in.Name)
+	}
+	if in.UpdatedAt != nil {
+		job.UpdatedAt = in.UpdatedAt.AsTime()
+	}
+	if in.CreatedAt != nil {
+		job.CreatedAt = in.CreatedAt.AsTime()
 	}
 	if in.GetLastSuccess().GetHasValue() {
 		t := in.GetLastSuccess().GetTime().AsTime()
@@ -196,9 +446,106 @@ func NewJobFromProto(in *proto.Job, logger *logrus.Entry) *Job {
 	}
 	job.Processors = procs
 
+	if len(in.Parameters) > 0 {
+		job.Parameters = make(map[string]*JobParameter, len(in.Parameters))
+		for k, v := range in.Parameters {
+			job.Parameters[k] = &JobParameter{
+				Default:     v.DefaultValue,
+				Required:    v.Required,
+				Description: v.Description,
+			}
+		}
+	}
+
 	return job
 }
 
+func retryBackoffFromProto(in *proto.RetryBackoff) *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	return &RetryBackoff{
+		Strategy:  in.Strategy,
+		BaseDelay: in.BaseDelay,
+		MaxDelay:  in.MaxDelay,
+		Jitter:    in.Jitter,
+	}
+}
+
+func (b *RetryBackoff) toProto() *proto.RetryBackoff {
+	if b == nil {
+		return nil
+	}
+	return &proto.RetryBackoff{
+		Strategy:  b.Strategy,
+		BaseDelay: b.BaseDelay,
+		MaxDelay:  b.MaxDelay,
+		Jitter:    b.Jitter,
+	}
+}
+
+// validate checks the retry backoff settings.
+func (b *RetryBackoff) validate() error {
+	switch b.Strategy {
+	case "", RetryBackoffFixed, RetryBackoffLinear, RetryBackoffExponential:
+	default:
+		return ErrWrongRetryBackoff
+	}
+
+	for _, v := range []string{b.BaseDelay, b.MaxDelay} {
+		if v == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return ErrWrongRetryDelay
+		}
+	}
+
+	if b.Jitter < 0 || b.Jitter > 1 {
+		return ErrWrongRetryJitter
+	}
+
+	return nil
+}
+
+// delay returns how long to wait before the given retry, starting at 1.
+func (b *RetryBackoff) delay(retry uint) time.Duration {
+	base := time.Second
+	if d, err := time.ParseDuration(b.BaseDelay); err == nil {
+		base = d
+	}
+
+	d := float64(base)
+	switch b.Strategy {
+	case RetryBackoffLinear:
+		d *= float64(retry)
+	case RetryBackoffFixed:
+	default:
+		d *= math.Pow(2, float64(retry-1))
+	}
+
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rand.Float64() - 1)
+	}
+	if m, err := time.ParseDuration(b.MaxDelay); err == nil && d > float64(m) {
+		d = float64(m)
+	}
+	if d > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(d)
+}
+
+// retryDelay returns how long to wait before retrying the given execution,
+// which already holds the attempt number of the retry.
+func (j *Job) retryDelay(ex *Execution) time.Duration {
+	if j.RetryBackoff == nil {
+		return ex.CalculateExponentialBackoff()
+	}
+	return j.RetryBackoff.delay(ex.Attempt - 1)
+}
+
 // ToProto return the corresponding representation of this Job in proto struct
 func (j *Job) ToProto() *proto.Job {
 	lastSuccess := &proto.Job_NullableTime{
@@ -230,36 +577,84 @@ func (j *Job) ToProto() *proto.Job {
 		expiresAt.Time = timestamppb.New(j.ExpiresAt.Get())
 	}
 
+	var updatedAt *timestamppb.Timestamp
+	if !j.UpdatedAt.IsZero() {
+		updatedAt = timestamppb.New(j.UpdatedAt)
+	}
+	var createdAt *timestamppb.Timestamp
+	if !j.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(j.CreatedAt)
+	}
+
 	processors := make(map[string]*proto.PluginConfig)
 	for k, v := range j.Processors {
 		processors[k] = &proto.PluginConfig{Config: v}
 	}
+
+	var parameters map[string]*proto.JobParameter
+	if len(j.Parameters) > 0 {
+		parameters = make(map[string]*proto.JobParameter, len(j.Parameters))
+		for k, v := range j.Parameters {
+			if v == nil {
+				v = &JobParameter{}
+			}
+			parameters[k] = &proto.JobParameter{
+				DefaultValue: v.Default,
+				Required:     v.Required,
+				Description:  v.Description,
+			}
+		}
+	}
 	return &proto.Job{
-		Name:           j.Name,
-		Displayname:    j.DisplayName,
-		Timezone:       j.Timezone,
-		Schedule:       j.Schedule,
-		Owner:          j.Owner,
-		OwnerEmail:     j.OwnerEmail,
-		SuccessCount:   int32(j.SuccessCount),
-		ErrorCount:     int32(j.ErrorCount),
-		Disabled:       j.Disabled,
-		Tags:           j.Tags,
-		Retries:        uint32(j.Retries),
-		DependentJobs:  j.DependentJobs,
-		ParentJob:      j.ParentJob,
-		Concurrency:    j.Concurrency,
-		Processors:     processors,
-		Executor:       j.Executor,
-		ExecutorConfig: j.ExecutorConfig,
-		Status:         j.Status,
-		Metadata:       j.Metadata,
-		LastSuccess:    lastSuccess,
-		LastError:      lastError,
-		Next:           next,
-		Ephemeral:      j.Ephemeral,
-		ExpiresAt:      expiresAt,
-		StartsAt:       startsAt,
+		Name:               j.Name,
+		Displayname:        j.DisplayName,
+		Timezone:           j.Timezone,
+		Schedule:           j.Schedule,
+		Owner:              j.Owner,
+		OwnerEmail:         j.OwnerEmail,
+		SuccessCount:       int32(j.SuccessCount),
+		ErrorCount:         int32(j.ErrorCount),
+		Disabled:           j.Disabled,
+		Tags:               j.Tags,
+		Constraints:        j.Constraints,
+		Selector:           j.Selector,
+		Retries:            uint32(j.Retries),
+		RetryBackoff:       j.RetryBackoff.toProto(),
+		RetryPlacement:     j.RetryPlacement,
+		DependentJobs:      j.DependentJobs,
+		ParentJob:          j.ParentJob,
+		ParentJobs:         j.ParentJobs,
+		Concurrency:        j.Concurrency,
+		MaxConcurrency:     int32(j.MaxConcurrency),
+		MaxQueueDepth:      int32(j.MaxQueueDepth),
+		MisfirePolicy:      j.MisfirePolicy,
+		MisfireLimit:       int32(j.MisfireLimit),
+		MisfireGrace:       j.MisfireGrace,
+		Calendar:           j.Calendar,
+		CalendarPolicy:     j.CalendarPolicy,
+		Template:           j.Template,
+		Overrides:          j.Overrides,
+		DependencyTriggers: j.DependencyTriggers,
+		Parameters:         parameters,
+		Processors:         processors,
+		Executor:           j.Executor,
+		ExecutorConfig:     j.ExecutorConfig,
+		Trigger:            j.Trigger,
+		TriggerConfig:      j.TriggerConfig,
+		Status:             j.Status,
+		Metadata:           j.Metadata,
+		LastSuccess:        lastSuccess,
+		LastError:          lastError,
+		Next:               next,
+		Ephemeral:          j.Ephemeral,
+		ExpiresAt:          expiresAt,
+		StartsAt:           startsAt,
+		Version:            j.Version,
+		UpdatedAt:          updatedAt,
+		CreatedAt:          createdAt,
+		UpdatedBy:          j.UpdatedBy,
+		Revision:           j.Revision,
+		Namespace:          j.Namespace,
 	}
 }
 
@@ -271,22 +666,73 @@ func (j *Job) Run() {
 		j.logger.Fatal("job: agent not set")
 	}
 
+	ej, ok := j.Agent.sched.GetEntryJob(j.Name)
+	if !ok {
+		j.logger.WithField("job", j.Name).Warn("job: Job not found in the scheduler, skipping scheduled run")
+		return
+	}
+
+	// Simple execution wrapper
+	j.runSlot(ej.entry.Prev, TriggerCron)
+}
+
+// runSlot claims the run of the job at the scheduled time and runs it. The
+// claim goes through raft before any node is contacted, so a slot fired by
+// both leaders during a leadership transfer only runs once. The claim also
+// records the fire time, used to run missed executions following the
+// misfire policy.
+func (j *Job) runSlot(scheduledAt time.Time, trigger string) {
+	logger := j.logger.WithFields(logrus.Fields{
+		"job":          j.Name,
+		"scheduled_at": scheduledAt,
+	})
+	claimed, err := j.Agent.applyClaimSlot(j.Name, scheduledAt)
+	if err != nil {
+		logger.WithError(err).Warn("job: Error claiming scheduled run, skipping")
+		return
+	}
+	if !claimed {
+		logger.Warn("job: Scheduled run already claimed, skipping")
+		return
+	}
+
+	// The scheduler lag is how late the leader sends the execution from its
+	// schedule, the missed executions are late by definition.
+	if trigger == TriggerCron {
+		metrics.MeasureSinceWithLabels([]string{"scheduler", "lag"}, scheduledAt, jobLabels("job_name", j.Name))
+	}
+
+	ex := NewExecution(j.Name, trigger)
+	ex.ScheduledAt = scheduledAt
+	j.run(ex)
+}
+
+// run sends the given execution to the agent if the job is runnable.
+func (j *Job) run(ex *Execution) {
+	if _, err := j.start(context.Background(), ex); err != nil {
+		j.logger.WithError(err).Error("job: Error running job")
+	}
+}
+
+// start sends the given execution to the agent if the job is runnable,
+// returning whether it was sent.
+func (j *Job) start(ctx context.Context, ex *Execution) (bool, error) {
 	// Check if it's runnable
-	if j.isRunnable(j.logger) {
-		j.logger.WithFields(logrus.Fields{
-			"job":      j.Name,
-			"schedule": j.Schedule,
-		}).Debug("job: Run job")
+	if !j.isRunnable(j.logger, ex) || !j.applyConcurrencyPolicy(ex) {
+		return false, nil
+	}
 
-		cronInspect.Set(j.Name, j)
+	j.logger.WithFields(logrus.Fields{
+		"job":      j.Name,
+		"schedule": j.Schedule,
+	}).Debug("job: Run job")
 
-		// Simple execution wrapper
-		ex := NewExecution(j.Name)
+	cronInspect.Set(j.Name, j)
 
-		if _, err := j.Agent.Run(context.Background(), j.Name, ex); err != nil {
-			j.logger.WithError(err).Error("job: Error running job")
-		}
+	if _, err := j.Agent.Run(ctx, j.Name, ex); err != nil {
+		return true, err
 	}
+	return true, nil
 }
 
 // Friendly format a job
@@ -316,6 +762,149 @@ func (j *Job) GetParent(ctx context.Context, store *Store) (*Job, error) {
 	return parentJob, nil
 }
 
+// parents returns the names of all the jobs this job depends on, combining
+// ParentJob and ParentJobs without duplicates.
+func (j *Job) parents() []string {
+	var parents []string
+	seen := make(map[string]bool)
+	for _, p := range append([]string{j.ParentJob}, j.ParentJobs...) {
+		if p == "" || seen[p] {
+			continue
+		}
+		seen[p] = true
+		parents = append(parents, p)
+	}
+	return parents
+}
+
+// triggeredBy returns whether this job should run after an execution
+// of the given parent job finished with the given status.
+func (j *Job) triggeredBy(parentName string, status string) bool {
+	switch j.DependencyTriggers[parentName] {
+	case TriggerAlways:
+		return true
+	case TriggerOnFailure:
+		return status == StatusFailed
+	case TriggerOnPartialFailure:
+		return status == StatusPartiallyFailed
+	default:
+		return status == StatusSuccess
+	}
+}
+
+// resolveParameters validates the parameters passed to a run of the job
+// against the declared ones, returning them with the defaults applied.
+func (j *Job) resolveParameters(params map[string]string) (map[string]string, error) {
+	for k := range params {
+		if _, ok := j.Parameters[k]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownParameter, k)
+		}
+	}
+
+	if len(j.Parameters) == 0 {
+		return nil, nil
+	}
+
+	resolved := make(map[string]string, len(j.Parameters))
+	for k, p := range j.Parameters {
+		if p == nil {
+			p = &JobParameter{}
+		}
+		if v, ok := params[k]; ok {
+			resolved[k] = v
+		} else if p.Required && p.Default == "" {
+			return nil, fmt.Errorf("%w: %s", ErrMissingParameter, k)
+		} else {
+			resolved[k] = p.Default
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolveEventParameters resolves the parameters of a run of the job by a
+// webhook or a message, keeping the parameters of the event the job doesn't
+// declare.
+func (j *Job) resolveEventParameters(params map[string]string) (map[string]string, error) {
+	declared := make(map[string]string)
+	event := make(map[string]string)
+	for k, v := range params {
+		if _, ok := j.Parameters[k]; !ok && (strings.HasPrefix(k, webhookParamPrefix) || strings.HasPrefix(k, messageParamPrefix)) {
+			event[k] = v
+		} else {
+			declared[k] = v
+		}
+	}
+
+	resolved, err := j.resolveParameters(declared)
+	if err != nil {
+		return nil, err
+	}
+	if resolved == nil {
+		resolved = make(map[string]string, len(event))
+	}
+	for k, v := range event {
+		resolved[k] = v
+	}
+	return resolved, nil
+}
+
+// checkDependencyCycle walks up the dependency graph from the job's parents,
+// using lookup to get the parents of every other job, and returns
+// ErrDependencyCycle if the job is found on the way.
+func checkDependencyCycle(job *Job, lookup func(name string) []string) error {
+	visited := make(map[string]bool)
+	pending := job.parents()
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if name == job.Name {
+			return ErrDependencyCycle
+		}
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+		pending = append(pending, lookup(name)...)
+	}
+	return nil
+}
+
+// checkCommonRoot walks up the dependency graph from the parents of the named
+// job, using lookup to get the parents of every job, and returns
+// ErrIndependentParents if they don't all descend from the same root job.
+// Workflow runs start at the root jobs, so the runs of parents descending
+// from different roots can't be matched.
+func checkCommonRoot(name string, lookup func(name string) []string) error {
+	pending := lookup(name)
+	if len(pending) < 2 {
+		return nil
+	}
+
+	roots := make(map[string]bool)
+	visited := make(map[string]bool)
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+		parents := lookup(name)
+		if len(parents) == 0 {
+			roots[name] = true
+			continue
+		}
+		pending = append(pending, parents...)
+	}
+	if len(roots) > 1 {
+		return ErrIndependentParents
+	}
+	return nil
+}
+
 // GetTimeLocation returns the time.Location based on the job's Timezone, or
 // the default (UTC) if none is configured, or
 // nil if an error occurred while creating the timezone from the property
@@ -324,6 +913,102 @@ func (j *Job) GetTimeLocation() *time.Location {
 	return loc
 }
 
+// cronSchedule returns the schedule spec given to the cron scheduler, adding
+// the job timezone to the schedule when needed.
+func (j *Job) cronSchedule() string {
+	return withTimezone(j.scheduleHash(), j.Timezone)
+}
+
+// withTimezone adds the timezone to a schedule spec when needed.
+func withTimezone(schedule, timezone string) string {
+	// If Timezone is set, and not explicitly in the schedule, AND its not
+	// a descriptor (that don't support timezones) other than rrule, add
+	// the timezone to the schedule so robfig/cron knows about it.
+	if timezone != "" &&
+		(!strings.HasPrefix(schedule, "@") || strings.HasPrefix(schedule, "@rrule ")) &&
+		!strings.HasPrefix(schedule, "TZ=") &&
+		!strings.HasPrefix(schedule, "CRON_TZ=") {
+		schedule = "CRON_TZ=" + timezone + " " + schedule
+	}
+	return schedule
+}
+
+// parseSchedule parses the job schedule, skipping or shifting the runs on the
+// days excluded by the given calendar when it is set.
+func (j *Job) parseSchedule(cal *Calendar) (cron.Schedule, error) {
+	sched, err := extcron.Parse(j.cronSchedule())
+	if err != nil {
+		return nil, err
+	}
+
+	// Recurrence rules start at the job start date, or else on the day the
+	// job was created
+	if rule, ok := sched.(*extcron.RRule); ok {
+		if j.StartsAt.HasValue() {
+			rule.Dtstart = j.StartsAt.Get()
+		} else if !j.CreatedAt.IsZero() {
+			t := j.CreatedAt.In(rule.Location)
+			rule.Dtstart = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, rule.Location)
+		}
+	}
+
+	if cal == nil {
+		return sched, nil
+	}
+
+	// Calendars without timezone evaluate the days in the job timezone
+	var loc *time.Location
+	if j.Timezone != "" {
+		loc = j.GetTimeLocation()
+	}
+	exclusions, err := cal.exclusions(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extcron.CalendarSchedule{
+		Schedule: sched,
+		Calendar: exclusions,
+		Shift:    j.CalendarPolicy == CalendarShift,
+	}, nil
+}
+
+// misfires returns the fire times missed between the last fire time and now
+// that have to be run following the misfire policy, oldest first.
+func (j *Job) misfires(cal *Calendar, last, now time.Time) ([]time.Time, error) {
+	if last.IsZero() || j.MisfirePolicy == "" || j.MisfirePolicy == MisfireSkip {
+		return nil, nil
+	}
+
+	sched, err := j.parseSchedule(cal)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fire times older than the grace window are not run
+	from := last
+	if grace, err := time.ParseDuration(j.MisfireGrace); err == nil && now.Add(-grace).After(last) {
+		from = now.Add(-grace - time.Nanosecond)
+	}
+	limit := 1
+	if j.MisfirePolicy == MisfireRunAll {
+		limit = defaultMisfireLimit
+		if j.MisfireLimit > 0 {
+			limit = j.MisfireLimit
+		}
+	}
+
+	var missed []time.Time
+	for t := sched.Next(from); !t.IsZero() && t.Before(now); t = sched.Next(t) {
+		missed = append(missed, t)
+		// Keep the most recent ones over the limit
+		if len(missed) > limit {
+			missed = missed[1:]
+		}
+	}
+	return missed, nil
+}
+
 // nameHash returns hash code of the job name
 func (j *Job) nameHash() int {
 	hash := 0
@@ -378,24 +1063,55 @@ func (j *Job) scheduleHash() string {
 	return strings.Join(parts, " ")
 }
 
-// GetNext returns the job's next schedule from now
-func (j *Job) GetNext() (time.Time, error) {
-	if j.StartsAt.HasValue() && time.Now().Before(j.StartsAt.Get()) {
-		return j.StartsAt.Get(), nil
+// GetNext returns the job's next schedule from now, computed like the
+// scheduler does with the given calendar.
+func (j *Job) GetNext(cal *Calendar) (time.Time, error) {
+	runs, err := j.nextRuns(cal, time.Now(), time.Time{}, 1)
+	if err != nil || len(runs) == 0 {
+		return time.Time{}, err
 	}
+	return runs[0], nil
+}
 
-	if j.Schedule != "" {
-		s, err := extcron.Parse(j.scheduleHash())
-		if err != nil {
-			return time.Time{}, err
-		}
-		return s.Next(time.Now()), nil
+// nextRuns returns up to count fire times of the job after from, and before
+// until when it is set, honoring the start and expiration dates of the job
+// and the given calendar. The times are in the job timezone.
+func (j *Job) nextRuns(cal *Calendar, from, until time.Time, count int) ([]time.Time, error) {
+	if j.Schedule == "" {
+		return nil, nil
 	}
 
-	return time.Time{}, nil
+	sched, err := j.parseSchedule(cal)
+	if err != nil {
+		return nil, err
+	}
+
+	// Include a fire time right at the start date
+	if j.StartsAt.HasValue() && from.Before(j.StartsAt.Get()) {
+		from = j.StartsAt.Get().Add(-time.Nanosecond)
+	}
+
+	loc, err := time.LoadLocation(j.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	var runs []time.Time
+	for t := sched.Next(from); !t.IsZero() && len(runs) < count; t = sched.Next(t) {
+		if !until.IsZero() && !t.Before(until) {
+			break
+		}
+		if j.ExpiresAt.HasValue() && t.After(j.ExpiresAt.Get()) {
+			break
+		}
+		runs = append(runs, t.In(loc))
+	}
+	return runs, nil
 }
 
-func (j *Job) isRunnable(logger *logrus.Entry) bool {
+// isRunnable reports whether the given run of the job can start. Runs
+// during a maintenance window are recorded as suppressed, the execution is
+// nil for queued executions that don't need to be recorded.
+func (j *Job) isRunnable(logger *logrus.Entry, ex *Execution) bool {
 	if j.Disabled {
 		logger.WithField("job", j.Name).
 			Debug("job: Skipping execution because job is disabled")
@@ -420,44 +1136,259 @@ func (j *Job) isRunnable(logger *logrus.Entry) bool {
 		return false
 	}
 
-	if j.Concurrency == ConcurrencyForbid {
-		// Check for running executions in persistent storage first
-		// This is the source of truth and survives node restarts
-		ctx := context.Background()
-		runningExecs, err := j.Agent.Store.GetRunningExecutions(ctx, j.Name)
+	if j.suppress(logger, ex) {
+		return false
+	}
+
+	// The replace and queue policies act on the running executions when the
+	// limit is reached, see applyConcurrencyPolicy.
+	if limit := j.maxConcurrency(); limit > 0 &&
+		j.Concurrency != ConcurrencyReplace && j.Concurrency != ConcurrencyQueue {
+		running, err := j.runningGroups(logger)
+		if err != nil {
+			return false
+		}
+
+		if len(running) >= limit {
+			logger.WithFields(logrus.Fields{
+				"job":             j.Name,
+				"concurrency":     j.Concurrency,
+				"max_concurrency": limit,
+				"job_status":      j.Status,
+				"running_count":   len(running),
+			}).Info("job: Skipping concurrent execution")
+			return false
+		}
+	}
+
+	// The max concurrent executions of the namespace apply to all its jobs,
+	// whatever their concurrency policy.
+	namespace, _ := splitJobName(j.Name)
+	if ns, _ := j.Agent.Store.GetNamespace(context.Background(), namespace); ns != nil && ns.MaxConcurrentExecutions > 0 {
+		running, err := j.namespaceRunning(logger, namespace)
 		if err != nil {
-			logger.WithError(err).Error("job: Error querying for running executions in storage")
 			return false
 		}
 
-		if len(runningExecs) > 0 {
+		if running >= ns.MaxConcurrentExecutions {
 			logger.WithFields(logrus.Fields{
-				"job":            j.Name,
-				"concurrency":    j.Concurrency,
-				"job_status":     j.Status,
-				"running_count":  len(runningExecs),
-			}).Info("job: Skipping concurrent execution (found running executions in storage)")
+				"job":                       j.Name,
+				"namespace":                 namespace,
+				"max_concurrent_executions": ns.MaxConcurrentExecutions,
+				"running_count":             running,
+			}).Info("job: Skipping execution because its namespace is at its max concurrent executions")
+			return false
+		}
+	}
+
+	return true
+}
+
+// maxConcurrency returns the number of executions of the job that can run at the
+// same time, zero meaning unlimited.
+func (j *Job) maxConcurrency() int {
+	if j.MaxConcurrency > 0 {
+		return j.MaxConcurrency
+	}
+	if j.Concurrency == "" || j.Concurrency == ConcurrencyAllow {
+		return 0
+	}
+	return 1
+}
+
+// maxQueueDepth returns the number of executions the job queue can hold.
+func (j *Job) maxQueueDepth() int {
+	if j.MaxQueueDepth > 0 {
+		return j.MaxQueueDepth
+	}
+	return defaultMaxQueueDepth
+}
+
+// runningGroups returns the running executions of the job by execution group,
+// an execution running in several nodes counts once.
+func (j *Job) runningGroups(logger *logrus.Entry) (map[int64][]*Execution, error) {
+	// Check for running executions in persistent storage first
+	// This is the source of truth and survives node restarts
+	running, err := j.storedRunningGroups(context.Background())
+	if err != nil {
+		logger.WithError(err).Error("job: Error querying for running executions in storage")
+		return nil, err
+	}
+
+	// Also check in-memory activeExecutions as a secondary check
+	// This catches executions that just started and may not be in storage yet
+	exs, err := j.Agent.GetActiveExecutions()
+	if err != nil {
+		logger.WithError(err).Error("job: Error querying for active executions")
+		return nil, err
+	}
+
+	for _, e := range exs {
+		if e.JobName != j.Name {
+			continue
+		}
+		ex := NewExecutionFromProto(e)
+		if !slices.ContainsFunc(running[ex.Group], func(r *Execution) bool { return r.Key() == ex.Key() }) {
+			running[ex.Group] = append(running[ex.Group], ex)
+		}
+	}
+
+	return running, nil
+}
+
+// namespaceRunning returns the number of running executions of the jobs of
+// the namespace, an execution running in several nodes counts once.
+func (j *Job) namespaceRunning(logger *logrus.Entry, namespace string) (int, error) {
+	exs, err := j.Agent.Store.GetNamespaceRunningExecutions(context.Background(), namespace)
+	if err != nil {
+		logger.WithError(err).Error("job: Error querying for running executions of the namespace in storage")
+		return 0, err
+	}
+
+	running := make(map[string]bool)
+	for _, ex := range exs {
+		running[fmt.Sprintf("%s:%d", ex.JobName, ex.Group)] = true
+	}
+
+	// The active executions catch the ones not in storage yet
+	active, err := j.Agent.GetActiveExecutions()
+	if err != nil {
+		logger.WithError(err).Error("job: Error querying for active executions")
+		return 0, err
+	}
+	for _, e := range active {
+		if ns, _ := splitJobName(e.JobName); ns == namespace {
+			running[fmt.Sprintf("%s:%d", e.JobName, e.Group)] = true
+		}
+	}
+
+	return len(running), nil
+}
+
+// storedRunningGroups returns the running executions of the job found in the
+// store by execution group.
+func (j *Job) storedRunningGroups(ctx context.Context) (map[int64][]*Execution, error) {
+	exs, err := j.Agent.Store.GetRunningExecutions(ctx, j.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	running := make(map[int64][]*Execution)
+	for _, ex := range exs {
+		running[ex.Group] = append(running[ex.Group], ex)
+	}
+	return running, nil
+}
+
+// applyConcurrencyPolicy applies the replace and queue concurrency policies
+// when the job is running at its concurrency limit. It returns whether the
+// given execution should run now.
+func (j *Job) applyConcurrencyPolicy(ex *Execution) bool {
+	if j.Concurrency != ConcurrencyReplace && j.Concurrency != ConcurrencyQueue {
+		return true
+	}
+
+	running, err := j.runningGroups(j.logger)
+	if err != nil {
+		return false
+	}
+	limit := j.maxConcurrency()
+	if len(running) < limit {
+		return true
+	}
+
+	// Messages are delivered again by their trigger instead of being queued
+	if j.Concurrency == ConcurrencyQueue && ex.Trigger == TriggerMessage {
+		j.logger.WithField("job", j.Name).Info("job: Skipping message execution until running executions finish")
+		return false
+	}
+
+	if j.Concurrency == ConcurrencyQueue {
+		queued, err := j.Agent.applyQueueExecution(ex, j.maxQueueDepth())
+		if err != nil {
+			j.logger.WithError(err).WithField("job", j.Name).Error("job: Error queueing execution")
 			return false
 		}
+		if !queued {
+			j.logger.WithFields(logrus.Fields{
+				"job":             j.Name,
+				"max_queue_depth": j.maxQueueDepth(),
+			}).Warning("job: Skipping execution because the queue is full")
+			return false
+		}
+		j.logger.WithField("job", j.Name).Info("job: Queued execution until running executions finish")
+		return false
+	}
+
+	// Cancel the oldest running executions to make room for the new one, the
+	// new one doesn't run if they can't be cancelled.
+	groups := make([]int64, 0, len(running))
+	for g := range running {
+		groups = append(groups, g)
+	}
+	slices.Sort(groups)
+	replaced := true
+	cancelled := make(map[string]bool)
+	for _, g := range groups[:len(groups)-limit+1] {
+		for _, r := range running[g] {
+			err := j.Agent.cancelExecution(r)
+			if err == nil {
+				cancelled[r.Key()] = true
+			} else if !errors.Is(err, ErrExecutionNotRunning) {
+				j.logger.WithError(err).WithFields(logrus.Fields{
+					"job":       j.Name,
+					"execution": r.Key(),
+				}).Error("job: Error cancelling execution to replace it, skipping the new execution")
+				replaced = false
+			}
+		}
+	}
+	if !replaced {
+		return false
+	}
 
-		// Also check in-memory activeExecutions as a secondary check
-		// This catches executions that just started and may not be in storage yet
-		exs, err := j.Agent.GetActiveExecutions()
+	return j.waitReplaced(cancelled)
+}
+
+var (
+	// replaceTimeout is how long the replace concurrency policy waits for the
+	// cancelled executions to finish before giving up on the new one.
+	replaceTimeout = 30 * time.Second
+	// replacePollInterval is how often the cancelled executions are checked.
+	replacePollInterval = 200 * time.Millisecond
+)
+
+// waitReplaced waits for the cancelled executions to finish so the new
+// execution never overlaps with the ones it replaces. It returns false when
+// they are still running after replaceTimeout.
+func (j *Job) waitReplaced(cancelled map[string]bool) bool {
+	deadline := time.Now().Add(replaceTimeout)
+	for len(cancelled) > 0 {
+		running, err := j.runningGroups(j.logger)
 		if err != nil {
-			logger.WithError(err).Error("job: Error querying for active executions")
 			return false
 		}
 
-		for _, e := range exs {
-			if e.JobName == j.Name {
-				logger.WithFields(logrus.Fields{
-					"job":         j.Name,
-					"concurrency": j.Concurrency,
-					"job_status":  j.Status,
-				}).Info("job: Skipping concurrent execution (found in active executions)")
-				return false
+		done := true
+		for _, exs := range running {
+			for _, ex := range exs {
+				if cancelled[ex.Key()] {
+					done = false
+				}
 			}
 		}
+		if done {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			j.logger.WithFields(logrus.Fields{
+				"job":     j.Name,
+				"timeout": replaceTimeout,
+			}).Warning("job: Replaced executions didn't finish in time, skipping the new execution")
+			return false
+		}
+		time.Sleep(replacePollInterval)
 	}
 
 	return true
@@ -469,25 +1400,118 @@ func (j *Job) Validate() error {
 		return fmt.Errorf("name cannot be empty")
 	}
 
-	if valid, chr := isSlug(j.Name); !valid {
+	namespace, name := splitJobName(j.Name)
+	if valid, chr := isSlug(name); !valid {
 		return fmt.Errorf("name contains illegal character '%s'", chr)
 	}
 
-	if j.ParentJob == j.Name {
-		return ErrSameParent
+	if valid, chr := isSlug(namespace); !valid {
+		return fmt.Errorf("namespace contains illegal character '%s'", chr)
+	}
+
+	if j.Namespace != "" && j.Namespace != namespace {
+		return ErrNamespaceMismatch
+	}
+
+	parents := j.parents()
+	for _, p := range parents {
+		if p == j.Name {
+			return ErrSameParent
+		}
+		if ns, _ := splitJobName(p); ns != namespace {
+			return ErrCrossNamespaceParent
+		}
+	}
+
+	for p, trigger := range j.DependencyTriggers {
+		if !slices.Contains(parents, p) {
+			return fmt.Errorf("%w: %s", ErrTriggerNotParent, p)
+		}
+		switch trigger {
+		case TriggerOnSuccess, TriggerOnFailure, TriggerOnPartialFailure, TriggerAlways:
+		default:
+			return ErrWrongDependencyTrigger
+		}
+	}
+
+	// Scheduled and dependent runs don't pass parameters
+	runsWithoutParameters := len(parents) > 0 || j.Schedule != "@manually"
+	for name, p := range j.Parameters {
+		if !parameterNameRegexp.MatchString(name) {
+			return fmt.Errorf("%w: %s", ErrWrongParameterName, name)
+		}
+		if runsWithoutParameters && p != nil && p.Required && p.Default == "" {
+			return fmt.Errorf("%w: %s", ErrScheduledRequiredParameter, name)
+		}
 	}
 
 	// Validate schedule, allow empty schedule if parent job set.
-	if j.Schedule != "" || j.ParentJob == "" {
+	if j.Schedule != "" || len(parents) == 0 {
 		if _, err := extcron.Parse(j.scheduleHash()); err != nil {
 			return fmt.Errorf("%s: %s", ErrScheduleParse.Error(), err)
 		}
 	}
 
-	if j.Concurrency != ConcurrencyAllow && j.Concurrency != ConcurrencyForbid && j.Concurrency != "" {
+	switch j.Concurrency {
+	case "", ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace, ConcurrencyQueue:
+	default:
 		return ErrWrongConcurrency
 	}
 
+	if j.MaxConcurrency < 0 {
+		return ErrWrongMaxConcurrency
+	}
+
+	if j.MaxQueueDepth < 0 {
+		return ErrWrongMaxQueueDepth
+	}
+
+	if j.RetryBackoff != nil {
+		if err := j.RetryBackoff.validate(); err != nil {
+			return err
+		}
+	}
+
+	switch j.MisfirePolicy {
+	case "", MisfireSkip, MisfireRunOnce, MisfireRunAll:
+	default:
+		return ErrWrongMisfirePolicy
+	}
+
+	if j.MisfireLimit < 0 {
+		return ErrWrongMisfireLimit
+	}
+
+	if j.MisfireGrace != "" {
+		if d, err := time.ParseDuration(j.MisfireGrace); err != nil || d <= 0 {
+			return ErrWrongMisfireGrace
+		}
+	}
+
+	switch j.CalendarPolicy {
+	case "", CalendarSkip, CalendarShift:
+	default:
+		return ErrWrongCalendarPolicy
+	}
+
+	if _, err := parseTagConstraints(j.Constraints); err != nil {
+		return err
+	}
+
+	switch j.Selector {
+	case "", SelectorRandom, SelectorRoundRobin, SelectorLeastBusy, SelectorConsistentHash:
+	default:
+		if tag, ok := strings.CutPrefix(j.Selector, SelectorSpread+":"); !ok || tag == "" {
+			return ErrWrongSelector
+		}
+	}
+
+	switch j.RetryPlacement {
+	case "", RetryPlacementSameNode, RetryPlacementAnyNode, RetryPlacementDifferentNode:
+	default:
+		return ErrWrongRetryPlacement
+	}
+
 	// An empty string is a valid timezone for LoadLocation
 	if _, err := time.LoadLocation(j.Timezone); err != nil {
 		return err
@@ -507,9 +1531,27 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if j.Trigger != "" {
+		if _, _, err := plugin.BatchConfig(j.TriggerConfig); err != nil {
+			return fmt.Errorf("Error parsing job trigger config: %v", err)
+		}
+		if _, err := ackTimeout(j.TriggerConfig); err != nil {
+			return fmt.Errorf("Error parsing job trigger config: %v", err)
+		}
+	}
+
+	for _, field := range j.Overrides {
+		if _, ok := overridableFields[field]; !ok {
+			return fmt.Errorf("invalid override %q, it isn't a field inherited from templates", field)
+		}
+	}
+
 	return nil
 }
 
+// parameterNameRegexp matches the parameter names that can be used as template variables.
+var parameterNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 // isSlug determines whether the given string is a proper value to be used as
 // key in the backend store (a "slug"). If false, the 2nd return value
 // will contain the first illegal character found.
@@ -520,69 +1562,51 @@ func isSlug(candidate string) (bool, string) {
 	return whyNot == "", whyNot
 }
 
-// generate Job Tree
+// generateJobTree validates the given jobs and links every job to all of its
+// parents through ChildJobs, returning the jobs that have no parents.
 func generateJobTree(jobs []*Job) ([]*Job, error) {
-	length := len(jobs)
-	j := 0
-	for i := 0; i < length; i++ {
-		rejobs, isTopParentNodeFlag, err := findParentJobAndValidateJob(jobs, j)
-		if err != nil {
+	byName := make(map[string]*Job, len(jobs))
+	for _, job := range jobs {
+		if err := job.qualify(); err != nil {
 			return nil, err
 		}
-		if isTopParentNodeFlag {
-			j++
+		if err := job.Validate(); err != nil {
+			return nil, err
 		}
-		jobs = rejobs
+		byName[job.Name] = job
 	}
-	return jobs, nil
-}
 
-// findParentJobAndValidateJob...
-func findParentJobAndValidateJob(jobs []*Job, index int) ([]*Job, bool, error) {
-	childJob := jobs[index]
-	// Validate job
-	if err := childJob.Validate(); err != nil {
-		return nil, false, err
-	}
-	if childJob.ParentJob == "" {
-		return jobs, true, nil
+	lookup := func(name string) []string {
+		if job, ok := byName[name]; ok {
+			return job.parents()
+		}
+		return nil
 	}
-	for _, parentJob := range jobs {
-		if parentJob.Name == childJob.Name {
+
+	var roots []*Job
+	for _, job := range jobs {
+		parents := job.parents()
+		if len(parents) == 0 {
+			roots = append(roots, job)
 			continue
 		}
-		if childJob.ParentJob == parentJob.Name {
-			parentJob.ChildJobs = append(parentJob.ChildJobs, childJob)
-			jobs = append(jobs[:index], jobs[index+1:]...)
-			return jobs, false, nil
+
+		if err := checkDependencyCycle(job, lookup); err != nil {
+			return nil, err
 		}
-		if len(parentJob.ChildJobs) > 0 {
-			flag := findParentJobInChildJobs(parentJob.ChildJobs, childJob)
-			if flag {
-				jobs = append(jobs[:index], jobs[index+1:]...)
-				return jobs, false, nil
-			}
+		if err := checkCommonRoot(job.Name, lookup); err != nil {
+			return nil, err
 		}
-	}
-	return nil, false, ErrNoParent
-}
 
-func findParentJobInChildJobs(jobs []*Job, job *Job) bool {
-	for _, parentJob := range jobs {
-		if job.ParentJob == parentJob.Name {
-			parentJob.ChildJobs = append(parentJob.ChildJobs, job)
-			return true
-		} else {
-			if len(parentJob.ChildJobs) > 0 {
-				flag := findParentJobInChildJobs(parentJob.ChildJobs, job)
-				if flag {
-					return true
-				}
+		for _, p := range parents {
+			parentJob, ok := byName[p]
+			if !ok {
+				return nil, ErrNoParent
 			}
-
+			parentJob.ChildJobs = append(parentJob.ChildJobs, job)
 		}
 	}
-	return false
+	return roots, nil
 }
 
 // validateMemoryLimit validates a memory limit string and returns an error if invalid.
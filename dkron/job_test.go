@@ -135,6 +135,22 @@ func Test_isRunnable(t *testing.T) {
 	var exp ntime.NullableTime
 	exp.Set(time.Now().AddDate(0, 0, -1))
 
+	window := &MaintenanceWindow{
+		Name:     "billing-upgrade",
+		Selector: map[string]string{"team": "billing"},
+	}
+	window.StartsAt.Set(time.Now().Add(-time.Hour))
+	window.EndsAt.Set(time.Now().Add(time.Hour))
+	require.NoError(t, a.Store.SetMaintenanceWindow(context.Background(), window))
+
+	// Another job of the namespace runs its only concurrent execution
+	require.NoError(t, a.Store.SetNamespace(context.Background(), &Namespace{Name: "team-a", MaxConcurrentExecutions: 1}))
+	running := NewExecution("team-a/other_job", TriggerCron)
+	running.StartedAt = time.Now()
+	running.NodeName = "test1"
+	_, err := a.Store.SetExecution(context.Background(), running)
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name string
 		job  *Job
@@ -185,15 +201,107 @@ func Test_isRunnable(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "maintenance window",
+			job: &Job{
+				Name:     "billing_job",
+				Metadata: map[string]string{"team": "billing"},
+				Agent:    a,
+			},
+			want: false,
+		},
+		{
+			name: "namespace max concurrent executions",
+			job: &Job{
+				Name:      "team-a/report",
+				Namespace: "team-a",
+				Agent:     a,
+			},
+			want: false,
+		},
 	}
 
 	log := getTestLogger()
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, tt.job.isRunnable(log))
+			assert.Equal(t, tt.want, tt.job.isRunnable(log, NewExecution(tt.job.Name, TriggerCron)))
 		})
 	}
+
+	// The run suppressed by the maintenance window is recorded
+	suppressions, err := a.Store.GetSuppressions(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, suppressions, 1)
+	assert.Equal(t, "billing_job", suppressions[0].JobName)
+	assert.Equal(t, "billing-upgrade", suppressions[0].Window)
+	assert.Equal(t, WindowSkip, suppressions[0].Policy)
+}
+
+func TestJobDeferredRuns(t *testing.T) {
+	ip1, returnFn1 := testutil.TakeIP()
+	defer returnFn1()
+
+	c := DefaultConfig()
+	c.BindAddr = ip1.String()
+	c.NodeName = "test1"
+	c.Server = true
+	c.LogLevel = logLevel
+	c.BootstrapExpect = 1
+	c.DevMode = true
+
+	a := NewAgent(c)
+	a.GRPCClient = &gRPCClientMock{}
+	require.NoError(t, a.Start())
+	defer a.Stop() // nolint: errcheck
+
+	for !a.IsLeader() {
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	ctx := context.Background()
+	window := &MaintenanceWindow{Name: "upgrade", Policy: WindowDefer}
+	window.StartsAt.Set(time.Now().Add(-time.Hour))
+	window.EndsAt.Set(time.Now().Add(time.Hour))
+	require.NoError(t, a.Store.SetMaintenanceWindow(ctx, window))
+
+	job := &Job{Name: "deferred_job", Schedule: "@every 1m", Executor: "shell", Agent: a}
+	require.NoError(t, a.Store.SetJob(ctx, job, false))
+
+	// Each suppressed run replaces the one deferred before
+	log := getTestLogger()
+	var last *Execution
+	for i := 0; i < 3; i++ {
+		last = NewExecution(job.Name, TriggerCron)
+		last.ScheduledAt = time.Now().Add(time.Duration(i) * time.Minute)
+		assert.False(t, job.isRunnable(log, last))
+	}
+
+	runs, err := a.Store.GetDeferredRuns(ctx, job.Name)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, last.Group, runs[0].Execution.Group)
+	assert.WithinDuration(t, window.EndsAt.Get(), runs[0].RunAt, time.Second)
+
+	// Deferred runs are not retries of failed executions
+	retries, err := a.Store.GetPendingRetries(ctx, job.Name)
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+
+	a.retryTimersLock.Lock()
+	assert.Len(t, a.retryTimers, 1)
+	a.retryTimersLock.Unlock()
+
+	// The deferred run goes through the job checks, it's deferred again
+	// while the window is active
+	a.runDeferredRun(runs[0])
+	runs, err = a.Store.GetDeferredRuns(ctx, job.Name)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+
+	suppressions, err := a.Store.GetSuppressions(ctx, job.Name)
+	require.NoError(t, err)
+	assert.Len(t, suppressions, 4)
 }
 
 func Test_scheduleHash(t *testing.T) {
@@ -214,11 +322,16 @@ type gRPCClientMock struct {
 func (gRPCClientMock) Connect(s string) (*grpc.ClientConn, error) { return nil, nil }
 func (gRPCClientMock) ExecutionDone(s string, e *Execution) error { return nil }
 func (gRPCClientMock) GetJob(s string, a string) (*Job, error)    { return nil, nil }
-func (gRPCClientMock) SetJob(j *Job) error                        { return nil }
-func (gRPCClientMock) DeleteJob(s string) (*Job, error)           { return nil, nil }
-func (gRPCClientMock) DeleteExecutions(s string) (*Job, error)    { return nil, nil }
-func (gRPCClientMock) Leave(s string) error                       { return nil }
-func (gRPCClientMock) RunJob(s string) (*Job, error)              { return nil, nil }
+func (gRPCClientMock) SetJob(j *Job, r uint64) error              { return nil }
+func (gRPCClientMock) PatchJob(n, t string, p []byte, r uint64, a string) (*Job, error) {
+	return nil, nil
+}
+func (gRPCClientMock) DeleteJob(s string) (*Job, error)        { return nil, nil }
+func (gRPCClientMock) DeleteExecutions(s string) (*Job, error) { return nil, nil }
+func (gRPCClientMock) Leave(s string) error                    { return nil }
+func (gRPCClientMock) RunJob(s string, p map[string]string, t string) (*Job, error) {
+	return nil, nil
+}
 func (gRPCClientMock) RaftGetConfiguration(s string) (*proto.RaftGetConfigurationResponse, error) {
 	return nil, nil
 }
@@ -234,6 +347,30 @@ func (gRPCClientMock) SetExecution(execution *proto.Execution) error { return ni
 func (gRPCClientMock) AgentRun(addr string, job *proto.Job, execution *proto.Execution) error {
 	return nil
 }
+func (gRPCClientMock) CancelExecution(addr string, j string, e string) (*Execution, error) {
+	return nil, nil
+}
+func (gRPCClientMock) AgentCancel(addr string, e string) (bool, error) { return false, nil }
+func (gRPCClientMock) SetCalendar(c *Calendar) error                   { return nil }
+func (gRPCClientMock) DeleteCalendar(n string) (*Calendar, error)      { return nil, nil }
+func (gRPCClientMock) SetMaintenanceWindow(w *MaintenanceWindow) error { return nil }
+func (gRPCClientMock) DeleteMaintenanceWindow(n string) (*MaintenanceWindow, error) {
+	return nil, nil
+}
+func (gRPCClientMock) SetPause(p *Pause) error                   { return nil }
+func (gRPCClientMock) DeletePause(id string) ([]*Pause, error)   { return nil, nil }
+func (gRPCClientMock) SetWebhookTrigger(w *WebhookTrigger) error { return nil }
+func (gRPCClientMock) DeleteWebhookTrigger(id string) (*WebhookTrigger, error) {
+	return nil, nil
+}
+func (gRPCClientMock) SetJobTemplate(t *JobTemplate) error { return nil }
+func (gRPCClientMock) DeleteJobTemplate(n string) (*JobTemplate, error) {
+	return nil, nil
+}
+func (gRPCClientMock) SetNamespace(n *Namespace) error { return nil }
+func (gRPCClientMock) DeleteNamespace(n string) (*Namespace, error) {
+	return nil, nil
+}
 
 func Test_generateJobTree(t *testing.T) {
 	jsonString := `[
@@ -513,3 +650,493 @@ func Test_generateJobTree(t *testing.T) {
 	}
 	assert.Equal(t, len(jobTree), 3)
 }
+
+func Test_generateJobTreeMultipleParents(t *testing.T) {
+	jobs := []*Job{
+		{Name: "start", Schedule: "@daily"},
+		{Name: "extract1", ParentJob: "start"},
+		{Name: "extract2", ParentJob: "start"},
+		{Name: "transform", ParentJobs: []string{"extract1", "extract2"}},
+		{Name: "load", ParentJob: "transform"},
+	}
+
+	jobTree, err := generateJobTree(jobs)
+	require.NoError(t, err)
+	require.Len(t, jobTree, 1)
+	require.Len(t, jobTree[0].ChildJobs, 2)
+	assert.Equal(t, "transform", jobTree[0].ChildJobs[0].ChildJobs[0].Name)
+	assert.Equal(t, "transform", jobTree[0].ChildJobs[1].ChildJobs[0].Name)
+	assert.Equal(t, "load", jobTree[0].ChildJobs[0].ChildJobs[0].ChildJobs[0].Name)
+}
+
+func Test_generateJobTreeIndependentParents(t *testing.T) {
+	// The runs of two independently scheduled roots can't be matched
+	jobs := []*Job{
+		{Name: "extract1", Schedule: "@daily"},
+		{Name: "extract2", Schedule: "@daily"},
+		{Name: "transform", ParentJobs: []string{"extract1", "extract2"}},
+	}
+
+	_, err := generateJobTree(jobs)
+	assert.ErrorIs(t, err, ErrIndependentParents)
+}
+
+func Test_generateJobTreeCycle(t *testing.T) {
+	jobs := []*Job{
+		{Name: "root", Schedule: "@daily"},
+		{Name: "job1", ParentJobs: []string{"root", "job3"}},
+		{Name: "job2", ParentJob: "job1"},
+		{Name: "job3", ParentJob: "job2"},
+	}
+
+	_, err := generateJobTree(jobs)
+	assert.ErrorIs(t, err, ErrDependencyCycle)
+}
+
+func TestJobValidateParents(t *testing.T) {
+	job := &Job{
+		Name:       "test_job",
+		ParentJobs: []string{"other_job", "test_job"},
+	}
+	assert.ErrorIs(t, job.Validate(), ErrSameParent)
+
+	job.ParentJobs = []string{"other_job", "another_job"}
+	assert.NoError(t, job.Validate())
+	assert.Equal(t, []string{"other_job", "another_job"}, job.parents())
+
+	job.ParentJob = "another_job"
+	assert.Equal(t, []string{"another_job", "other_job"}, job.parents())
+}
+
+func TestJobValidateDependencyTriggers(t *testing.T) {
+	job := &Job{
+		Name:               "test_job",
+		ParentJobs:         []string{"other_job", "another_job"},
+		DependencyTriggers: map[string]string{"other_job": "on_timeout"},
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongDependencyTrigger)
+
+	job.DependencyTriggers = map[string]string{"unknown_job": TriggerAlways}
+	assert.ErrorIs(t, job.Validate(), ErrTriggerNotParent)
+
+	job.DependencyTriggers = map[string]string{"other_job": TriggerOnFailure}
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobResolveParameters(t *testing.T) {
+	job := &Job{
+		Name: "test_job",
+		Parameters: map[string]*JobParameter{
+			"date": {Required: true},
+			"mode": {Default: "full"},
+			"dry":  {},
+		},
+	}
+
+	_, err := job.resolveParameters(map[string]string{"mode": "fast"})
+	assert.ErrorIs(t, err, ErrMissingParameter)
+
+	_, err = job.resolveParameters(map[string]string{"date": "2024-01-31", "other": "x"})
+	assert.ErrorIs(t, err, ErrUnknownParameter)
+
+	params, err := job.resolveParameters(map[string]string{"date": "2024-01-31"})
+	assert.NoError(t, err)
+	// The parameters not passed and without default are empty
+	assert.Equal(t, map[string]string{"date": "2024-01-31", "mode": "full", "dry": ""}, params)
+
+	// Jobs without parameters don't accept any
+	job.Parameters = nil
+	params, err = job.resolveParameters(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, params)
+	_, err = job.resolveParameters(map[string]string{"date": "2024-01-31"})
+	assert.ErrorIs(t, err, ErrUnknownParameter)
+}
+
+func TestJobValidateParameters(t *testing.T) {
+	job := &Job{
+		Name:       "test_job",
+		Schedule:   "@every 1m",
+		Parameters: map[string]*JobParameter{"from-date": {}},
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongParameterName)
+
+	job.Parameters = map[string]*JobParameter{"from_date": {Default: "today"}}
+	assert.NoError(t, job.Validate())
+
+	// Scheduled and dependent runs can't pass required parameters
+	job.Parameters = map[string]*JobParameter{"from_date": {Required: true}}
+	assert.ErrorIs(t, job.Validate(), ErrScheduledRequiredParameter)
+
+	job.Schedule = "@manually"
+	assert.NoError(t, job.Validate())
+
+	job.ParentJob = "other_job"
+	assert.ErrorIs(t, job.Validate(), ErrScheduledRequiredParameter)
+}
+
+func TestJobValidateConcurrency(t *testing.T) {
+	job := &Job{
+		Name:        "test_job",
+		Schedule:    "@every 1m",
+		Concurrency: "badvalue",
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongConcurrency)
+
+	job.Concurrency = ConcurrencyQueue
+	job.MaxConcurrency = -1
+	assert.ErrorIs(t, job.Validate(), ErrWrongMaxConcurrency)
+
+	job.MaxConcurrency = 2
+	job.MaxQueueDepth = -1
+	assert.ErrorIs(t, job.Validate(), ErrWrongMaxQueueDepth)
+
+	job.MaxQueueDepth = 5
+	assert.NoError(t, job.Validate())
+
+	job.Concurrency = ConcurrencyReplace
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobValidateRetryBackoff(t *testing.T) {
+	job := &Job{
+		Name:         "test_job",
+		Schedule:     "@every 1m",
+		RetryBackoff: &RetryBackoff{Strategy: "random"},
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongRetryBackoff)
+
+	job.RetryBackoff = &RetryBackoff{BaseDelay: "10"}
+	assert.ErrorIs(t, job.Validate(), ErrWrongRetryDelay)
+
+	job.RetryBackoff = &RetryBackoff{MaxDelay: "-1m"}
+	assert.ErrorIs(t, job.Validate(), ErrWrongRetryDelay)
+
+	job.RetryBackoff = &RetryBackoff{Jitter: 1.5}
+	assert.ErrorIs(t, job.Validate(), ErrWrongRetryJitter)
+
+	job.RetryBackoff = &RetryBackoff{Strategy: RetryBackoffLinear, BaseDelay: "10s", MaxDelay: "1m", Jitter: 0.2}
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobValidateRetryPlacement(t *testing.T) {
+	job := &Job{
+		Name:           "test_job",
+		Schedule:       "@every 1m",
+		RetryPlacement: "other_node",
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongRetryPlacement)
+
+	job.RetryPlacement = RetryPlacementDifferentNode
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobValidateSelector(t *testing.T) {
+	job := &Job{
+		Name:     "test_job",
+		Schedule: "@every 1m",
+		Selector: "fastest",
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongSelector)
+
+	job.Selector = SelectorSpread + ":"
+	assert.ErrorIs(t, job.Validate(), ErrWrongSelector)
+
+	job.Selector = SelectorSpread + ":zone"
+	assert.NoError(t, job.Validate())
+
+	job.Selector = SelectorConsistentHash
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobValidateConstraints(t *testing.T) {
+	job := &Job{
+		Name:        "test_job",
+		Schedule:    "@every 1m",
+		Constraints: []string{"os != windows", "mem_gb >= many"},
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongConstraint)
+
+	job.Constraints = []string{"os != windows", "mem_gb >= 16"}
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobValidateCalendarPolicy(t *testing.T) {
+	job := &Job{
+		Name:           "test_job",
+		Schedule:       "@every 1m",
+		Calendar:       "business",
+		CalendarPolicy: "postpone",
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongCalendarPolicy)
+
+	job.CalendarPolicy = CalendarShift
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobValidateMisfire(t *testing.T) {
+	job := &Job{
+		Name:          "test_job",
+		Schedule:      "@every 1m",
+		MisfirePolicy: "run_twice",
+	}
+	assert.ErrorIs(t, job.Validate(), ErrWrongMisfirePolicy)
+
+	job.MisfirePolicy = MisfireRunAll
+	job.MisfireLimit = -1
+	assert.ErrorIs(t, job.Validate(), ErrWrongMisfireLimit)
+
+	job.MisfireLimit = 5
+	job.MisfireGrace = "1 hour"
+	assert.ErrorIs(t, job.Validate(), ErrWrongMisfireGrace)
+
+	job.MisfireGrace = "1h"
+	assert.NoError(t, job.Validate())
+}
+
+func TestJobMisfires(t *testing.T) {
+	last := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	now := last.Add(5*time.Hour + 30*time.Minute)
+	hour := func(h int) time.Time { return last.Add(time.Duration(h) * time.Hour) }
+
+	testCases := []struct {
+		name string
+		job  *Job
+		last time.Time
+		want []time.Time
+	}{
+		{
+			name: "skip by default",
+			job:  &Job{Schedule: "0 0 * * * *"},
+			last: last,
+		},
+		{
+			name: "never fired",
+			job:  &Job{Schedule: "0 0 * * * *", MisfirePolicy: MisfireRunAll},
+		},
+		{
+			name: "run once runs the last missed",
+			job:  &Job{Schedule: "0 0 * * * *", MisfirePolicy: MisfireRunOnce},
+			last: last,
+			want: []time.Time{hour(5)},
+		},
+		{
+			name: "run all",
+			job:  &Job{Schedule: "0 0 * * * *", MisfirePolicy: MisfireRunAll},
+			last: last,
+			want: []time.Time{hour(1), hour(2), hour(3), hour(4), hour(5)},
+		},
+		{
+			name: "run all up to the limit",
+			job:  &Job{Schedule: "0 0 * * * *", MisfirePolicy: MisfireRunAll, MisfireLimit: 2},
+			last: last,
+			want: []time.Time{hour(4), hour(5)},
+		},
+		{
+			name: "run all within the grace window",
+			job:  &Job{Schedule: "0 0 * * * *", MisfirePolicy: MisfireRunAll, MisfireGrace: "3h"},
+			last: last,
+			want: []time.Time{hour(3), hour(4), hour(5)},
+		},
+		{
+			name: "nothing missed",
+			job:  &Job{Schedule: "0 0 * * * *", MisfirePolicy: MisfireRunAll},
+			last: hour(5),
+		},
+		{
+			name: "manual jobs never miss",
+			job:  &Job{Schedule: "@manually", MisfirePolicy: MisfireRunAll},
+			last: last,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.job.Timezone = "UTC"
+			got, err := tc.job.misfires(nil, tc.last, now)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAgentRunMisfiresStops(t *testing.T) {
+	ip1, returnFn1 := testutil.TakeIP()
+	defer returnFn1()
+
+	c := DefaultConfig()
+	c.BindAddr = ip1.String()
+	c.NodeName = "test-misfires"
+	c.Server = true
+	c.LogLevel = logLevel
+	c.BootstrapExpect = 1
+	c.DevMode = true
+
+	a := NewAgent(c)
+	a.GRPCClient = &gRPCClientMock{}
+	require.NoError(t, a.Start())
+	defer a.Stop() // nolint: errcheck
+
+	for !a.IsLeader() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	ctx := context.Background()
+	job := &Job{
+		Name:          "misfire_job",
+		Schedule:      "0 0 * * * *",
+		Executor:      "shell",
+		MisfirePolicy: MisfireRunOnce,
+		Agent:         a,
+		logger:        getTestLogger(),
+	}
+	require.NoError(t, a.Store.SetJob(ctx, job, true))
+	missed := map[string][]time.Time{job.Name: {time.Now().Truncate(time.Hour)}}
+
+	// The missed executions don't run once the leadership is lost
+	stopCh := make(chan struct{})
+	close(stopCh)
+	a.runMisfires(stopCh, []*Job{job}, missed)
+	time.Sleep(200 * time.Millisecond)
+	last, err := a.Store.GetLastFire(ctx, job.Name)
+	require.NoError(t, err)
+	assert.True(t, last.IsZero())
+
+	a.runMisfires(make(chan struct{}), []*Job{job}, missed)
+	assert.Eventually(t, func() bool {
+		last, err := a.Store.GetLastFire(ctx, job.Name)
+		return err == nil && last.Equal(missed[job.Name][0])
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestJobNextRuns(t *testing.T) {
+	from := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return from.Add(time.Duration(h) * time.Hour) }
+
+	job := &Job{Name: "test_job", Schedule: "0 0 * * * *"}
+	runs, err := job.nextRuns(nil, from, time.Time{}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{hour(1), hour(2), hour(3)}, runs)
+
+	// Up to the end of the window
+	runs, err = job.nextRuns(nil, from, hour(2), 10)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{hour(1)}, runs)
+
+	// Start and expiration dates
+	job.StartsAt.Set(hour(2))
+	job.ExpiresAt.Set(hour(4))
+	runs, err = job.nextRuns(nil, from, time.Time{}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{hour(2), hour(3), hour(4)}, runs)
+
+	// Times in the job timezone
+	job = &Job{Name: "test_job", Schedule: "0 0 9 * * *", Timezone: "America/New_York"}
+	runs, err = job.nextRuns(nil, from, time.Time{}, 1)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "2024-01-01T09:00:00-05:00", runs[0].Format(time.RFC3339))
+
+	// Recurrence rules in the job timezone, from the start date
+	job = &Job{
+		Name:     "test_job",
+		Schedule: "@rrule FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;BYHOUR=9",
+		Timezone: "America/New_York",
+	}
+	job.StartsAt.Set(time.Date(2024, 1, 9, 5, 0, 0, 0, time.UTC))
+	job.ExpiresAt.Set(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, job.Validate())
+	runs, err = job.nextRuns(nil, from, time.Time{}, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "2024-01-09T09:00:00-05:00", runs[0].Format(time.RFC3339))
+	assert.Equal(t, "2024-01-23T09:00:00-05:00", runs[1].Format(time.RFC3339))
+
+	// Without start date the rule starts on the day the job was created
+	job.StartsAt = ntime.NullableTime{}
+	require.NoError(t, job.Validate())
+	job.CreatedAt = time.Date(2024, 1, 9, 20, 0, 0, 0, time.UTC)
+	runs, err = job.nextRuns(nil, from, time.Time{}, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "2024-01-09T09:00:00-05:00", runs[0].Format(time.RFC3339))
+	assert.Equal(t, "2024-01-23T09:00:00-05:00", runs[1].Format(time.RFC3339))
+
+	// Hashed schedules use the job name
+	job = &Job{Name: "test_job", Schedule: "~ 0 * * * *"}
+	runs, err = job.nextRuns(nil, from, time.Time{}, 2)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, job.nameHash()%60, runs[0].Second())
+
+	// Days excluded by the calendar, 2024-01-06 and 2024-01-07 are a weekend
+	cal := &Calendar{Name: "business", ExcludedWeekdays: []string{"saturday", "sunday"}}
+	job = &Job{Name: "test_job", Schedule: "0 0 12 * * *"}
+	runs, err = job.nextRuns(cal, from, time.Time{}, 6)
+	require.NoError(t, err)
+	require.Len(t, runs, 6)
+	assert.Equal(t, time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC), runs[5])
+
+	// Jobs without a cron schedule
+	job = &Job{Name: "test_job", Schedule: "@manually"}
+	runs, err = job.nextRuns(nil, from, time.Time{}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+}
+
+func TestRetryBackoffDelay(t *testing.T) {
+	testCases := []struct {
+		backoff *RetryBackoff
+		retry   uint
+		want    time.Duration
+	}{
+		{&RetryBackoff{}, 1, time.Second},
+		{&RetryBackoff{}, 4, 8 * time.Second},
+		{&RetryBackoff{Strategy: RetryBackoffFixed, BaseDelay: "5s"}, 3, 5 * time.Second},
+		{&RetryBackoff{Strategy: RetryBackoffLinear, BaseDelay: "5s"}, 3, 15 * time.Second},
+		{&RetryBackoff{Strategy: RetryBackoffExponential, BaseDelay: "5s"}, 3, 20 * time.Second},
+		{&RetryBackoff{Strategy: RetryBackoffExponential, BaseDelay: "5s", MaxDelay: "1m"}, 10, time.Minute},
+		{&RetryBackoff{Strategy: RetryBackoffExponential, BaseDelay: "1h", MaxDelay: "2h"}, 200, 2 * time.Hour},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, tc.backoff.delay(tc.retry), "%+v/%d", tc.backoff, tc.retry)
+	}
+
+	jittered := &RetryBackoff{Strategy: RetryBackoffFixed, BaseDelay: "10s", Jitter: 0.5}
+	for i := 0; i < 10; i++ {
+		d := jittered.delay(1)
+		assert.GreaterOrEqual(t, d, 5*time.Second)
+		assert.LessOrEqual(t, d, 15*time.Second)
+	}
+}
+
+func TestJobTriggeredBy(t *testing.T) {
+	job := &Job{
+		Name:       "test_job",
+		ParentJobs: []string{"success", "failure", "partial", "always"},
+		DependencyTriggers: map[string]string{
+			"failure": TriggerOnFailure,
+			"partial": TriggerOnPartialFailure,
+			"always":  TriggerAlways,
+		},
+	}
+
+	testCases := []struct {
+		parent string
+		status string
+		want   bool
+	}{
+		{"success", StatusSuccess, true},
+		{"success", StatusFailed, false},
+		{"failure", StatusFailed, true},
+		{"failure", StatusPartiallyFailed, false},
+		{"failure", StatusSuccess, false},
+		{"partial", StatusPartiallyFailed, true},
+		{"partial", StatusFailed, false},
+		{"always", StatusSuccess, true},
+		{"always", StatusFailed, true},
+		{"always", StatusPartiallyFailed, true},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, job.triggeredBy(tc.parent, tc.status), "%s/%s", tc.parent, tc.status)
+	}
+}
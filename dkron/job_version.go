@@ -0,0 +1,104 @@
+package dkron
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// JobVersion is a numbered version of the definition of a job, recorded on
+// every change to the job.
+type JobVersion struct {
+	// Name of the job.
+	JobName string `json:"job_name"`
+
+	// Version number, starting at 1 for the job creation.
+	Version int64 `json:"version"`
+
+	// Time of the change.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Who made the change.
+	Actor string `json:"actor"`
+
+	// Job definition in this version, as stored: jobs inheriting from a
+	// template only have the fields they override.
+	Job *Job `json:"job"`
+}
+
+// JobChange is a change to a field of the job definition between two versions.
+type JobChange struct {
+	// Name of the field as in the job JSON.
+	Field string `json:"field"`
+
+	// Value of the field in the older version.
+	From json.RawMessage `json:"from"`
+
+	// Value of the field in the newer version.
+	To json.RawMessage `json:"to"`
+}
+
+// NewJobVersionFromProto maps a proto.JobVersion to a JobVersion object
+func NewJobVersionFromProto(in *proto.JobVersion, logger *logrus.Entry) *JobVersion {
+	return &JobVersion{
+		JobName:   in.JobName,
+		Version:   in.Version,
+		CreatedAt: in.GetCreatedAt().AsTime(),
+		Actor:     in.Actor,
+		Job:       NewJobFromProto(in.GetJob(), logger),
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current job version.
+func (v *JobVersion) ToProto() *proto.JobVersion {
+	return &proto.JobVersion{
+		JobName:   v.JobName,
+		Version:   v.Version,
+		CreatedAt: timestamppb.New(v.CreatedAt),
+		Actor:     v.Actor,
+		Job:       v.Job.ToProto(),
+	}
+}
+
+// diffJobs returns the fields that changed from one job definition to the
+// other, sorted by field name.
+func diffJobs(from, to *Job) ([]JobChange, error) {
+	fromFields, err := jobFields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := jobFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []JobChange{}
+	for field, value := range toFields {
+		if !bytes.Equal(fromFields[field], value) {
+			changes = append(changes, JobChange{Field: field, From: fromFields[field], To: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+// jobFields returns the JSON values of the job fields by name.
+func jobFields(job *Job) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
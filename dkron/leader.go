@@ -232,7 +232,29 @@ func (a *Agent) establishLeadership(stopCh chan struct{}) error {
 	if err != nil {
 		return err
 	}
-	return a.sched.Start(jobs, a)
+	// Look for missed executions before the scheduler records new fire times
+	missed := a.getMisfires(ctx, jobs)
+	if err := a.sched.Start(jobs, a); err != nil {
+		return err
+	}
+
+	// Resume the retries, deferred runs, missed executions and queues left by the
+	// previous leader
+	if err := a.resumeRetries(ctx); err != nil {
+		return err
+	}
+	if err := a.resumeDeferredRuns(ctx); err != nil {
+		return err
+	}
+	a.runMisfires(stopCh, jobs, missed)
+	a.startConsumers(jobs)
+
+	for _, job := range jobs {
+		if job.Concurrency == ConcurrencyQueue {
+			a.runQueued(ctx, job)
+		}
+	}
+	return nil
 }
 
 // revokeLeadership is invoked once we step down as leader.
@@ -242,6 +264,8 @@ func (a *Agent) revokeLeadership() error {
 	// Stop the scheduler, running jobs will continue to finish but we
 	// can not actively wait for them blocking the execution here.
 	a.sched.Stop()
+	a.stopRetries()
+	a.stopConsumers()
 
 	return nil
 }
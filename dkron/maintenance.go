@@ -0,0 +1,335 @@
+package dkron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/distribworks/dkron/v4/extcron"
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/ntime"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// WindowSkip doesn't run the jobs while the maintenance window is active.
+	WindowSkip = "skip"
+	// WindowDefer runs once the runs of the jobs suppressed by the maintenance
+	// window when it ends.
+	WindowDefer = "defer"
+
+	// maxSuppressions is the number of suppressed runs kept per job.
+	maxSuppressions = 100
+)
+
+var (
+	// ErrWrongWindowPolicy is returned when the maintenance window policy is set to a non existing setting.
+	ErrWrongWindowPolicy = errors.New("invalid maintenance window policy value, use \"skip\" or \"defer\"")
+	// ErrWindowTimes is returned when a maintenance window has no valid time span.
+	ErrWindowTimes = errors.New("set starts_at and ends_at for a one-off window, or schedule and duration for a recurring window")
+)
+
+// MaintenanceWindow is a time span when the scheduled runs of the matching
+// jobs are suppressed. A window happens once, from StartsAt to EndsAt, or
+// recurs following a schedule for a duration.
+type MaintenanceWindow struct {
+	// Window name. Must be unique, acts as the id.
+	Name string `json:"name"`
+
+	// Description of the window.
+	Description string `json:"description"`
+
+	// Start of a one-off window. For recurring windows, the time from
+	// which the window recurs.
+	StartsAt ntime.NullableTime `json:"starts_at"`
+
+	// End of a one-off window. For recurring windows, the time after which
+	// the window doesn't recur anymore.
+	EndsAt ntime.NullableTime `json:"ends_at"`
+
+	// Schedule of the start of a recurring window, in the job schedule format.
+	Schedule string `json:"schedule"`
+
+	// How long a recurring window lasts, like "2h".
+	Duration string `json:"duration"`
+
+	// The timezone of the schedule of a recurring window.
+	Timezone string `json:"timezone"`
+
+	// Metadata of the jobs the window applies to, all the jobs when empty.
+	Selector map[string]string `json:"selector"`
+
+	// What to do with the runs suppressed by the window skip/defer.
+	Policy string `json:"policy"`
+}
+
+// NewMaintenanceWindowFromProto maps a proto.MaintenanceWindow to a MaintenanceWindow object
+func NewMaintenanceWindowFromProto(in *proto.MaintenanceWindow) *MaintenanceWindow {
+	w := &MaintenanceWindow{
+		Name:        in.Name,
+		Description: in.Description,
+		Schedule:    in.Schedule,
+		Duration:    in.Duration,
+		Timezone:    in.Timezone,
+		Selector:    in.Selector,
+		Policy:      in.Policy,
+	}
+	if in.StartsAt != nil {
+		w.StartsAt.Set(in.StartsAt.AsTime())
+	}
+	if in.EndsAt != nil {
+		w.EndsAt.Set(in.EndsAt.AsTime())
+	}
+	return w
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current maintenance window.
+func (w *MaintenanceWindow) ToProto() *proto.MaintenanceWindow {
+	pw := &proto.MaintenanceWindow{
+		Name:        w.Name,
+		Description: w.Description,
+		Schedule:    w.Schedule,
+		Duration:    w.Duration,
+		Timezone:    w.Timezone,
+		Selector:    w.Selector,
+		Policy:      w.Policy,
+	}
+	if w.StartsAt.HasValue() {
+		pw.StartsAt = timestamppb.New(w.StartsAt.Get())
+	}
+	if w.EndsAt.HasValue() {
+		pw.EndsAt = timestamppb.New(w.EndsAt.Get())
+	}
+	return pw
+}
+
+// Validate validates the maintenance window.
+func (w *MaintenanceWindow) Validate() error {
+	if w.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if valid, chr := isSlug(w.Name); !valid {
+		return fmt.Errorf("name contains illegal character '%s'", chr)
+	}
+
+	if w.Policy != "" && w.Policy != WindowSkip && w.Policy != WindowDefer {
+		return ErrWrongWindowPolicy
+	}
+
+	if w.StartsAt.HasValue() && w.EndsAt.HasValue() && !w.EndsAt.Get().After(w.StartsAt.Get()) {
+		return fmt.Errorf("ends_at must be after starts_at")
+	}
+
+	// One-off windows
+	if w.Schedule == "" && w.Duration == "" {
+		if !w.StartsAt.HasValue() || !w.EndsAt.HasValue() {
+			return ErrWindowTimes
+		}
+		return nil
+	}
+
+	if w.Schedule == "" || w.Duration == "" {
+		return ErrWindowTimes
+	}
+	if _, err := w.parseSchedule(); err != nil {
+		return fmt.Errorf("%s: %s", ErrScheduleParse.Error(), err)
+	}
+	if d, err := time.ParseDuration(w.Duration); err != nil || d <= 0 {
+		return fmt.Errorf("invalid duration %q", w.Duration)
+	}
+
+	return nil
+}
+
+// parseSchedule parses the schedule of a recurring window.
+func (w *MaintenanceWindow) parseSchedule() (cron.Schedule, error) {
+	return extcron.Parse(withTimezone(w.Schedule, w.Timezone))
+}
+
+// matches reports whether the window applies to the given job.
+func (w *MaintenanceWindow) matches(job *Job) bool {
+	return matchesMetadata(w.Selector, job.Metadata)
+}
+
+// matchesMetadata reports whether the metadata has all the pairs of the
+// selector, an empty selector matches any metadata.
+func matchesMetadata(selector, metadata map[string]string) bool {
+	for k, v := range selector {
+		if metadata[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// activeAt returns when the window ends if it is active at the given time.
+func (w *MaintenanceWindow) activeAt(t time.Time) (time.Time, bool) {
+	if w.StartsAt.HasValue() && t.Before(w.StartsAt.Get()) {
+		return time.Time{}, false
+	}
+	if w.EndsAt.HasValue() && !t.Before(w.EndsAt.Get()) {
+		return time.Time{}, false
+	}
+	if w.Schedule == "" {
+		return w.EndsAt.Get(), true
+	}
+
+	sched, err := w.parseSchedule()
+	if err != nil {
+		return time.Time{}, false
+	}
+	d, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// The first window started in the duration up to t, it ends before t
+	// when it started exactly the duration before
+	start := sched.Next(t.Add(-d))
+	if start.IsZero() || start.After(t) {
+		return time.Time{}, false
+	}
+	return start.Add(d), true
+}
+
+// Suppression is a run of a job suppressed by a maintenance window.
+type Suppression struct {
+	// Name of the job.
+	JobName string `json:"job_name"`
+
+	// Name of the maintenance window that suppressed the run.
+	Window string `json:"window"`
+
+	// Policy of the window, skip or defer.
+	Policy string `json:"policy"`
+
+	// What triggered the suppressed run.
+	Trigger string `json:"trigger"`
+
+	// Time the run was scheduled at.
+	ScheduledAt time.Time `json:"scheduled_at"`
+
+	// Time the run was suppressed.
+	SuppressedAt time.Time `json:"suppressed_at"`
+
+	// Time a deferred run runs, when the window ends.
+	RunAt time.Time `json:"run_at"`
+}
+
+// NewSuppressionFromProto maps a proto.Suppression to a Suppression object
+func NewSuppressionFromProto(in *proto.Suppression) *Suppression {
+	s := &Suppression{
+		JobName:      in.JobName,
+		Window:       in.Window,
+		Policy:       in.Policy,
+		Trigger:      in.Trigger,
+		ScheduledAt:  in.GetScheduledAt().AsTime(),
+		SuppressedAt: in.GetSuppressedAt().AsTime(),
+	}
+	if in.RunAt != nil {
+		s.RunAt = in.RunAt.AsTime()
+	}
+	return s
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current suppression.
+func (s *Suppression) ToProto() *proto.Suppression {
+	ps := &proto.Suppression{
+		JobName:      s.JobName,
+		Window:       s.Window,
+		Policy:       s.Policy,
+		Trigger:      s.Trigger,
+		ScheduledAt:  timestamppb.New(s.ScheduledAt),
+		SuppressedAt: timestamppb.New(s.SuppressedAt),
+	}
+	if !s.RunAt.IsZero() {
+		ps.RunAt = timestamppb.New(s.RunAt)
+	}
+	return ps
+}
+
+// suppress checks the maintenance windows of the job, returning whether the
+// given run is suppressed by an active window. Suppressed runs are recorded,
+// and the last deferred one is stored as a pending retry to run when the last
+// active window ends, replacing the run deferred before. A skipping window
+// wins over deferring ones.
+func (j *Job) suppress(logger *logrus.Entry, ex *Execution) bool {
+	windows, err := j.Agent.Store.GetMaintenanceWindows(context.Background())
+	if err != nil {
+		logger.WithError(err).WithField("job", j.Name).Error("job: Error querying maintenance windows")
+		return false
+	}
+
+	now := time.Now()
+	var window *MaintenanceWindow
+	var end time.Time
+	for _, w := range windows {
+		if !w.matches(j) {
+			continue
+		}
+		e, ok := w.activeAt(now)
+		if !ok {
+			continue
+		}
+		if window == nil || w.Policy != WindowDefer && window.Policy == WindowDefer {
+			window = w
+		}
+		if e.After(end) {
+			end = e
+		}
+	}
+	if window == nil {
+		return false
+	}
+
+	// Queued executions wait in the queue for the window to end
+	if ex == nil {
+		return true
+	}
+
+	s := &Suppression{
+		JobName:      j.Name,
+		Window:       window.Name,
+		Policy:       WindowSkip,
+		Trigger:      ex.Trigger,
+		ScheduledAt:  ex.ScheduledAt,
+		SuppressedAt: now,
+	}
+	// Messages are delivered again by their trigger instead of being deferred
+	if window.Policy == WindowDefer && ex.Trigger != TriggerMessage {
+		s.Policy = WindowDefer
+		s.RunAt = end
+	}
+
+	log := logger.WithFields(logrus.Fields{
+		"job":    j.Name,
+		"window": window.Name,
+		"policy": s.Policy,
+	})
+	log.Info("job: Run suppressed by maintenance window")
+	metrics.IncrCounterWithLabels([]string{"job", "suppressed"}, 1, jobLabels("job_name", j.Name,
+		metrics.Label{Name: "policy", Value: s.Policy},
+	))
+
+	if err := j.Agent.applySetSuppression(s.ToProto()); err != nil {
+		log.WithError(err).Error("job: Error recording suppressed run")
+	}
+
+	if s.Policy == WindowDefer {
+		run := &DeferredRun{Execution: ex, RunAt: end}
+		if err := j.Agent.applySetDeferredRun(run); err != nil {
+			log.WithError(err).Error("job: Error deferring suppressed run")
+			return true
+		}
+		j.Agent.scheduleDeferredRun(run)
+	}
+
+	return true
+}
@@ -0,0 +1,86 @@
+package dkron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	oneOff := &MaintenanceWindow{Name: "upgrade"}
+	assert.ErrorIs(t, oneOff.Validate(), ErrWindowTimes)
+	oneOff.StartsAt.Set(start)
+	oneOff.EndsAt.Set(start.Add(-time.Hour))
+	assert.Error(t, oneOff.Validate())
+	oneOff.EndsAt.Set(start.Add(time.Hour))
+	assert.NoError(t, oneOff.Validate())
+	oneOff.Policy = "postpone"
+	assert.ErrorIs(t, oneOff.Validate(), ErrWrongWindowPolicy)
+
+	recurring := &MaintenanceWindow{Name: "nightly", Schedule: "0 0 2 * * *"}
+	assert.ErrorIs(t, recurring.Validate(), ErrWindowTimes)
+	recurring.Duration = "-1h"
+	assert.Error(t, recurring.Validate())
+	recurring.Duration = "1h"
+	recurring.Policy = WindowDefer
+	assert.NoError(t, recurring.Validate())
+	recurring.Schedule = "0 0 25 * * *"
+	assert.Error(t, recurring.Validate())
+}
+
+func TestMaintenanceWindowActiveAt(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	oneOff := &MaintenanceWindow{Name: "upgrade"}
+	oneOff.StartsAt.Set(start)
+	oneOff.EndsAt.Set(start.Add(time.Hour))
+
+	_, ok := oneOff.activeAt(start.Add(-time.Second))
+	assert.False(t, ok)
+	end, ok := oneOff.activeAt(start)
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(time.Hour), end)
+	_, ok = oneOff.activeAt(start.Add(time.Hour))
+	assert.False(t, ok)
+
+	// From 2:00 to 3:00 New York time, until the end of January
+	recurring := &MaintenanceWindow{
+		Name:     "nightly",
+		Schedule: "0 0 2 * * *",
+		Duration: "1h",
+		Timezone: "America/New_York",
+	}
+	recurring.EndsAt.Set(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	end, ok = recurring.activeAt(time.Date(2024, 1, 10, 7, 30, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC), end.UTC())
+	_, ok = recurring.activeAt(time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+	_, ok = recurring.activeAt(time.Date(2024, 1, 10, 2, 30, 0, 0, time.UTC))
+	assert.False(t, ok)
+	_, ok = recurring.activeAt(time.Date(2024, 2, 10, 7, 30, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestMaintenanceWindowMatches(t *testing.T) {
+	job := &Job{Name: "test_job", Metadata: map[string]string{"team": "billing", "tier": "1"}}
+
+	assert.True(t, (&MaintenanceWindow{}).matches(job))
+	assert.True(t, (&MaintenanceWindow{Selector: map[string]string{"team": "billing"}}).matches(job))
+	assert.False(t, (&MaintenanceWindow{Selector: map[string]string{"team": "billing", "tier": "2"}}).matches(job))
+	assert.False(t, (&MaintenanceWindow{Selector: map[string]string{"region": "eu"}}).matches(job))
+}
+
+func TestDeferredRunID(t *testing.T) {
+	end := time.Now()
+	run := &DeferredRun{Execution: NewExecution("test_job", TriggerCron), RunAt: end}
+	other := &DeferredRun{Execution: NewExecution("test_job", TriggerCron), RunAt: end}
+	assert.Equal(t, run.ID(), other.ID())
+
+	other.RunAt = end.Add(time.Hour)
+	assert.NotEqual(t, run.ID(), other.ID())
+}
@@ -0,0 +1,185 @@
+package dkron
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/armon/go-metrics"
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+)
+
+// DefaultNamespace is the namespace of the jobs that don't set one.
+const DefaultNamespace = "default"
+
+// namespaceSeparator separates the namespace from the job name in the name
+// of the jobs of the other namespaces.
+const namespaceSeparator = "/"
+
+var (
+	// ErrNamespaceNotFound is returned when the namespace of a job is not found.
+	ErrNamespaceNotFound = errors.New("specified namespace not found")
+	// ErrNamespaceInUse is returned when deleting a namespace that has some jobs.
+	ErrNamespaceInUse = errors.New("the namespace has some jobs")
+	// ErrNamespaceMismatch is returned when the job name is qualified with a
+	// namespace other than the job namespace.
+	ErrNamespaceMismatch = errors.New("the job name doesn't match the job namespace")
+	// ErrCrossNamespaceParent is returned when a job depends on a job of
+	// another namespace.
+	ErrCrossNamespaceParent = errors.New("the parent jobs must be in the namespace of the job")
+	// ErrNamespaceMaxJobs is returned when creating a job in a namespace that
+	// has reached its max jobs.
+	ErrNamespaceMaxJobs = errors.New("the namespace has reached its max jobs")
+)
+
+// Namespace isolates a group of jobs. Job names are unique per namespace,
+// and the namespace sets the defaults and quotas of its jobs.
+type Namespace struct {
+	// Namespace name. Must be unique, acts as the id.
+	Name string `json:"name"`
+
+	// Description of the namespace.
+	Description string `json:"description"`
+
+	// Defaults of the jobs of the namespace, for the fields they don't set.
+	Defaults *JobTemplate `json:"defaults,omitempty"`
+
+	// Maximum number of jobs in the namespace, 0 is unlimited.
+	MaxJobs int `json:"max_jobs"`
+
+	// Maximum number of executions of all the jobs of the namespace running
+	// at the same time, 0 is unlimited.
+	MaxConcurrentExecutions int `json:"max_concurrent_executions"`
+}
+
+// NewNamespaceFromProto maps a proto.Namespace to a Namespace object
+func NewNamespaceFromProto(in *proto.Namespace) *Namespace {
+	ns := &Namespace{
+		Name:                    in.Name,
+		Description:             in.Description,
+		MaxJobs:                 int(in.MaxJobs),
+		MaxConcurrentExecutions: int(in.MaxConcurrentExecutions),
+	}
+	if in.Defaults != nil {
+		ns.Defaults = NewJobTemplateFromProto(in.Defaults)
+	}
+	return ns
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current namespace.
+func (ns *Namespace) ToProto() *proto.Namespace {
+	pn := &proto.Namespace{
+		Name:                    ns.Name,
+		Description:             ns.Description,
+		MaxJobs:                 int32(ns.MaxJobs),
+		MaxConcurrentExecutions: int32(ns.MaxConcurrentExecutions),
+	}
+	if ns.Defaults != nil {
+		pn.Defaults = ns.Defaults.ToProto()
+	}
+	return pn
+}
+
+// Validate validates the namespace.
+func (ns *Namespace) Validate() error {
+	if ns.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if valid, chr := isSlug(ns.Name); !valid {
+		return fmt.Errorf("name contains illegal character '%s'", chr)
+	}
+
+	if ns.MaxJobs < 0 {
+		return fmt.Errorf("invalid max jobs value, it can't be negative")
+	}
+
+	if ns.MaxConcurrentExecutions < 0 {
+		return fmt.Errorf("invalid max concurrent executions value, it can't be negative")
+	}
+
+	if ns.Defaults != nil {
+		// The defaults are not a stored template, they only need a valid timezone.
+		defaults := *ns.Defaults
+		defaults.Name = ns.Name
+		if err := defaults.Validate(); err != nil {
+			return fmt.Errorf("defaults: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// splitJobName returns the namespace and the name in the namespace of the
+// given job name.
+func splitJobName(name string) (string, string) {
+	if namespace, local, ok := strings.Cut(name, namespaceSeparator); ok {
+		return namespace, local
+	}
+	return DefaultNamespace, name
+}
+
+// qualifiedJobName returns the job name of the given name in the namespace,
+// the names of the default namespace are not qualified so they don't change.
+func qualifiedJobName(namespace, name string) string {
+	if namespace == "" || namespace == DefaultNamespace {
+		return name
+	}
+	return namespace + namespaceSeparator + name
+}
+
+// qualify sets the namespace of the job and qualifies its name with it. The
+// parents are qualified with the namespace of the job too, so they can be
+// given by their name in the namespace.
+func (j *Job) qualify() error {
+	namespace, name := splitJobName(j.Name)
+	if j.Namespace == "" {
+		j.Namespace = namespace
+	} else if strings.Contains(j.Name, namespaceSeparator) && namespace != j.Namespace {
+		return ErrNamespaceMismatch
+	}
+	j.Name = qualifiedJobName(j.Namespace, name)
+
+	qualifyParent := func(p string) (string, error) {
+		if p == "" || !strings.Contains(p, namespaceSeparator) {
+			return qualifiedJobName(j.Namespace, p), nil
+		}
+		if namespace, _ := splitJobName(p); namespace != j.Namespace {
+			return "", ErrCrossNamespaceParent
+		}
+		return p, nil
+	}
+
+	var err error
+	if j.ParentJob != "" {
+		if j.ParentJob, err = qualifyParent(j.ParentJob); err != nil {
+			return err
+		}
+	}
+	for i, p := range j.ParentJobs {
+		if j.ParentJobs[i], err = qualifyParent(p); err != nil {
+			return err
+		}
+	}
+	if len(j.DependencyTriggers) > 0 {
+		triggers := make(map[string]string, len(j.DependencyTriggers))
+		for p, trigger := range j.DependencyTriggers {
+			qp, err := qualifyParent(p)
+			if err != nil {
+				return err
+			}
+			triggers[qp] = trigger
+		}
+		j.DependencyTriggers = triggers
+	}
+
+	return nil
+}
+
+// jobLabels returns the metric labels of a job, its name under the given
+// label name and its namespace.
+func jobLabels(label, name string, labels ...metrics.Label) []metrics.Label {
+	namespace, _ := splitJobName(name)
+	return append([]metrics.Label{{Name: label, Value: name}, {Name: "namespace", Value: namespace}}, labels...)
+}
@@ -0,0 +1,69 @@
+package dkron
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobQualify(t *testing.T) {
+	// Jobs of the default namespace keep their name
+	job := &Job{Name: "report", ParentJob: "extract"}
+	require.NoError(t, job.qualify())
+	assert.Equal(t, DefaultNamespace, job.Namespace)
+	assert.Equal(t, "report", job.Name)
+	assert.Equal(t, "extract", job.ParentJob)
+
+	// The name and parents of the other namespaces are qualified
+	job = &Job{
+		Name:               "report",
+		Namespace:          "team-a",
+		ParentJobs:         []string{"extract", "team-a/load"},
+		DependencyTriggers: map[string]string{"extract": TriggerAlways},
+	}
+	require.NoError(t, job.qualify())
+	assert.Equal(t, "team-a/report", job.Name)
+	assert.Equal(t, []string{"team-a/extract", "team-a/load"}, job.ParentJobs)
+	assert.Equal(t, map[string]string{"team-a/extract": TriggerAlways}, job.DependencyTriggers)
+
+	// Qualifying again doesn't change the job
+	require.NoError(t, job.qualify())
+	assert.Equal(t, "team-a/report", job.Name)
+
+	// The namespace is taken from a qualified name
+	job = &Job{Name: "team-b/report"}
+	require.NoError(t, job.qualify())
+	assert.Equal(t, "team-b", job.Namespace)
+
+	job = &Job{Name: "team-b/report", Namespace: "team-a"}
+	assert.ErrorIs(t, job.qualify(), ErrNamespaceMismatch)
+
+	job = &Job{Name: "report", Namespace: "team-a", ParentJob: "team-b/extract"}
+	assert.ErrorIs(t, job.qualify(), ErrCrossNamespaceParent)
+}
+
+func TestNamespaceDefaults(t *testing.T) {
+	ns := &Namespace{
+		Name:     "team-a",
+		Defaults: &JobTemplate{Owner: "team-a", Retries: 1, Tags: map[string]string{"team": "a:1"}},
+	}
+	tmpl := &JobTemplate{Name: "batch", Retries: 3, Tags: map[string]string{"role": "batch:1"}}
+
+	// The template wins over the namespace defaults
+	defaults := tmpl.with(ns.Defaults)
+	job := &Job{Owner: "alice"}
+	defaults.apply(job)
+	assert.Equal(t, "alice", job.Owner)
+	assert.Equal(t, uint(3), job.Retries)
+	assert.Equal(t, map[string]string{"team": "a:1", "role": "batch:1"}, job.Tags)
+
+	// Jobs without template get the namespace defaults
+	var none *JobTemplate
+	job = &Job{}
+	none.with(ns.Defaults).apply(job)
+	assert.Equal(t, "team-a", job.Owner)
+	assert.Equal(t, uint(1), job.Retries)
+	assert.Nil(t, none.with(nil))
+	assert.Equal(t, tmpl, tmpl.with(nil))
+}
@@ -9,6 +9,7 @@ func WithPlugins(plugins Plugins) AgentOption {
 	return func(agent *Agent) {
 		agent.ProcessorPlugins = plugins.Processors
 		agent.ExecutorPlugins = plugins.Executors
+		agent.TriggerPlugins = plugins.Triggers
 	}
 }
 
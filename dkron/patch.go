@@ -0,0 +1,60 @@
+package dkron
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+const (
+	// PatchTypeMerge is a JSON merge patch (RFC 7386).
+	PatchTypeMerge = "merge"
+	// PatchTypeJSON is a JSON patch (RFC 6902).
+	PatchTypeJSON = "json"
+)
+
+// ErrInvalidPatch is returned when a job patch can't be applied.
+var ErrInvalidPatch = errors.New("invalid job patch")
+
+// patchJob returns a copy of the job with the patch applied to its JSON
+// representation. The patched job is validated and keeps the job name.
+func patchJob(job *Job, patchType string, patch []byte) (*Job, error) {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	switch patchType {
+	case PatchTypeMerge:
+		if b, err = jsonpatch.MergePatch(b, patch); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err)
+		}
+	case PatchTypeJSON:
+		p, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err)
+		}
+		if b, err = p.Apply(b); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown patch type %q", ErrInvalidPatch, patchType)
+	}
+
+	var patched Job
+	if err := json.Unmarshal(b, &patched); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err)
+	}
+	if err := patched.qualify(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err)
+	}
+	if patched.Name != job.Name {
+		return nil, fmt.Errorf("%w: the job name can't be changed", ErrInvalidPatch)
+	}
+	if err := patched.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err)
+	}
+	return &patched, nil
+}
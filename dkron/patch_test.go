@@ -0,0 +1,43 @@
+package dkron
+
+import (
+	"testing"
+
+	"github.com/distribworks/dkron/v4/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchJob(t *testing.T) {
+	job := &Job{
+		Name:           "test_job",
+		Schedule:       "@every 1m",
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"command": "echo hello", "shell": "true"},
+		Concurrency:    ConcurrencyAllow,
+		SuccessCount:   5,
+	}
+
+	patched, err := patchJob(job, PatchTypeMerge, []byte(`{"schedule":"@every 5m","executor_config":{"command":"echo bye"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "@every 5m", patched.Schedule)
+	assert.Equal(t, plugin.ExecutorPluginConfig{"command": "echo bye", "shell": "true"}, patched.ExecutorConfig)
+	assert.Equal(t, 5, patched.SuccessCount)
+	assert.Equal(t, "@every 1m", job.Schedule)
+
+	patched, err = patchJob(job, PatchTypeJSON, []byte(`[{"op":"remove","path":"/executor_config/shell"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, plugin.ExecutorPluginConfig{"command": "echo hello"}, patched.ExecutorConfig)
+
+	// The name can't be changed and the patched job must be valid
+	_, err = patchJob(job, PatchTypeMerge, []byte(`{"name":"other_job"}`))
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+	_, err = patchJob(job, PatchTypeMerge, []byte(`{"concurrency":"sometimes"}`))
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+	_, err = patchJob(job, PatchTypeJSON, []byte(`{"schedule":"@every 5m"}`))
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+	_, err = patchJob(job, PatchTypeJSON, []byte(`[{"op":"test","path":"/schedule","value":"@daily"}]`))
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+	_, err = patchJob(job, "strategic", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+}
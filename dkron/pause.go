@@ -0,0 +1,181 @@
+package dkron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/ntime"
+	"github.com/hashicorp/go-uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrSubmissionsPaused is returned when submitting a job while the
+	// submission of new jobs is paused.
+	ErrSubmissionsPaused = errors.New("new job submissions are currently paused")
+	// ErrWrongPauseID is returned when lifting a pause with an invalid id.
+	ErrWrongPauseID = errors.New("invalid pause id, use only lower case letters, digits, underscore and dash")
+)
+
+// Pause stops the submission of new jobs, or of the jobs matching its
+// selector, until it is lifted or it expires. Pauses are stored through
+// raft, so they apply to the whole cluster and survive leader changes.
+type Pause struct {
+	// Pause id, generated when the pause is created.
+	ID string `json:"id"`
+
+	// Why the submissions are paused.
+	Reason string `json:"reason"`
+
+	// Who paused the submissions.
+	Actor string `json:"actor"`
+
+	// Metadata of the jobs the pause applies to, all the jobs when empty.
+	Selector map[string]string `json:"selector"`
+
+	// Time the pause was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Time the pause lifts automatically, never when not set.
+	ExpiresAt ntime.NullableTime `json:"expires_at"`
+}
+
+// NewPauseFromProto maps a proto.Pause to a Pause object
+func NewPauseFromProto(in *proto.Pause) *Pause {
+	p := &Pause{
+		ID:        in.Id,
+		Reason:    in.Reason,
+		Actor:     in.Actor,
+		Selector:  in.Selector,
+		CreatedAt: in.GetCreatedAt().AsTime(),
+	}
+	if in.ExpiresAt != nil {
+		p.ExpiresAt.Set(in.ExpiresAt.AsTime())
+	}
+	return p
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current pause.
+func (p *Pause) ToProto() *proto.Pause {
+	pp := &proto.Pause{
+		Id:        p.ID,
+		Reason:    p.Reason,
+		Actor:     p.Actor,
+		Selector:  p.Selector,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+	if p.ExpiresAt.HasValue() {
+		pp.ExpiresAt = timestamppb.New(p.ExpiresAt.Get())
+	}
+	return pp
+}
+
+// activeAt reports whether the pause didn't expire at the given time.
+func (p *Pause) activeAt(t time.Time) bool {
+	return !p.ExpiresAt.HasValue() || t.Before(p.ExpiresAt.Get())
+}
+
+// err returns the error of the submissions stopped by the pause.
+func (p *Pause) err() error {
+	if p.Reason == "" {
+		return ErrSubmissionsPaused
+	}
+	return fmt.Errorf("%w: %s", ErrSubmissionsPaused, p.Reason)
+}
+
+// validPauseID reports whether the given id can identify a pause.
+func validPauseID(id string) bool {
+	valid, _ := isSlug(id)
+	return id != "" && valid
+}
+
+// PauseNewJobs pauses new job submissions in the whole cluster
+func (a *Agent) PauseNewJobs() {
+	if err := a.PauseJobs(&Pause{}); err != nil {
+		a.logger.WithError(err).Error("agent: Error pausing new job submissions")
+	}
+}
+
+// UnpauseNewJobs resumes new job submissions, lifting all the pauses
+func (a *Agent) UnpauseNewJobs() {
+	if _, err := a.UnpauseJobs(""); err != nil {
+		a.logger.WithError(err).Error("agent: Error resuming new job submissions")
+	}
+}
+
+// PauseJobs pauses the submission of new jobs in the whole cluster, or of
+// the jobs matching the selector of the pause, through the leader.
+func (a *Agent) PauseJobs(p *Pause) error {
+	if p.ID == "" {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			return err
+		}
+		p.ID = id
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+	}
+
+	if err := a.GRPCClient.SetPause(p); err != nil {
+		return err
+	}
+	a.logger.WithFields(logrus.Fields{
+		"id":       p.ID,
+		"reason":   p.Reason,
+		"actor":    p.Actor,
+		"selector": p.Selector,
+	}).Info("agent: New job submissions paused")
+	return nil
+}
+
+// UnpauseJobs lifts the pause with the given id, or all the pauses when
+// the id is empty, through the leader, returning the lifted pauses.
+func (a *Agent) UnpauseJobs(id string) ([]*Pause, error) {
+	if id != "" && !validPauseID(id) {
+		return nil, ErrWrongPauseID
+	}
+	pauses, err := a.GRPCClient.DeletePause(id)
+	if err != nil {
+		return nil, err
+	}
+	a.logger.WithField("id", id).Info("agent: New job submissions resumed")
+	return pauses, nil
+}
+
+// IsNewJobsPaused returns whether new job submissions are paused in the
+// whole cluster.
+func (a *Agent) IsNewJobsPaused() bool {
+	pauses, err := a.Store.GetPauses(context.Background())
+	if err != nil {
+		a.logger.WithError(err).Error("agent: Error querying pauses")
+		return false
+	}
+	for _, p := range pauses {
+		if len(p.Selector) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// jobPause returns the active pause stopping the submission of the given
+// job, resolved with the fields inherited from its template, or nil if there
+// is none.
+func (a *Agent) jobPause(ctx context.Context, job *Job) (*Pause, error) {
+	pauses, err := a.Store.GetPauses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pauses {
+		if matchesMetadata(p.Selector, job.Metadata) {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
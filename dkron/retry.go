@@ -0,0 +1,130 @@
+package dkron
+
+import (
+	"context"
+	"time"
+
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PendingRetry is a retry of a failed execution waiting for its backoff delay.
+// Pending retries are stored through raft, so a new leader runs the retries
+// scheduled by the previous one.
+type PendingRetry struct {
+	// Execution to retry, it holds the attempt number of the retry.
+	Execution *Execution `json:"execution"`
+
+	// Time when the retry runs.
+	RunAt time.Time `json:"run_at"`
+}
+
+// NewPendingRetryFromProto maps a proto.PendingRetry to a PendingRetry object
+func NewPendingRetryFromProto(in *proto.PendingRetry) *PendingRetry {
+	return &PendingRetry{
+		Execution: NewExecutionFromProto(in.GetExecution()),
+		RunAt:     in.GetRunAt().AsTime(),
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the representation of
+// the current pending retry.
+func (r *PendingRetry) ToProto() *proto.PendingRetry {
+	return &proto.PendingRetry{
+		Execution: r.Execution.ToProto(),
+		RunAt:     timestamppb.New(r.RunAt),
+	}
+}
+
+// ID returns the identifier of the pending retry in its job, the key of the
+// failed execution.
+func (r *PendingRetry) ID() string {
+	return r.Execution.Key()
+}
+
+// timerKey returns the key of the pending retry timer.
+func (r *PendingRetry) timerKey() string {
+	return retriesPrefix + ":" + r.Execution.JobName + ":" + r.ID()
+}
+
+// scheduleRetry sets a timer that runs the given pending retry when it is due,
+// replacing the timer of the same retry.
+func (a *Agent) scheduleRetry(retry *PendingRetry) {
+	a.setRetryTimer(retry.timerKey(), retry.RunAt, func() {
+		a.runRetry(retry)
+	})
+}
+
+// setRetryTimer sets a timer calling f at the given time, replacing the timer
+// with the same key.
+func (a *Agent) setRetryTimer(key string, at time.Time, f func()) {
+	a.retryTimersLock.Lock()
+	defer a.retryTimersLock.Unlock()
+
+	if a.retryTimers == nil {
+		a.retryTimers = make(map[string]*time.Timer)
+	}
+	if t, ok := a.retryTimers[key]; ok {
+		t.Stop()
+	}
+	a.retryTimers[key] = time.AfterFunc(time.Until(at), f)
+}
+
+// deleteRetryTimer forgets the timer with the given key once it fired.
+func (a *Agent) deleteRetryTimer(key string) {
+	a.retryTimersLock.Lock()
+	defer a.retryTimersLock.Unlock()
+	delete(a.retryTimers, key)
+}
+
+// runRetry claims a due pending retry through raft and runs it.
+func (a *Agent) runRetry(retry *PendingRetry) {
+	a.deleteRetryTimer(retry.timerKey())
+
+	log := a.logger.WithFields(logrus.Fields{
+		"job":     retry.Execution.JobName,
+		"attempt": retry.Execution.Attempt,
+	})
+
+	// Deleting the pending retry claims it, it is not run when it was
+	// already deleted, along with its job for example.
+	found, err := a.applyDeletePendingRetry(retry.Execution.JobName, retry.ID())
+	if err != nil {
+		log.WithError(err).Error("agent: Error claiming pending retry")
+		return
+	}
+	if !found {
+		return
+	}
+
+	log.Debug("agent: Retrying execution")
+	if _, err := a.Run(context.Background(), retry.Execution.JobName, retry.Execution); err != nil {
+		log.WithError(err).Error("agent: Error retrying execution")
+	}
+}
+
+// resumeRetries schedules the pending retries found in the store, the ones
+// already due run right away.
+func (a *Agent) resumeRetries(ctx context.Context) error {
+	retries, err := a.Store.GetPendingRetries(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, retry := range retries {
+		a.scheduleRetry(retry)
+	}
+	return nil
+}
+
+// stopRetries stops the timers of the pending retries and of the deferred
+// runs, they are left in the store for the next leader.
+func (a *Agent) stopRetries() {
+	a.retryTimersLock.Lock()
+	defer a.retryTimersLock.Unlock()
+
+	for id, t := range a.retryTimers {
+		t.Stop()
+		delete(a.retryTimers, id)
+	}
+}
@@ -3,9 +3,12 @@ package dkron
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/serf/serf"
+	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -20,8 +23,19 @@ func (a *Agent) Run(ctx context.Context, jobName string, ex *Execution) (*Job, e
 		return nil, fmt.Errorf("agent: Run error retrieving job: %s from store: %w", jobName, err)
 	}
 
+	// Webhook and message runs, and their retries, carry the event parameters
+	resolve := job.resolveParameters
+	if ex.Trigger == TriggerWebhook || ex.Trigger == TriggerMessage {
+		resolve = job.resolveEventParameters
+	}
+	params, err := resolve(ex.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("agent: Run error with job %s parameters: %w", jobName, err)
+	}
+	ex.Parameters = params
+
 	// In case the job is not a child job, compute the next execution time
-	if job.ParentJob == "" {
+	if len(job.parents()) == 0 {
 		if ej, ok := a.sched.GetEntryJob(jobName); ok {
 			job.Next = ej.entry.Next
 			if err := a.applySetJob(job.ToProto()); err != nil {
@@ -33,21 +47,20 @@ func (a *Agent) Run(ctx context.Context, jobName string, ex *Execution) (*Job, e
 	}
 
 	// In the first execution attempt we build and filter the target nodes
-	// but we use the existing node target in case of retry.
+	// but the retries follow the retry placement of the job.
+	constraints, err := parseTagConstraints(job.Constraints)
+	if err != nil {
+		return nil, fmt.Errorf("agent: Run error with job %s constraints: %w", jobName, err)
+	}
+
 	var targetNodes []Node
 	if ex.Attempt <= 1 {
-		targetNodes = a.getTargetNodes(job.Tags, defaultSelector)
+		targetNodes = a.getTargetNodes(job.Tags, constraints, a.nodeSelector(job))
+		ex.GroupSize = uint(len(targetNodes))
 	} else {
-		// In case of retrying, find the node or return with an error
-		for _, m := range a.serf.Members() {
-			if ex.NodeName == m.Name {
-				if m.Status == serf.StatusAlive {
-					targetNodes = []Node{m}
-					break
-				} else {
-					return nil, fmt.Errorf("retry node is gone: %s for job %s", ex.NodeName, ex.JobName)
-				}
-			}
+		targetNodes, err = a.getRetryNodes(a.serf.Members(), job, constraints, ex)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -87,3 +100,175 @@ func (a *Agent) Run(ctx context.Context, jobName string, ex *Execution) (*Job, e
 	wg.Wait()
 	return job, nil
 }
+
+// getRetryNodes returns the node to retry a failed execution on, following the
+// retry placement of the job.
+func (a *Agent) getRetryNodes(members []Node, job *Job, constraints []*tagConstraint, ex *Execution) ([]Node, error) {
+	var failed *Node
+	for i, m := range members {
+		if m.Name == ex.NodeName && m.Status == serf.StatusAlive {
+			failed = &members[i]
+			break
+		}
+	}
+
+	switch job.RetryPlacement {
+	case RetryPlacementSameNode:
+		// In case of retrying on the same node, find the node or return with an error
+		if failed == nil {
+			return nil, fmt.Errorf("retry node is gone: %s for job %s", ex.NodeName, ex.JobName)
+		}
+		return []Node{*failed}, nil
+	case RetryPlacementDifferentNode:
+	default:
+		// Retry on the same node, or select another one when it's gone
+		if failed != nil {
+			return []Node{*failed}, nil
+		}
+	}
+
+	bareTags, _ := cleanTags(job.Tags, a.logger)
+	nodes := a.getQualifyingNodes(slices.Clone(members), bareTags)
+	nodes = filterArray(nodes, func(node Node) bool {
+		return node.Name != ex.NodeName && nodeMatchesConstraints(node, constraints)
+	})
+	return selectNodes(nodes, 1, a.nodeSelector(job)), nil
+}
+
+// cancelExecution asks the node running the given execution to cancel it.
+func (a *Agent) cancelExecution(execution *Execution) error {
+	var addr string
+	for _, m := range a.serf.Members() {
+		if m.Name == execution.NodeName && m.Status == serf.StatusAlive {
+			var ok bool
+			if addr, ok = m.Tags["rpc_addr"]; !ok {
+				addr = m.Addr.String()
+			}
+			break
+		}
+	}
+	if addr == "" {
+		return ErrExecutionNotRunning
+	}
+
+	found, err := a.GRPCClient.AgentCancel(addr, execution.Key())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrExecutionNotRunning
+	}
+
+	return nil
+}
+
+// runQueued runs the oldest queued executions of a job using the queue
+// concurrency policy, as many as its concurrency limit allows.
+func (a *Agent) runQueued(ctx context.Context, job *Job) {
+	job.Agent = a
+	if !job.isRunnable(a.logger, nil) {
+		return
+	}
+
+	queued, err := a.Store.GetQueuedExecutions(ctx, job.Name)
+	if err != nil {
+		a.logger.WithError(err).WithField("job", job.Name).Error("agent: Error querying for queued executions")
+		return
+	}
+	if len(queued) == 0 {
+		return
+	}
+
+	// Only stored executions are counted, the in-memory active executions
+	// may still hold the execution that just finished.
+	running, err := job.storedRunningGroups(ctx)
+	if err != nil {
+		a.logger.WithError(err).WithField("job", job.Name).Error("agent: Error querying for running executions in storage")
+		return
+	}
+
+	for i := 0; i < min(job.maxConcurrency()-len(running), len(queued)); i++ {
+		ex, err := a.applyDequeueExecution(job.Name)
+		if err != nil {
+			a.logger.WithError(err).WithField("job", job.Name).Error("agent: Error dequeueing execution")
+			return
+		}
+		if ex == nil {
+			return
+		}
+
+		a.logger.WithFields(logrus.Fields{
+			"job":   job.Name,
+			"group": ex.Group,
+		}).Info("agent: Running queued execution")
+		go func(ex *Execution) {
+			if _, err := a.Run(context.Background(), job.Name, ex); err != nil {
+				a.logger.WithError(err).WithField("job", job.Name).Error("agent: Error running queued execution")
+			}
+		}(ex)
+	}
+}
+
+// getMisfires returns the fire times of the given jobs missed while there was
+// no leader that have to be run following their misfire policy, by job name.
+// It must be called before starting the scheduler, which records new fire times.
+func (a *Agent) getMisfires(ctx context.Context, jobs []*Job) map[string][]time.Time {
+	now := time.Now()
+	missed := make(map[string][]time.Time)
+	for _, job := range jobs {
+		if job.Disabled || len(job.parents()) > 0 {
+			continue
+		}
+
+		last, err := a.Store.GetLastFire(ctx, job.Name)
+		if err != nil {
+			a.logger.WithError(err).WithField("job", job.Name).Error("agent: Error getting last fire time")
+			continue
+		}
+		cal, err := jobCalendar(ctx, a.Store, job)
+		if err != nil {
+			a.logger.WithError(err).WithField("job", job.Name).Error("agent: Error getting job calendar")
+			continue
+		}
+		misfires, err := job.misfires(cal, last, now)
+		if err != nil {
+			a.logger.WithError(err).WithField("job", job.Name).Error("agent: Error computing missed executions")
+			continue
+		}
+		if len(misfires) > 0 {
+			missed[job.Name] = misfires
+		}
+	}
+	return missed
+}
+
+// runMisfires runs the missed executions of the given jobs, the executions of
+// a job run in order, one after the other, until the leadership is lost.
+func (a *Agent) runMisfires(stopCh chan struct{}, jobs []*Job, missed map[string][]time.Time) {
+	for _, job := range jobs {
+		misfires, ok := missed[job.Name]
+		if !ok {
+			continue
+		}
+
+		a.logger.WithFields(logrus.Fields{
+			"job":            job.Name,
+			"misfire_policy": job.MisfirePolicy,
+			"missed":         len(misfires),
+		}).Info("agent: Running executions missed while there was no leader")
+
+		go func(job *Job, misfires []time.Time) {
+			for _, t := range misfires {
+				select {
+				case <-stopCh:
+					return
+				default:
+				}
+				if !a.IsLeader() {
+					return
+				}
+				job.runSlot(t, TriggerMisfire)
+			}
+		}(job, misfires)
+	}
+}
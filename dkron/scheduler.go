@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"expvar"
-	"strings"
+	"fmt"
 	"sync"
 
 	"github.com/armon/go-metrics"
@@ -61,6 +61,14 @@ func (s *Scheduler) Start(jobs []*Job, agent *Agent) error {
 	for _, job := range jobs {
 		job.Agent = agent
 		if err := s.AddJob(job); err != nil {
+			// A missing calendar only leaves its jobs unscheduled
+			if errors.Is(err, ErrCalendarNotFound) {
+				s.logger.WithError(err).WithFields(logrus.Fields{
+					"job":      job.Name,
+					"calendar": job.Calendar,
+				}).Error("scheduler: Skipping job without its calendar")
+				continue
+			}
 			return err
 		}
 	}
@@ -148,7 +156,7 @@ func (s *Scheduler) AddJob(job *Job) error {
 		s.RemoveJob(job.Name)
 	}
 
-	if job.Disabled || job.ParentJob != "" {
+	if job.Disabled || len(job.parents()) > 0 {
 		return nil
 	}
 
@@ -156,24 +164,26 @@ func (s *Scheduler) AddJob(job *Job) error {
 		"job": job.Name,
 	}).Debug("scheduler: Adding job to cron")
 
-	// If Timezone is set on the job, and not explicitly in its schedule,
-	// AND its not a descriptor (that don't support timezones), add the
-	// timezone to the schedule so robfig/cron knows about it.
-	schedule := job.scheduleHash()
-	if job.Timezone != "" &&
-		!strings.HasPrefix(schedule, "@") &&
-		!strings.HasPrefix(schedule, "TZ=") &&
-		!strings.HasPrefix(schedule, "CRON_TZ=") {
-		schedule = "CRON_TZ=" + job.Timezone + " " + schedule
+	var cal *Calendar
+	if job.Calendar != "" {
+		if job.Agent == nil {
+			return fmt.Errorf("scheduler: job %s has no agent to read its calendar %s from", job.Name, job.Calendar)
+		}
+		c, err := jobCalendar(context.Background(), job.Agent.Store, job)
+		if err != nil {
+			return err
+		}
+		cal = c
 	}
 
-	_, err := s.Cron.AddJob(schedule, job)
+	sched, err := job.parseSchedule(cal)
 	if err != nil {
 		return err
 	}
+	s.Cron.Schedule(sched, job)
 
 	cronInspect.Set(job.Name, job)
-	metrics.IncrCounterWithLabels([]string{"scheduler", "job_add"}, 1, []metrics.Label{{Name: "job", Value: job.Name}})
+	metrics.IncrCounterWithLabels([]string{"scheduler", "job_add"}, 1, jobLabels("job", job.Name))
 
 	return nil
 }
@@ -187,6 +197,6 @@ func (s *Scheduler) RemoveJob(jobName string) {
 	if ej, ok := s.GetEntryJob(jobName); ok {
 		s.Cron.Remove(ej.entry.ID)
 		cronInspect.Delete(jobName)
-		metrics.IncrCounterWithLabels([]string{"scheduler", "job_delete"}, 1, []metrics.Label{{Name: "job", Value: jobName}})
+		metrics.IncrCounterWithLabels([]string{"scheduler", "job_delete"}, 1, jobLabels("job", jobName))
 	}
 }
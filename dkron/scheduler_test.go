@@ -9,6 +9,7 @@ import (
 	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
 )
 
 func TestSchedule(t *testing.T) {
@@ -91,6 +92,41 @@ func TestTimezoneAwareJob(t *testing.T) {
 	sched.Stop()
 }
 
+func TestScheduleMissingCalendar(t *testing.T) {
+	log := getTestLogger()
+	sched := NewScheduler(log)
+
+	s, err := NewStore(log, otel.Tracer("test"))
+	require.NoError(t, err)
+	defer s.Shutdown() // nolint: errcheck
+
+	calJob := &Job{
+		Name:     "cal_job",
+		Schedule: "@every 2s",
+		Executor: "shell",
+		Calendar: "missing",
+	}
+	testJob := &Job{
+		Name:     "cron_job",
+		Schedule: "@every 2s",
+		Executor: "shell",
+	}
+
+	// A job without agent can't read its calendar
+	err = sched.AddJob(calJob)
+	assert.Error(t, err)
+
+	// The job with a missing calendar is skipped, the others scheduled
+	err = sched.Start([]*Job{calJob, testJob}, &Agent{Store: s})
+	require.NoError(t, err)
+	defer sched.Stop()
+
+	_, ok := sched.GetEntryJob(calJob.Name)
+	assert.False(t, ok)
+	_, ok = sched.GetEntryJob(testJob.Name)
+	assert.True(t, ok)
+}
+
 func TestScheduleStop(t *testing.T) {
 	log := getTestLogger()
 	sched := NewScheduler(log)
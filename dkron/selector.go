@@ -0,0 +1,129 @@
+package dkron
+
+import (
+	"hash/fnv"
+	"strings"
+)
+
+// nodeSelector returns the function used to pick the target nodes of a job,
+// following its selector setting.
+func (a *Agent) nodeSelector(job *Job) func([]Node) int {
+	switch {
+	case job.Selector == SelectorRoundRobin:
+		return a.roundRobinSelector(job.Name)
+	case job.Selector == SelectorLeastBusy:
+		return leastBusySelector(a.runningByNode())
+	case job.Selector == SelectorConsistentHash:
+		return consistentHashSelector(job.Name)
+	case strings.HasPrefix(job.Selector, SelectorSpread+":"):
+		return spreadSelector(strings.TrimPrefix(job.Selector, SelectorSpread+":"), a.runningByNode())
+	default:
+		return defaultSelector
+	}
+}
+
+// runningByNode returns the number of running executions in every node.
+func (a *Agent) runningByNode() map[string]int {
+	running := make(map[string]int)
+	exs, err := a.GetActiveExecutions()
+	if err != nil {
+		a.logger.WithError(err).Error("agent: Error querying for active executions")
+		return running
+	}
+	for _, ex := range exs {
+		running[ex.NodeName]++
+	}
+	return running
+}
+
+// roundRobinSelector picks the nodes in name order, starting after the last
+// node picked for the job.
+func (a *Agent) roundRobinSelector(jobName string) func([]Node) int {
+	return func(nodes []Node) int {
+		a.selectorLock.Lock()
+		defer a.selectorLock.Unlock()
+
+		if a.roundRobinLast == nil {
+			a.roundRobinLast = make(map[string]string)
+		}
+		last := a.roundRobinLast[jobName]
+
+		next, first := -1, 0
+		for i, n := range nodes {
+			if n.Name > last && (next == -1 || n.Name < nodes[next].Name) {
+				next = i
+			}
+			if n.Name < nodes[first].Name {
+				first = i
+			}
+		}
+		// Start over once the last node was picked
+		if next == -1 {
+			next = first
+		}
+
+		a.roundRobinLast[jobName] = nodes[next].Name
+		return next
+	}
+}
+
+// leastBusySelector picks the node with the fewest running executions.
+func leastBusySelector(running map[string]int) func([]Node) int {
+	return func(nodes []Node) int {
+		chosen := 0
+		for i, n := range nodes {
+			if running[n.Name] < running[nodes[chosen].Name] {
+				chosen = i
+			}
+		}
+		// Count the pick so the next one goes to another node
+		running[nodes[chosen].Name]++
+		return chosen
+	}
+}
+
+// consistentHashSelector picks the node with the highest hash of the job and
+// node names, a job keeps running in the same node while it is available.
+func consistentHashSelector(jobName string) func([]Node) int {
+	score := func(n Node) uint64 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(jobName + "/" + n.Name))
+		return h.Sum64()
+	}
+	return func(nodes []Node) int {
+		chosen := 0
+		for i, n := range nodes {
+			if score(n) > score(nodes[chosen]) {
+				chosen = i
+			}
+		}
+		return chosen
+	}
+}
+
+// spreadSelector picks the least busy node among the nodes having the tag value
+// with the fewest running executions, spreading the picks across tag values.
+func spreadSelector(tag string, running map[string]int) func([]Node) int {
+	var byValue map[string]int
+	return func(nodes []Node) int {
+		// The first call gets all the candidate nodes, the picked ones are
+		// left out of the next calls.
+		if byValue == nil {
+			byValue = make(map[string]int)
+			for _, n := range nodes {
+				byValue[n.Tags[tag]] += running[n.Name]
+			}
+		}
+
+		chosen := 0
+		for i, n := range nodes {
+			cv, nv := byValue[nodes[chosen].Tags[tag]], byValue[n.Tags[tag]]
+			if nv < cv || (nv == cv && running[n.Name] < running[nodes[chosen].Name]) {
+				chosen = i
+			}
+		}
+		byValue[nodes[chosen].Tags[tag]]++
+		running[nodes[chosen].Name]++
+		return chosen
+	}
+}
@@ -0,0 +1,76 @@
+package dkron
+
+import (
+	"testing"
+
+	"github.com/hashicorp/serf/serf"
+	"github.com/stretchr/testify/assert"
+)
+
+func selectorTestNodes() []Node {
+	return []Node{
+		{Name: "node1", Status: serf.StatusAlive, Tags: map[string]string{"zone": "a"}},
+		{Name: "node2", Status: serf.StatusAlive, Tags: map[string]string{"zone": "a"}},
+		{Name: "node3", Status: serf.StatusAlive, Tags: map[string]string{"zone": "b"}},
+		{Name: "node4", Status: serf.StatusAlive, Tags: map[string]string{"zone": "c"}},
+	}
+}
+
+func nodeNames(nodes []Node) []string {
+	names := make([]string, len(nodes))
+	for i, n := range nodes {
+		names[i] = n.Name
+	}
+	return names
+}
+
+func Test_roundRobinSelector(t *testing.T) {
+	a := NewAgent(DefaultConfig())
+
+	var picked []string
+	for i := 0; i < 5; i++ {
+		nodes := selectNodes(selectorTestNodes(), 1, a.roundRobinSelector("job"))
+		picked = append(picked, nodeNames(nodes)...)
+	}
+	assert.Equal(t, []string{"node1", "node2", "node3", "node4", "node1"}, picked)
+
+	// Every job takes its own turns
+	nodes := selectNodes(selectorTestNodes(), 1, a.roundRobinSelector("other"))
+	assert.Equal(t, []string{"node1"}, nodeNames(nodes))
+}
+
+func Test_leastBusySelector(t *testing.T) {
+	running := map[string]int{"node1": 3, "node2": 1, "node3": 2, "node4": 5}
+
+	nodes := selectNodes(selectorTestNodes(), 2, leastBusySelector(running))
+	assert.ElementsMatch(t, []string{"node2", "node3"}, nodeNames(nodes))
+}
+
+func Test_consistentHashSelector(t *testing.T) {
+	first := nodeNames(selectNodes(selectorTestNodes(), 1, consistentHashSelector("job")))
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, first, nodeNames(selectNodes(selectorTestNodes(), 1, consistentHashSelector("job"))))
+	}
+
+	// Removing another node keeps the job in the same node
+	var remaining []Node
+	for _, n := range selectorTestNodes() {
+		if n.Name == first[0] || len(remaining) < 1 {
+			remaining = append(remaining, n)
+		}
+	}
+	assert.Equal(t, first, nodeNames(selectNodes(remaining, 1, consistentHashSelector("job"))))
+}
+
+func Test_spreadSelector(t *testing.T) {
+	nodes := selectNodes(selectorTestNodes(), 3, spreadSelector("zone", map[string]int{}))
+	zones := make([]string, len(nodes))
+	for i, n := range nodes {
+		zones[i] = n.Tags["zone"]
+	}
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, zones)
+
+	// The busiest zone is left out
+	nodes = selectNodes(selectorTestNodes(), 2, spreadSelector("zone", map[string]int{"node3": 2}))
+	assert.ElementsMatch(t, []string{"node1", "node4"}, nodeNames(nodes))
+}
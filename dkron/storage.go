@@ -3,6 +3,7 @@ package dkron
 import (
 	"context"
 	"io"
+	"time"
 )
 
 // Storage is the interface that should be used by any
@@ -17,11 +18,51 @@ type Storage interface {
 	SetExecutionDone(ctx context.Context, execution *Execution) (bool, error)
 	GetJobs(ctx context.Context, options *JobOptions) ([]*Job, error)
 	GetJob(ctx context.Context, name string, options *JobOptions) (*Job, error)
+	GetJobVersions(ctx context.Context, jobName string) ([]*JobVersion, error)
+	GetJobVersion(ctx context.Context, jobName string, version int64) (*JobVersion, error)
 	GetExecution(ctx context.Context, jobName string, executionName string) (*Execution, error)
 	GetExecutions(ctx context.Context, jobName string, opts *ExecutionOptions) ([]*Execution, error)
 	GetRunningExecutions(ctx context.Context, jobName string) ([]*Execution, error)
+	GetNamespaceRunningExecutions(ctx context.Context, namespace string) ([]*Execution, error)
 	GetExecutionGroup(ctx context.Context, execution *Execution, opts *ExecutionOptions) ([]*Execution, error)
 	GetGroupedExecutions(ctx context.Context, jobName string, opts *ExecutionOptions) (map[int64][]*Execution, []int64, error)
+	ParentJobDone(ctx context.Context, jobName string, parentName string, run int64) (bool, error)
+	QueueExecution(ctx context.Context, execution *Execution, maxDepth int, index uint64) (bool, error)
+	DequeueExecution(ctx context.Context, jobName string) (*Execution, error)
+	GetQueuedExecutions(ctx context.Context, jobName string) ([]*Execution, error)
+	ClaimSlot(ctx context.Context, jobName string, scheduledAt time.Time) (bool, error)
+	GetLastFire(ctx context.Context, jobName string) (time.Time, error)
+	SetPendingRetry(ctx context.Context, retry *PendingRetry) error
+	DeletePendingRetry(ctx context.Context, jobName string, id string) (bool, error)
+	GetPendingRetries(ctx context.Context, jobName string) ([]*PendingRetry, error)
+	SetDeferredRun(ctx context.Context, run *DeferredRun) error
+	DeleteDeferredRun(ctx context.Context, jobName string, id string) (bool, error)
+	GetDeferredRuns(ctx context.Context, jobName string) ([]*DeferredRun, error)
+	SetCalendar(ctx context.Context, calendar *Calendar) error
+	GetCalendar(ctx context.Context, name string) (*Calendar, error)
+	GetCalendars(ctx context.Context) ([]*Calendar, error)
+	DeleteCalendar(ctx context.Context, name string) (*Calendar, error)
+	SetMaintenanceWindow(ctx context.Context, window *MaintenanceWindow) error
+	GetMaintenanceWindow(ctx context.Context, name string) (*MaintenanceWindow, error)
+	GetMaintenanceWindows(ctx context.Context) ([]*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, name string) (*MaintenanceWindow, error)
+	SetSuppression(ctx context.Context, suppression *Suppression) error
+	GetSuppressions(ctx context.Context, jobName string) ([]*Suppression, error)
+	SetPause(ctx context.Context, pause *Pause) error
+	GetPauses(ctx context.Context) ([]*Pause, error)
+	DeletePause(ctx context.Context, id string) ([]*Pause, error)
+	SetWebhookTrigger(ctx context.Context, trigger *WebhookTrigger) error
+	GetWebhookTrigger(ctx context.Context, id string) (*WebhookTrigger, error)
+	GetWebhookTriggers(ctx context.Context, jobName string) ([]*WebhookTrigger, error)
+	DeleteWebhookTrigger(ctx context.Context, id string) (*WebhookTrigger, error)
+	SetJobTemplate(ctx context.Context, template *JobTemplate) error
+	GetJobTemplate(ctx context.Context, name string) (*JobTemplate, error)
+	GetJobTemplates(ctx context.Context) ([]*JobTemplate, error)
+	DeleteJobTemplate(ctx context.Context, name string) (*JobTemplate, error)
+	SetNamespace(ctx context.Context, namespace *Namespace) error
+	GetNamespace(ctx context.Context, name string) (*Namespace, error)
+	GetNamespaces(ctx context.Context) ([]*Namespace, error)
+	DeleteNamespace(ctx context.Context, name string) (*Namespace, error)
 	Shutdown() error
 	Snapshot(w io.WriteCloser) error
 	Restore(r io.ReadCloser) error
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -26,8 +27,24 @@ const (
 	// MaxExecutions to maintain in the storage
 	MaxExecutions = 100
 
+	// MaxJobVersions to maintain in the storage for each job
+	MaxJobVersions = 100
+
 	jobsPrefix       = "jobs"
 	executionsPrefix = "executions"
+	workflowsPrefix  = "workflows"
+	queuePrefix      = "queue"
+	retriesPrefix    = "retries"
+	deferredPrefix   = "deferred"
+	firesPrefix      = "fires"
+	calendarsPrefix  = "calendars"
+	windowsPrefix    = "windows"
+	suppressPrefix   = "suppressions"
+	pausesPrefix     = "pauses"
+	hooksPrefix      = "hooks"
+	templatesPrefix  = "templates"
+	versionsPrefix   = "versions"
+	namespacesPrefix = "namespaces"
 )
 
 var (
@@ -54,6 +71,8 @@ type JobOptions struct {
 	Query    string
 	Status   string
 	Disabled string
+	// Namespace of the jobs, empty for all the namespaces.
+	Namespace string
 }
 
 // ExecutionOptions additional options like "Sort" will be ready for JSON marshall
@@ -85,6 +104,7 @@ func NewStore(logger *logrus.Entry, tracer trace.Tracer) (*Store, error) {
 	_ = db.CreateIndex("last_success", jobsPrefix+":*", buntdb.IndexJSON("last_success"))
 	_ = db.CreateIndex("last_error", jobsPrefix+":*", buntdb.IndexJSON("last_error"))
 	_ = db.CreateIndex("next", jobsPrefix+":*", buntdb.IndexJSON("next"))
+	_ = db.CreateIndex("namespace", jobsPrefix+":*", buntdb.IndexJSON("namespace"))
 
 	store := &Store{
 		db:     db,
@@ -114,6 +134,54 @@ func (s *Store) setJobTxFunc(pbj *dkronpb.Job) func(tx *buntdb.Tx) error {
 	}
 }
 
+// jobDefinition returns a copy of the stored job without the state computed
+// while it runs and the version fields, to tell whether the job changed.
+func jobDefinition(pbj *dkronpb.Job) *dkronpb.Job {
+	d := proto.Clone(pbj).(*dkronpb.Job)
+	d.SuccessCount = 0
+	d.ErrorCount = 0
+	d.Status = ""
+	d.LastSuccess = nil
+	d.LastError = nil
+	d.Next = nil
+	d.DependentJobs = nil
+	d.Version = 0
+	d.UpdatedAt = nil
+	d.UpdatedBy = ""
+	d.CreatedAt = nil
+	d.Revision = 0
+	return d
+}
+
+// setJobVersionTxFunc records a version of a job, deleting the oldest
+// versions of the job over the MaxJobVersions limit.
+func (s *Store) setJobVersionTxFunc(pbv *dkronpb.JobVersion) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		vb, err := json.Marshal(pbv)
+		if err != nil {
+			return err
+		}
+		if _, _, err := tx.Set(jobVersionKey(pbv.JobName, pbv.Version), string(vb), nil); err != nil {
+			return err
+		}
+
+		if pbv.Version <= MaxJobVersions {
+			return nil
+		}
+		_, err = tx.Delete(jobVersionKey(pbv.JobName, pbv.Version-MaxJobVersions))
+		if err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+		return nil
+	}
+}
+
+// jobVersionKey returns the key of a job version, the version is zero padded
+// so the versions of a job are sorted by key.
+func jobVersionKey(jobName string, version int64) string {
+	return fmt.Sprintf("%s:%s:%020d", versionsPrefix, jobName, version)
+}
+
 // DB is the getter for the BuntDB instance
 func (s *Store) DB() *buntdb.DB {
 	return s.db
@@ -127,18 +195,67 @@ func (s *Store) SetJob(ctx context.Context, job *Job, copyDependentJobs bool) er
 	var pbej dkronpb.Job
 	var ej *Job
 
+	// Work on a copy, leaving the job of the caller as is
+	j := *job
+	job = &j
+
+	if err := job.qualify(); err != nil {
+		return err
+	}
+
+	// The namespaces other than the default must exist
+	namespace, _ := s.GetNamespace(ctx, job.Namespace)
+	if namespace == nil && job.Namespace != DefaultNamespace {
+		return ErrNamespaceNotFound
+	}
+
+	// Jobs inheriting from a template or from the defaults of their
+	// namespace are checked resolved, and stored with only the fields they
+	// override.
+	defaults, err := inheritedDefaults(ctx, s, job)
+	if err != nil {
+		return err
+	}
+	if defaults != nil {
+		defaults.apply(job)
+	}
+
 	if err := job.Validate(); err != nil {
 		return err
 	}
 
-	// Abort if parent not found before committing job to the store
-	if job.ParentJob != "" {
-		if j, _ := s.GetJob(ctx, job.ParentJob, nil); j == nil {
+	// Abort if any parent is not found before committing job to the store
+	for _, p := range job.parents() {
+		if j, _ := s.GetJob(ctx, p, nil); j == nil {
 			return ErrParentJobNotFound
 		}
 	}
 
-	err := s.db.Update(func(tx *buntdb.Tx) error {
+	var cal *Calendar
+	if job.Calendar != "" {
+		if cal, _ = s.GetCalendar(ctx, job.Calendar); cal == nil {
+			return ErrCalendarNotFound
+		}
+	}
+
+	lookup := func(name string) []string {
+		if name == job.Name {
+			return job.parents()
+		}
+		j, err := s.GetJob(ctx, name, nil)
+		if err != nil {
+			return nil
+		}
+		return j.parents()
+	}
+	if err := checkDependencyCycle(job, lookup); err != nil {
+		return err
+	}
+	if err := s.checkCommonRoots(ctx, job, lookup); err != nil {
+		return err
+	}
+
+	err = s.db.Update(func(tx *buntdb.Tx) error {
 		// Get if the requested job already exist
 		err := s.getJobTxFunc(job.Name, &pbej)(tx)
 		if err != nil && err != buntdb.ErrNotFound {
@@ -147,6 +264,18 @@ func (s *Store) SetJob(ctx context.Context, job *Job, copyDependentJobs bool) er
 
 		ej = NewJobFromProto(&pbej, s.logger)
 
+		// The max jobs are only checked on the new jobs, so lowering them
+		// doesn't prevent updating the existing jobs.
+		if namespace != nil && ej.Name == "" && namespace.MaxJobs > 0 {
+			count, err := countNamespaceJobsTx(tx, namespace.Name)
+			if err != nil {
+				return err
+			}
+			if count >= namespace.MaxJobs {
+				return ErrNamespaceMaxJobs
+			}
+		}
+
 		if ej.Name != "" {
 			// When the job runs, these status vars are updated
 			// otherwise use the ones that are stored
@@ -169,96 +298,1329 @@ func (s *Store) SetJob(ctx context.Context, job *Job, copyDependentJobs bool) er
 				job.Status = ej.Status
 			}
 		}
-
-		if job.Schedule != ej.Schedule {
-			job.Next, err = job.GetNext()
-			if err != nil {
+
+		// The creation time is the time of the first change of the job,
+		// the jobs created before it was recorded take the current one.
+		job.CreatedAt = ej.CreatedAt
+		if job.CreatedAt.IsZero() {
+			job.CreatedAt = job.UpdatedAt
+		}
+
+		if job.Schedule != ej.Schedule || job.Calendar != ej.Calendar || job.CalendarPolicy != ej.CalendarPolicy {
+			job.Next, err = job.GetNext(cal)
+			if err != nil {
+				return err
+			}
+		} else {
+			// If coming from a backup us the previous value, don't allow overwriting this
+			if job.Next.Before(ej.Next) {
+				job.Next = ej.Next
+			}
+		}
+
+		stored := job
+		if defaults != nil {
+			stored = defaults.strip(job)
+		}
+
+		// Changes to the job definition are recorded as a new version,
+		// otherwise the job keeps its current version and revision. The time
+		// of the change comes with the job, so all the nodes store the same one.
+		changed := ej.Name == "" || !proto.Equal(jobDefinition(&pbej), jobDefinition(stored.ToProto()))
+		if changed {
+			job.Version = ej.Version + 1
+		} else {
+			job.Version, job.UpdatedAt, job.UpdatedBy = ej.Version, ej.UpdatedAt, ej.UpdatedBy
+			job.Revision = ej.Revision
+		}
+		stored.Version, stored.UpdatedAt, stored.UpdatedBy = job.Version, job.UpdatedAt, job.UpdatedBy
+		stored.Revision = job.Revision
+
+		pbj := stored.ToProto()
+		if changed {
+			if err := s.setJobVersionTxFunc(&dkronpb.JobVersion{
+				JobName:   pbj.Name,
+				Version:   pbj.Version,
+				CreatedAt: pbj.UpdatedAt,
+				Actor:     pbj.UpdatedBy,
+				Job:       jobDefinition(pbj),
+			})(tx); err != nil {
+				return err
+			}
+		}
+
+		if err := s.setJobTxFunc(pbj)(tx); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// If the parent jobs changed update the parents of the old (if any) and new jobs
+	oldParents, newParents := ej.parents(), job.parents()
+	for _, p := range oldParents {
+		if !slices.Contains(newParents, p) {
+			if err := s.removeFromParent(ctx, p, job.Name); err != nil {
+				return err
+			}
+		}
+	}
+	for _, p := range newParents {
+		if !slices.Contains(oldParents, p) {
+			if err := s.addToParent(ctx, p, job.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkCommonRoots checks that the parents of the job descend from the same
+// root job. When the job changes its parents, the jobs depending on it are
+// checked too, as their parents can descend from other roots now.
+func (s *Store) checkCommonRoots(ctx context.Context, job *Job, lookup func(name string) []string) error {
+	if err := checkCommonRoot(job.Name, lookup); err != nil {
+		return err
+	}
+
+	ej, _ := s.GetJob(ctx, job.Name, nil)
+	if ej == nil || slices.Equal(ej.parents(), job.parents()) {
+		return nil
+	}
+
+	visited := make(map[string]bool)
+	pending := slices.Clone(ej.DependentJobs)
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+		if err := checkCommonRoot(name, lookup); err != nil {
+			return err
+		}
+		if dj, _ := s.GetJob(ctx, name, nil); dj != nil {
+			pending = append(pending, dj.DependentJobs...)
+		}
+	}
+	return nil
+}
+
+// Removes the given child job from the dependent jobs of the given parent.
+func (s *Store) removeFromParent(ctx context.Context, parentName, childName string) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.remove_from_parent")
+	defer span.End()
+
+	parent, err := s.getParentJob(ctx, parentName)
+	if err != nil {
+		return err
+	}
+
+	// Remove all occurrences from the parent, not just one.
+	// Due to an old bug (in v1), a parent can have the same child more than once.
+	djs := []string{}
+	for _, djn := range parent.DependentJobs {
+		if djn != childName {
+			djs = append(djs, djn)
+		}
+	}
+	parent.DependentJobs = djs
+	if err := s.SetJob(ctx, parent, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Adds the given child job to the dependent jobs of the given parent.
+func (s *Store) addToParent(ctx context.Context, parentName, childName string) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.add_to_parent")
+	defer span.End()
+
+	parent, err := s.getParentJob(ctx, parentName)
+	if err != nil {
+		return err
+	}
+
+	parent.DependentJobs = append(parent.DependentJobs, childName)
+	if err := s.SetJob(ctx, parent, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) getParentJob(ctx context.Context, name string) (*Job, error) {
+	parent, err := s.GetJob(ctx, name, nil)
+	if err != nil {
+		if err == buntdb.ErrNotFound {
+			return nil, ErrParentJobNotFound
+		}
+		return nil, err
+	}
+	return parent, nil
+}
+
+// ParentJobDone records that a parent of a job finished successfully in the given
+// workflow run. It returns true when this was the last parent the job was waiting
+// for in that run, meaning that the job is ready to run.
+func (s *Store) ParentJobDone(ctx context.Context, jobName string, parentName string, run int64) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.parent_job_done", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	ready := false
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		var pbj dkronpb.Job
+		if err := s.getJobTxFunc(jobName, &pbj)(tx); err != nil {
+			return err
+		}
+		parents := NewJobFromProto(&pbj, s.logger).parents()
+
+		key := fmt.Sprintf("%s:%s:%d", workflowsPrefix, jobName, run)
+		wr := dkronpb.WorkflowRun{
+			JobName:     jobName,
+			WorkflowRun: run,
+		}
+		item, err := tx.Get(key)
+		if err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+		if item != "" {
+			if err := json.Unmarshal([]byte(item), &wr); err != nil {
+				return err
+			}
+		}
+
+		// Only the parent completing the set makes the job ready, later reports
+		// for the same run are ignored.
+		wasReady := containsAll(wr.DoneParents, parents)
+		if !slices.Contains(wr.DoneParents, parentName) {
+			wr.DoneParents = append(wr.DoneParents, parentName)
+		}
+		ready = !wasReady && containsAll(wr.DoneParents, parents)
+
+		wb, err := json.Marshal(&wr)
+		if err != nil {
+			return err
+		}
+		if _, _, err := tx.Set(key, string(wb), nil); err != nil {
+			return err
+		}
+
+		return s.pruneWorkflowRunsTxFunc(jobName)(tx)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ready, nil
+}
+
+// pruneWorkflowRunsTxFunc deletes the oldest workflow runs of a job
+// over the MaxExecutions limit.
+func (s *Store) pruneWorkflowRunsTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var runs int64arr
+		prefix := fmt.Sprintf("%s:%s:", workflowsPrefix, jobName)
+		if err := tx.AscendKeys(prefix+"*", func(key, value string) bool {
+			if run, err := strconv.ParseInt(strings.TrimPrefix(key, prefix), 10, 64); err == nil {
+				runs = append(runs, run)
+			}
+			return true
+		}); err != nil {
+			return err
+		}
+
+		if len(runs) <= MaxExecutions {
+			return nil
+		}
+		sort.Sort(runs)
+		for _, run := range runs[:len(runs)-MaxExecutions] {
+			if _, err := tx.Delete(fmt.Sprintf("%s%d", prefix, run)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// QueueExecution appends an execution to the queue of its job. It returns false
+// without queueing it when the queue already holds maxDepth executions.
+func (s *Store) QueueExecution(ctx context.Context, execution *Execution, maxDepth int, index uint64) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.queue.execution", trace.WithAttributes(attribute.String("job_name", execution.JobName)))
+	defer span.End()
+
+	queued := false
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		keys, err := s.queueKeysTx(tx, execution.JobName)
+		if err != nil {
+			return err
+		}
+		if len(keys) >= maxDepth {
+			return nil
+		}
+
+		eb, err := json.Marshal(execution.ToProto())
+		if err != nil {
+			return err
+		}
+		// The raft index is unique and zero padded keeps the keys in arrival order
+		key := fmt.Sprintf("%s:%s:%020d", queuePrefix, execution.JobName, index)
+		if _, _, err := tx.Set(key, string(eb), nil); err != nil {
+			return err
+		}
+		queued = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return queued, nil
+}
+
+// DequeueExecution removes the oldest queued execution of a job and returns it,
+// it returns nil when the queue is empty.
+func (s *Store) DequeueExecution(ctx context.Context, jobName string) (*Execution, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.dequeue.execution", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	var execution *Execution
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		keys, err := s.queueKeysTx(tx, jobName)
+		if err != nil || len(keys) == 0 {
+			return err
+		}
+
+		item, err := tx.Delete(keys[0])
+		if err != nil {
+			return err
+		}
+		var pbe dkronpb.Execution
+		if err := json.Unmarshal([]byte(item), &pbe); err != nil {
+			return err
+		}
+		execution = NewExecutionFromProto(&pbe)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return execution, nil
+}
+
+// GetQueuedExecutions returns the queued executions of a job, oldest first.
+func (s *Store) GetQueuedExecutions(ctx context.Context, jobName string) ([]*Execution, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.queued_executions", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	executions := []*Execution{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:%s:*", queuePrefix, jobName), func(key, value string) bool {
+			var pbe dkronpb.Execution
+			if err := json.Unmarshal([]byte(value), &pbe); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			executions = append(executions, NewExecutionFromProto(&pbe))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+// queueKeysTx returns the keys of the queued executions of a job, oldest first.
+func (*Store) queueKeysTx(tx *buntdb.Tx, jobName string) ([]string, error) {
+	var keys []string
+	err := tx.AscendKeys(fmt.Sprintf("%s:%s:*", queuePrefix, jobName), func(key, value string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys, err
+}
+
+// ClaimSlot claims the slot of a job scheduled to run at the given time, it
+// returns false if the slot was already claimed. Only the last claimed slot of
+// the job is kept, also used as the last fire time to find the executions
+// missed while there was no leader. A slot at or before the last claimed one
+// is rejected, and so is a slot before the schedule time following it, so the
+// ticks of @every schedules computed by two leaders don't both run.
+func (s *Store) ClaimSlot(ctx context.Context, jobName string, scheduledAt time.Time) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.claim.slot", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	claimed := false
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		key := fmt.Sprintf("%s:%s", firesPrefix, jobName)
+		item, err := tx.Get(key)
+		if err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+		if item != "" {
+			last, err := time.Parse(time.RFC3339Nano, item)
+			if err != nil {
+				return err
+			}
+			next := last.Add(time.Nanosecond)
+			var pbj dkronpb.Job
+			if err := s.getJobTxFunc(jobName, &pbj)(tx); err == nil {
+				if sched, err := NewJobFromProto(&pbj, s.logger).parseSchedule(nil); err == nil {
+					if t := sched.Next(last); t.After(last) {
+						next = t
+					}
+				}
+			}
+			if scheduledAt.Before(next) {
+				return nil
+			}
+		}
+
+		if _, _, err := tx.Set(key, scheduledAt.UTC().Format(time.RFC3339Nano), nil); err != nil {
+			return err
+		}
+		claimed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return claimed, nil
+}
+
+// GetLastFire returns the last time a job was scheduled to run, or the zero
+// time if it was never recorded.
+func (s *Store) GetLastFire(ctx context.Context, jobName string) (time.Time, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.last_fire", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	var last time.Time
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		item, err := tx.Get(fmt.Sprintf("%s:%s", firesPrefix, jobName))
+		if err != nil {
+			if err == buntdb.ErrNotFound {
+				return nil
+			}
+			return err
+		}
+		last, err = time.Parse(time.RFC3339Nano, item)
+		return err
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return last, nil
+}
+
+// SetCalendar stores a calendar.
+func (s *Store) SetCalendar(ctx context.Context, calendar *Calendar) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.calendar", trace.WithAttributes(attribute.String("calendar", calendar.Name)))
+	defer span.End()
+
+	if err := calendar.Validate(); err != nil {
+		return err
+	}
+
+	cb, err := json.Marshal(calendar.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(fmt.Sprintf("%s:%s", calendarsPrefix, calendar.Name), string(cb), nil)
+		return err
+	})
+}
+
+// GetCalendar returns the calendar with the given name.
+func (s *Store) GetCalendar(ctx context.Context, name string) (*Calendar, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.calendar", trace.WithAttributes(attribute.String("calendar", name)))
+	defer span.End()
+
+	var calendar *Calendar
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		item, err := tx.Get(fmt.Sprintf("%s:%s", calendarsPrefix, name))
+		if err != nil {
+			return err
+		}
+		var pbc dkronpb.Calendar
+		if err := json.Unmarshal([]byte(item), &pbc); err != nil {
+			return err
+		}
+		calendar = NewCalendarFromProto(&pbc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar, nil
+}
+
+// GetCalendars returns all the calendars sorted by name.
+func (s *Store) GetCalendars(ctx context.Context) ([]*Calendar, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.calendars")
+	defer span.End()
+
+	calendars := []*Calendar{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:*", calendarsPrefix), func(key, value string) bool {
+			var pbc dkronpb.Calendar
+			if err := json.Unmarshal([]byte(value), &pbc); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			calendars = append(calendars, NewCalendarFromProto(&pbc))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return calendars, nil
+}
+
+// DeleteCalendar deletes the calendar with the given name, calendars used by
+// some jobs can't be deleted.
+func (s *Store) DeleteCalendar(ctx context.Context, name string) (*Calendar, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.calendar", trace.WithAttributes(attribute.String("calendar", name)))
+	defer span.End()
+
+	calendar, err := s.GetCalendar(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Update(func(tx *buntdb.Tx) error {
+		inUse := false
+		err := tx.AscendKeys(fmt.Sprintf("%s:*", jobsPrefix), func(key, value string) bool {
+			var pbj dkronpb.Job
+			if err := proto.Unmarshal([]byte(value), &pbj); err != nil {
+				if err := json.Unmarshal([]byte(value), &pbj); err != nil {
+					return true
+				}
+			}
+			inUse = pbj.Calendar == name
+			return !inUse
+		})
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return ErrCalendarInUse
+		}
+
+		_, err = tx.Delete(fmt.Sprintf("%s:%s", calendarsPrefix, name))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar, nil
+}
+
+// SetJobTemplate stores a job template.
+func (s *Store) SetJobTemplate(ctx context.Context, template *JobTemplate) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.job_template", trace.WithAttributes(attribute.String("template", template.Name)))
+	defer span.End()
+
+	if err := template.Validate(); err != nil {
+		return err
+	}
+
+	tb, err := json.Marshal(template.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(fmt.Sprintf("%s:%s", templatesPrefix, template.Name), string(tb), nil)
+		return err
+	})
+}
+
+// GetJobTemplate returns the job template with the given name.
+func (s *Store) GetJobTemplate(ctx context.Context, name string) (*JobTemplate, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.job_template", trace.WithAttributes(attribute.String("template", name)))
+	defer span.End()
+
+	var template *JobTemplate
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		item, err := tx.Get(fmt.Sprintf("%s:%s", templatesPrefix, name))
+		if err != nil {
+			return err
+		}
+		var pbt dkronpb.JobTemplate
+		if err := json.Unmarshal([]byte(item), &pbt); err != nil {
+			return err
+		}
+		template = NewJobTemplateFromProto(&pbt)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GetJobTemplates returns all the job templates sorted by name.
+func (s *Store) GetJobTemplates(ctx context.Context) ([]*JobTemplate, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.job_templates")
+	defer span.End()
+
+	templates := []*JobTemplate{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:*", templatesPrefix), func(key, value string) bool {
+			var pbt dkronpb.JobTemplate
+			if err := json.Unmarshal([]byte(value), &pbt); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			templates = append(templates, NewJobTemplateFromProto(&pbt))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// DeleteJobTemplate deletes the job template with the given name, templates
+// used by some jobs can't be deleted.
+func (s *Store) DeleteJobTemplate(ctx context.Context, name string) (*JobTemplate, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.job_template", trace.WithAttributes(attribute.String("template", name)))
+	defer span.End()
+
+	template, err := s.GetJobTemplate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Update(func(tx *buntdb.Tx) error {
+		inUse := false
+		err := tx.AscendKeys(fmt.Sprintf("%s:*", jobsPrefix), func(key, value string) bool {
+			var pbj dkronpb.Job
+			if err := proto.Unmarshal([]byte(value), &pbj); err != nil {
+				if err := json.Unmarshal([]byte(value), &pbj); err != nil {
+					return true
+				}
+			}
+			inUse = pbj.Template == name
+			return !inUse
+		})
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return ErrJobTemplateInUse
+		}
+
+		_, err = tx.Delete(fmt.Sprintf("%s:%s", templatesPrefix, name))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// SetNamespace stores a namespace.
+func (s *Store) SetNamespace(ctx context.Context, namespace *Namespace) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.namespace", trace.WithAttributes(attribute.String("namespace", namespace.Name)))
+	defer span.End()
+
+	if err := namespace.Validate(); err != nil {
+		return err
+	}
+
+	nb, err := json.Marshal(namespace.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(fmt.Sprintf("%s:%s", namespacesPrefix, namespace.Name), string(nb), nil)
+		return err
+	})
+}
+
+// GetNamespace returns the namespace with the given name.
+func (s *Store) GetNamespace(ctx context.Context, name string) (*Namespace, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.namespace", trace.WithAttributes(attribute.String("namespace", name)))
+	defer span.End()
+
+	var namespace *Namespace
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		item, err := tx.Get(fmt.Sprintf("%s:%s", namespacesPrefix, name))
+		if err != nil {
+			return err
+		}
+		var pbn dkronpb.Namespace
+		if err := json.Unmarshal([]byte(item), &pbn); err != nil {
+			return err
+		}
+		namespace = NewNamespaceFromProto(&pbn)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return namespace, nil
+}
+
+// GetNamespaces returns all the namespaces sorted by name.
+func (s *Store) GetNamespaces(ctx context.Context) ([]*Namespace, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.namespaces")
+	defer span.End()
+
+	namespaces := []*Namespace{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:*", namespacesPrefix), func(key, value string) bool {
+			var pbn dkronpb.Namespace
+			if err := json.Unmarshal([]byte(value), &pbn); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			namespaces = append(namespaces, NewNamespaceFromProto(&pbn))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return namespaces, nil
+}
+
+// DeleteNamespace deletes the namespace with the given name, namespaces
+// that have some jobs can't be deleted.
+func (s *Store) DeleteNamespace(ctx context.Context, name string) (*Namespace, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.namespace", trace.WithAttributes(attribute.String("namespace", name)))
+	defer span.End()
+
+	namespace, err := s.GetNamespace(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Update(func(tx *buntdb.Tx) error {
+		count, err := countNamespaceJobsTx(tx, name)
+		if err != nil {
+			return err
+		}
+		if count > 0 && name != DefaultNamespace {
+			return ErrNamespaceInUse
+		}
+
+		_, err = tx.Delete(fmt.Sprintf("%s:%s", namespacesPrefix, name))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return namespace, nil
+}
+
+// countNamespaceJobsTx returns the number of jobs in the namespace. The keys
+// of the jobs of a namespace share the prefix of their qualified names, but
+// the names of the default namespace are not qualified.
+func countNamespaceJobsTx(tx *buntdb.Tx, namespace string) (int, error) {
+	count := 0
+	pattern := fmt.Sprintf("%s:%s%s*", jobsPrefix, namespace, namespaceSeparator)
+	if namespace == DefaultNamespace {
+		pattern = fmt.Sprintf("%s:*", jobsPrefix)
+	}
+	err := tx.AscendKeys(pattern, func(key, value string) bool {
+		if ns, _ := splitJobName(strings.TrimPrefix(key, jobsPrefix+":")); ns == namespace {
+			count++
+		}
+		return true
+	})
+	return count, err
+}
+
+// SetMaintenanceWindow stores a maintenance window.
+func (s *Store) SetMaintenanceWindow(ctx context.Context, window *MaintenanceWindow) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.maintenance_window", trace.WithAttributes(attribute.String("window", window.Name)))
+	defer span.End()
+
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	wb, err := json.Marshal(window.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(fmt.Sprintf("%s:%s", windowsPrefix, window.Name), string(wb), nil)
+		return err
+	})
+}
+
+// GetMaintenanceWindow returns the maintenance window with the given name.
+func (s *Store) GetMaintenanceWindow(ctx context.Context, name string) (*MaintenanceWindow, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.maintenance_window", trace.WithAttributes(attribute.String("window", name)))
+	defer span.End()
+
+	var window *MaintenanceWindow
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		item, err := tx.Get(fmt.Sprintf("%s:%s", windowsPrefix, name))
+		if err != nil {
+			return err
+		}
+		var pbw dkronpb.MaintenanceWindow
+		if err := json.Unmarshal([]byte(item), &pbw); err != nil {
+			return err
+		}
+		window = NewMaintenanceWindowFromProto(&pbw)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return window, nil
+}
+
+// GetMaintenanceWindows returns all the maintenance windows sorted by name.
+func (s *Store) GetMaintenanceWindows(ctx context.Context) ([]*MaintenanceWindow, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.maintenance_windows")
+	defer span.End()
+
+	windows := []*MaintenanceWindow{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:*", windowsPrefix), func(key, value string) bool {
+			var pbw dkronpb.MaintenanceWindow
+			if err := json.Unmarshal([]byte(value), &pbw); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			windows = append(windows, NewMaintenanceWindowFromProto(&pbw))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window with the given name,
+// returning the deleted window.
+func (s *Store) DeleteMaintenanceWindow(ctx context.Context, name string) (*MaintenanceWindow, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.maintenance_window", trace.WithAttributes(attribute.String("window", name)))
+	defer span.End()
+
+	window, err := s.GetMaintenanceWindow(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(fmt.Sprintf("%s:%s", windowsPrefix, name))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return window, nil
+}
+
+// SetSuppression records a run suppressed by a maintenance window, keeping
+// the last maxSuppressions records of the job.
+func (s *Store) SetSuppression(ctx context.Context, suppression *Suppression) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.suppression", trace.WithAttributes(attribute.String("job_name", suppression.JobName)))
+	defer span.End()
+
+	sb, err := json.Marshal(suppression.ToProto())
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%s:%s:%020d", suppressPrefix, suppression.JobName, suppression.SuppressedAt.UnixNano())
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		if _, _, err := tx.Set(key, string(sb), nil); err != nil {
+			return err
+		}
+
+		// Keys sort by time, prune the oldest ones over the limit
+		var keys []string
+		err := tx.AscendKeys(fmt.Sprintf("%s:%s:*", suppressPrefix, suppression.JobName), func(key, value string) bool {
+			keys = append(keys, key)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(keys)-maxSuppressions; i++ {
+			if _, err := tx.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetSuppressions returns the runs of a job suppressed by maintenance windows,
+// or of all jobs when the job name is empty, the oldest first.
+func (s *Store) GetSuppressions(ctx context.Context, jobName string) ([]*Suppression, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.suppressions", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	pattern := suppressPrefix + ":*"
+	if jobName != "" {
+		pattern = fmt.Sprintf("%s:%s:*", suppressPrefix, jobName)
+	}
+
+	suppressions := []*Suppression{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(pattern, func(key, value string) bool {
+			var pbs dkronpb.Suppression
+			if err := json.Unmarshal([]byte(value), &pbs); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			suppressions = append(suppressions, NewSuppressionFromProto(&pbs))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(suppressions, func(i, j int) bool {
+		return suppressions[i].SuppressedAt.Before(suppressions[j].SuppressedAt)
+	})
+
+	return suppressions, nil
+}
+
+func (*Store) deleteSuppressionsTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var keys []string
+		err := tx.AscendKeys(fmt.Sprintf("%s:%s:*", suppressPrefix, jobName), func(key, value string) bool {
+			keys = append(keys, key)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if _, err := tx.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// SetPause stores a pause of the job submissions, pruning the pauses
+// expired when it was created.
+func (s *Store) SetPause(ctx context.Context, pause *Pause) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.pause", trace.WithAttributes(attribute.String("pause", pause.ID)))
+	defer span.End()
+
+	pb, err := json.Marshal(pause.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		// Prune using the creation time so every node prunes the same pauses
+		var expired []string
+		err := tx.AscendKeys(fmt.Sprintf("%s:*", pausesPrefix), func(key, value string) bool {
+			var pbp dkronpb.Pause
+			if err := json.Unmarshal([]byte(value), &pbp); err != nil {
+				return true
+			}
+			if !NewPauseFromProto(&pbp).activeAt(pause.CreatedAt) {
+				expired = append(expired, key)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range expired {
+			if _, err := tx.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		_, _, err = tx.Set(fmt.Sprintf("%s:%s", pausesPrefix, pause.ID), string(pb), nil)
+		return err
+	})
+}
+
+// GetPauses returns the pauses of the job submissions that didn't expire,
+// the oldest first.
+func (s *Store) GetPauses(ctx context.Context) ([]*Pause, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.pauses")
+	defer span.End()
+
+	now := time.Now()
+	pauses := []*Pause{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:*", pausesPrefix), func(key, value string) bool {
+			var pbp dkronpb.Pause
+			if err := json.Unmarshal([]byte(value), &pbp); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			if p := NewPauseFromProto(&pbp); p.activeAt(now) {
+				pauses = append(pauses, p)
+			}
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(pauses, func(i, j int) bool {
+		return pauses[i].CreatedAt.Before(pauses[j].CreatedAt)
+	})
+
+	return pauses, nil
+}
+
+// DeletePause deletes the pause with the given id, or all the pauses when
+// the id is empty, returning the deleted pauses that didn't expire.
+func (s *Store) DeletePause(ctx context.Context, id string) ([]*Pause, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.pause", trace.WithAttributes(attribute.String("pause", id)))
+	defer span.End()
+
+	if id != "" && !validPauseID(id) {
+		return nil, ErrWrongPauseID
+	}
+
+	now := time.Now()
+	pauses := []*Pause{}
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		var keys []string
+		add := func(key, value string) bool {
+			keys = append(keys, key)
+			var pbp dkronpb.Pause
+			if err := json.Unmarshal([]byte(value), &pbp); err != nil {
+				return true
+			}
+			if p := NewPauseFromProto(&pbp); p.activeAt(now) {
+				pauses = append(pauses, p)
+			}
+			return true
+		}
+
+		if id != "" {
+			key := fmt.Sprintf("%s:%s", pausesPrefix, id)
+			value, err := tx.Get(key)
+			if err != nil {
+				return err
+			}
+			add(key, value)
+		} else if err := tx.AscendKeys(pausesPrefix+":*", add); err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if _, err := tx.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(pauses, func(i, j int) bool {
+		return pauses[i].CreatedAt.Before(pauses[j].CreatedAt)
+	})
+
+	return pauses, nil
+}
+
+// SetWebhookTrigger stores a webhook trigger.
+func (s *Store) SetWebhookTrigger(ctx context.Context, trigger *WebhookTrigger) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.webhook_trigger", trace.WithAttributes(attribute.String("trigger", trigger.ID)))
+	defer span.End()
+
+	if err := trigger.Validate(); err != nil {
+		return err
+	}
+
+	tb, err := json.Marshal(trigger.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(fmt.Sprintf("%s:%s", hooksPrefix, trigger.ID), string(tb), nil)
+		return err
+	})
+}
+
+// GetWebhookTrigger returns the webhook trigger with the given id.
+func (s *Store) GetWebhookTrigger(ctx context.Context, id string) (*WebhookTrigger, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.webhook_trigger", trace.WithAttributes(attribute.String("trigger", id)))
+	defer span.End()
+
+	var trigger *WebhookTrigger
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		item, err := tx.Get(fmt.Sprintf("%s:%s", hooksPrefix, id))
+		if err != nil {
+			return err
+		}
+		var pbt dkronpb.WebhookTrigger
+		if err := json.Unmarshal([]byte(item), &pbt); err != nil {
+			return err
+		}
+		trigger = NewWebhookTriggerFromProto(&pbt)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+// GetWebhookTriggers returns the webhook triggers sorted by id, or the ones
+// of a job when the job name is not empty.
+func (s *Store) GetWebhookTriggers(ctx context.Context, jobName string) ([]*WebhookTrigger, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.webhook_triggers", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	triggers := []*WebhookTrigger{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:*", hooksPrefix), func(key, value string) bool {
+			var pbt dkronpb.WebhookTrigger
+			if err := json.Unmarshal([]byte(value), &pbt); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			if jobName == "" || pbt.JobName == jobName {
+				triggers = append(triggers, NewWebhookTriggerFromProto(&pbt))
+			}
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return triggers, nil
+}
+
+// DeleteWebhookTrigger deletes the webhook trigger with the given id,
+// returning the deleted trigger.
+func (s *Store) DeleteWebhookTrigger(ctx context.Context, id string) (*WebhookTrigger, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.webhook_trigger", trace.WithAttributes(attribute.String("trigger", id)))
+	defer span.End()
+
+	trigger, err := s.GetWebhookTrigger(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(fmt.Sprintf("%s:%s", hooksPrefix, id))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+func (*Store) deleteWebhookTriggersTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var keys []string
+		err := tx.AscendKeys(fmt.Sprintf("%s:*", hooksPrefix), func(key, value string) bool {
+			var pbt dkronpb.WebhookTrigger
+			if err := json.Unmarshal([]byte(value), &pbt); err == nil && pbt.JobName == jobName {
+				keys = append(keys, key)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if _, err := tx.Delete(k); err != nil {
 				return err
 			}
-		} else {
-			// If coming from a backup us the previous value, don't allow overwriting this
-			if job.Next.Before(ej.Next) {
-				job.Next = ej.Next
-			}
 		}
+		return nil
+	}
+}
 
-		pbj := job.ToProto()
-		if err := s.setJobTxFunc(pbj)(tx); err != nil {
-			return err
-		}
+// SetPendingRetry stores a retry of a failed execution waiting for its backoff delay.
+func (s *Store) SetPendingRetry(ctx context.Context, retry *PendingRetry) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.pending_retry", trace.WithAttributes(attribute.String("job_name", retry.Execution.JobName)))
+	defer span.End()
 
-		return nil
-	})
+	rb, err := json.Marshal(retry.ToProto())
 	if err != nil {
 		return err
 	}
+	key := fmt.Sprintf("%s:%s:%s", retriesPrefix, retry.Execution.JobName, retry.ID())
 
-	// If the parent job changed update the parents of the old (if any) and new jobs
-	if job.ParentJob != ej.ParentJob {
-		if err := s.removeFromParent(ctx, ej); err != nil {
-			return err
-		}
-		if err := s.addToParent(ctx, job); err != nil {
-			return err
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(key, string(rb), nil)
+		return err
+	})
+}
+
+// DeletePendingRetry deletes a pending retry, returning whether it existed.
+func (s *Store) DeletePendingRetry(ctx context.Context, jobName string, id string) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.pending_retry", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	found := true
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(fmt.Sprintf("%s:%s:%s", retriesPrefix, jobName, id))
+		if err == buntdb.ErrNotFound {
+			found = false
+			return nil
 		}
+		return err
+	})
+	if err != nil {
+		return false, err
 	}
 
-	return nil
+	return found, nil
 }
 
-// Removes the given job from its parent.
-// Does nothing if nil is passed as child.
-func (s *Store) removeFromParent(ctx context.Context, child *Job) error {
-	ctx, span := s.tracer.Start(ctx, "buntdb.remove_from_parent")
+// GetPendingRetries returns the pending retries of a job, or of all jobs when
+// the job name is empty, the next one to run first.
+func (s *Store) GetPendingRetries(ctx context.Context, jobName string) ([]*PendingRetry, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.pending_retries", trace.WithAttributes(attribute.String("job_name", jobName)))
 	defer span.End()
 
-	// Do nothing if no job was given or job has no parent
-	if child == nil || child.ParentJob == "" {
-		return nil
+	pattern := retriesPrefix + ":*"
+	if jobName != "" {
+		pattern = fmt.Sprintf("%s:%s:*", retriesPrefix, jobName)
+	}
+
+	retries := []*PendingRetry{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(pattern, func(key, value string) bool {
+			var pbr dkronpb.PendingRetry
+			if err := json.Unmarshal([]byte(value), &pbr); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			retries = append(retries, NewPendingRetryFromProto(&pbr))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	parent, err := child.GetParent(ctx, s)
+	sort.SliceStable(retries, func(i, j int) bool {
+		return retries[i].RunAt.Before(retries[j].RunAt)
+	})
+
+	return retries, nil
+}
+
+// SetDeferredRun stores a run deferred by a maintenance window, replacing the
+// run of the job deferred until the same time.
+func (s *Store) SetDeferredRun(ctx context.Context, run *DeferredRun) error {
+	ctx, span := s.tracer.Start(ctx, "buntdb.set.deferred_run", trace.WithAttributes(attribute.String("job_name", run.Execution.JobName)))
+	defer span.End()
+
+	rb, err := json.Marshal(run.ToProto())
 	if err != nil {
 		return err
 	}
+	key := fmt.Sprintf("%s:%s:%s", deferredPrefix, run.Execution.JobName, run.ID())
 
-	// Remove all occurrences from the parent, not just one.
-	// Due to an old bug (in v1), a parent can have the same child more than once.
-	djs := []string{}
-	for _, djn := range parent.DependentJobs {
-		if djn != child.Name {
-			djs = append(djs, djn)
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(key, string(rb), nil)
+		return err
+	})
+}
+
+// DeleteDeferredRun deletes a deferred run, returning whether it existed.
+func (s *Store) DeleteDeferredRun(ctx context.Context, jobName string, id string) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.delete.deferred_run", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	found := true
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(fmt.Sprintf("%s:%s:%s", deferredPrefix, jobName, id))
+		if err == buntdb.ErrNotFound {
+			found = false
+			return nil
 		}
-	}
-	parent.DependentJobs = djs
-	if err := s.SetJob(ctx, parent, false); err != nil {
 		return err
+	})
+	if err != nil {
+		return false, err
 	}
 
-	return nil
+	return found, nil
 }
 
-// Adds the given job to its parent.
-func (s *Store) addToParent(ctx context.Context, child *Job) error {
-	ctx, span := s.tracer.Start(ctx, "buntdb.add_to_parent")
+// GetDeferredRuns returns the deferred runs of a job, or of all jobs when the
+// job name is empty, the next one to run first.
+func (s *Store) GetDeferredRuns(ctx context.Context, jobName string) ([]*DeferredRun, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.deferred_runs", trace.WithAttributes(attribute.String("job_name", jobName)))
 	defer span.End()
 
-	// Do nothing if job has no parent
-	if child.ParentJob == "" {
-		return nil
+	pattern := deferredPrefix + ":*"
+	if jobName != "" {
+		pattern = fmt.Sprintf("%s:%s:*", deferredPrefix, jobName)
 	}
 
-	parent, err := child.GetParent(ctx, s)
+	runs := []*DeferredRun{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(pattern, func(key, value string) bool {
+			var pbr dkronpb.DeferredRun
+			if err := json.Unmarshal([]byte(value), &pbr); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			runs = append(runs, NewDeferredRunFromProto(&pbr))
+			return true
+		})
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	parent.DependentJobs = append(parent.DependentJobs, child.Name)
-	if err := s.SetJob(ctx, parent, false); err != nil {
-		return err
-	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].RunAt.Before(runs[j].RunAt)
+	})
 
-	return nil
+	return runs, nil
 }
 
 // SetExecutionDone saves the execution and updates the job with the corresponding
@@ -292,13 +1654,13 @@ func (s *Store) SetExecutionDone(ctx context.Context, execution *Execution) (boo
 			pbj.LastSuccess.Time = pbe.FinishedAt
 			pbj.SuccessCount++
 			// Emit metrics for successful job execution
-			metrics.IncrCounterWithLabels([]string{"job", "executions_succeeded_total"}, 1, []metrics.Label{{Name: "job_name", Value: execution.JobName}})
+			metrics.IncrCounterWithLabels([]string{"job", "executions_succeeded_total"}, 1, jobLabels("job_name", execution.JobName))
 		} else {
 			pbj.LastError.HasValue = true
 			pbj.LastError.Time = pbe.FinishedAt
 			pbj.ErrorCount++
 			// Emit metrics for failed job execution
-			metrics.IncrCounterWithLabels([]string{"job", "executions_failed_total"}, 1, []metrics.Label{{Name: "job_name", Value: execution.JobName}})
+			metrics.IncrCounterWithLabels([]string{"job", "executions_failed_total"}, 1, jobLabels("job_name", execution.JobName))
 		}
 
 		status, err := s.computeStatus(pbj.Name, pbe.Group, tx)
@@ -346,6 +1708,23 @@ func (s *Store) GetJobs(ctx context.Context, options *JobOptions) ([]*Job, error
 		}
 	}
 
+	templates, err := s.GetJobTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*JobTemplate, len(templates))
+	for _, t := range templates {
+		byName[t.Name] = t
+	}
+	namespaces, err := s.GetNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nsDefaults := make(map[string]*JobTemplate, len(namespaces))
+	for _, ns := range namespaces {
+		nsDefaults[ns.Name] = ns.Defaults
+	}
+
 	jobs := make([]*Job, 0)
 	jobsFn := func(key, item string) bool {
 		var pbj dkronpb.Job
@@ -358,9 +1737,14 @@ func (s *Store) GetJobs(ctx context.Context, options *JobOptions) ([]*Job, error
 		}
 		job := NewJobFromProto(&pbj, s.logger)
 		job.logger = s.logger
+		namespace, _ := splitJobName(job.Name)
+		if defaults := byName[job.Template].with(nsDefaults[namespace]); defaults != nil {
+			defaults.apply(job)
+		}
 
 		if options == nil ||
 			(options.Metadata == nil || len(options.Metadata) == 0 || s.jobHasMetadata(job, options.Metadata)) &&
+				(options.Namespace == "" || job.Namespace == options.Namespace) &&
 				(options.Query == "" || strings.Contains(job.Name, options.Query) || strings.Contains(job.DisplayName, options.Query)) &&
 				(options.Disabled == "" || strconv.FormatBool(job.Disabled) == options.Disabled) &&
 				((options.Status == "untriggered" && job.Status == "") || (options.Status == "" || job.Status == options.Status)) {
@@ -370,7 +1754,7 @@ func (s *Store) GetJobs(ctx context.Context, options *JobOptions) ([]*Job, error
 		return true
 	}
 
-	err := s.db.View(func(tx *buntdb.Tx) error {
+	err = s.db.View(func(tx *buntdb.Tx) error {
 		var err error
 		if options.Order == "DESC" {
 			err = tx.Descend(options.Sort, jobsFn)
@@ -398,6 +1782,16 @@ func (s *Store) GetJob(ctx context.Context, name string, options *JobOptions) (*
 	job := NewJobFromProto(&pbj, s.logger)
 	job.logger = s.logger
 
+	// Return the job resolved with the fields inherited from its template
+	// and its namespace
+	defaults, err := inheritedDefaults(ctx, s, job)
+	if err != nil {
+		return nil, fmt.Errorf("job %s: %w", job.Name, err)
+	}
+	if defaults != nil {
+		defaults.apply(job)
+	}
+
 	return job, nil
 }
 
@@ -450,6 +1844,38 @@ func (s *Store) DeleteJob(ctx context.Context, name string) (*Job, error) {
 			return err
 		}
 
+		if err := s.deleteWorkflowRunsTxFunc(name)(tx); err != nil {
+			return err
+		}
+
+		if err := s.deleteQueueTxFunc(name)(tx); err != nil {
+			return err
+		}
+
+		if err := s.deletePendingRetriesTxFunc(name)(tx); err != nil {
+			return err
+		}
+
+		if err := s.deleteDeferredRunsTxFunc(name)(tx); err != nil {
+			return err
+		}
+
+		if _, err := tx.Delete(fmt.Sprintf("%s:%s", firesPrefix, name)); err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+
+		if err := s.deleteSuppressionsTxFunc(name)(tx); err != nil {
+			return err
+		}
+
+		if err := s.deleteWebhookTriggersTxFunc(name)(tx); err != nil {
+			return err
+		}
+
+		if err := s.deleteJobVersionsTxFunc(name)(tx); err != nil {
+			return err
+		}
+
 		_, err := tx.Delete(fmt.Sprintf("%s:%s", jobsPrefix, name))
 		return err
 	})
@@ -457,9 +1883,9 @@ func (s *Store) DeleteJob(ctx context.Context, name string) (*Job, error) {
 		return nil, err
 	}
 
-	// If the transaction succeeded, remove from parent
-	if job.ParentJob != "" {
-		if err := s.removeFromParent(ctx, job); err != nil {
+	// If the transaction succeeded, remove from parents
+	for _, p := range job.parents() {
+		if err := s.removeFromParent(ctx, p, job.Name); err != nil {
 			return nil, err
 		}
 	}
@@ -467,6 +1893,55 @@ func (s *Store) DeleteJob(ctx context.Context, name string) (*Job, error) {
 	return job, nil
 }
 
+// GetJobVersions returns the versions of a job, oldest first.
+func (s *Store) GetJobVersions(ctx context.Context, jobName string) ([]*JobVersion, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.job_versions", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	versions := []*JobVersion{}
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(fmt.Sprintf("%s:%s:*", versionsPrefix, jobName), func(key, value string) bool {
+			var pbv dkronpb.JobVersion
+			if err := json.Unmarshal([]byte(value), &pbv); err != nil {
+				s.logger.WithError(err).WithField("key", key).Debug("error unmarshaling JSON")
+				return true
+			}
+			versions = append(versions, NewJobVersionFromProto(&pbv, s.logger))
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// GetJobVersion returns the given version of a job.
+func (s *Store) GetJobVersion(ctx context.Context, jobName string, version int64) (*JobVersion, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.job_version", trace.WithAttributes(attribute.String("job_name", jobName)))
+	defer span.End()
+
+	var v *JobVersion
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		item, err := tx.Get(jobVersionKey(jobName, version))
+		if err != nil {
+			return err
+		}
+		var pbv dkronpb.JobVersion
+		if err := json.Unmarshal([]byte(item), &pbv); err != nil {
+			return err
+		}
+		v = NewJobVersionFromProto(&pbv, s.logger)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 // GetExecutions returns the executions given a Job name.
 func (s *Store) GetExecutions(ctx context.Context, jobName string, opts *ExecutionOptions) ([]*Execution, error) {
 	ctx, span := s.tracer.Start(ctx, "buntdb.get.executions", trace.WithAttributes(attribute.String("job_name", jobName)))
@@ -603,6 +2078,44 @@ func (s *Store) GetRunningExecutions(ctx context.Context, jobName string) ([]*Ex
 	return runningExecs, nil
 }
 
+// GetNamespaceRunningExecutions returns the executions of all the jobs of a
+// namespace that have started but not finished.
+func (s *Store) GetNamespaceRunningExecutions(ctx context.Context, namespace string) ([]*Execution, error) {
+	ctx, span := s.tracer.Start(ctx, "buntdb.get.namespace_running_executions", trace.WithAttributes(attribute.String("namespace", namespace)))
+	defer span.End()
+
+	// The jobs of the default namespace are not qualified, they are told
+	// apart once unmarshalled
+	kvs := []kv{}
+	pattern := fmt.Sprintf("%s:%s:*", executionsPrefix, qualifiedJobName(namespace, "*"))
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(pattern, func(key, value string) bool {
+			kvs = append(kvs, kv{Key: key, Value: []byte(value)})
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allExecs, err := s.unmarshalExecutions(kvs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var runningExecs []*Execution
+	for _, exec := range allExecs {
+		if ns, _ := splitJobName(exec.JobName); ns != namespace {
+			continue
+		}
+		if !exec.StartedAt.IsZero() && exec.FinishedAt.IsZero() {
+			runningExecs = append(runningExecs, exec)
+		}
+	}
+
+	return runningExecs, nil
+}
+
 // GetGroupedExecutions returns executions for a job grouped and with an ordered index
 // to facilitate access.
 func (s *Store) GetGroupedExecutions(ctx context.Context, jobName string, opts *ExecutionOptions) (map[int64][]*Execution, []int64, error) {
@@ -780,6 +2293,98 @@ func (s *Store) deleteExecutionsTxFunc(jobName string) func(tx *buntdb.Tx) error
 	}
 }
 
+// deleteWorkflowRunsTxFunc removes all the pending workflow runs of a job
+func (s *Store) deleteWorkflowRunsTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var delkeys []string
+		if err := tx.AscendKeys(fmt.Sprintf("%s:%s:*", workflowsPrefix, jobName), func(key, value string) bool {
+			delkeys = append(delkeys, key)
+			return true
+		}); err != nil {
+			return err
+		}
+
+		for _, k := range delkeys {
+			_, _ = tx.Delete(k)
+		}
+
+		return nil
+	}
+}
+
+// deleteQueueTxFunc removes all the queued executions of a job
+func (s *Store) deleteQueueTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		keys, err := s.queueKeysTx(tx, jobName)
+		if err != nil {
+			return err
+		}
+
+		for _, k := range keys {
+			_, _ = tx.Delete(k)
+		}
+
+		return nil
+	}
+}
+
+// deletePendingRetriesTxFunc removes all the pending retries of a job
+func (s *Store) deletePendingRetriesTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var delkeys []string
+		if err := tx.AscendKeys(fmt.Sprintf("%s:%s:*", retriesPrefix, jobName), func(key, value string) bool {
+			delkeys = append(delkeys, key)
+			return true
+		}); err != nil {
+			return err
+		}
+
+		for _, k := range delkeys {
+			_, _ = tx.Delete(k)
+		}
+
+		return nil
+	}
+}
+
+// deleteDeferredRunsTxFunc removes all the deferred runs of a job
+func (s *Store) deleteDeferredRunsTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var delkeys []string
+		if err := tx.AscendKeys(fmt.Sprintf("%s:%s:*", deferredPrefix, jobName), func(key, value string) bool {
+			delkeys = append(delkeys, key)
+			return true
+		}); err != nil {
+			return err
+		}
+
+		for _, k := range delkeys {
+			_, _ = tx.Delete(k)
+		}
+
+		return nil
+	}
+}
+
+// deleteJobVersionsTxFunc removes all the versions of a job
+func (s *Store) deleteJobVersionsTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var delkeys []string
+		if err := tx.AscendKeys(fmt.Sprintf("%s:%s:*", versionsPrefix, jobName), func(key, value string) bool {
+			delkeys = append(delkeys, key)
+			return true
+		}); err != nil {
+			return err
+		}
+
+		for _, k := range delkeys {
+			_, _ = tx.Delete(k)
+		}
+
+		return nil
+	}
+}
+
 // Shutdown close the KV store
 func (s *Store) Shutdown() error {
 	return s.db.Close()
@@ -2,9 +2,11 @@ package dkron
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/distribworks/dkron/v4/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/buntdb"
@@ -51,12 +53,14 @@ func TestStore(t *testing.T) {
 	assert.Equal(t, "test", jobs[0].Name)
 
 	testExecution := &Execution{
-		JobName:    "test",
-		StartedAt:  time.Now().UTC(),
-		FinishedAt: time.Now().UTC(),
-		Success:    true,
-		Output:     "test",
-		NodeName:   "testNode",
+		JobName:     "test",
+		StartedAt:   time.Now().UTC(),
+		FinishedAt:  time.Now().UTC(),
+		Success:     true,
+		Output:      "test",
+		NodeName:    "testNode",
+		ScheduledAt: time.Now().UTC().Add(-time.Second),
+		Trigger:     TriggerCron,
 	}
 
 	_, err = s.SetExecution(ctx, testExecution)
@@ -189,6 +193,775 @@ func TestStore_ChildIsUpdatedAfterDeletingParentJob(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStore_MultipleParents(t *testing.T) {
+	s := setupStore(t)
+
+	storeJob(t, s, "root")
+	storeChildJob(t, s, "parent1", "root")
+	storeChildJob(t, s, "parent2", "root")
+	storeChildJobWithParents(t, s, "child1", "parent1", "parent2")
+
+	assert.Equal(t, []string{"child1"}, loadJob(t, s, "parent1").DependentJobs)
+	assert.Equal(t, []string{"child1"}, loadJob(t, s, "parent2").DependentJobs)
+
+	// Dropping one of the parents only updates that parent
+	storeChildJobWithParents(t, s, "child1", "parent2")
+	assert.Empty(t, loadJob(t, s, "parent1").DependentJobs)
+	assert.Equal(t, []string{"child1"}, loadJob(t, s, "parent2").DependentJobs)
+
+	deleteJob(t, s, "child1")
+	assert.Empty(t, loadJob(t, s, "parent2").DependentJobs)
+}
+
+func TestStore_IndependentParents(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	storeJob(t, s, "root")
+	storeJob(t, s, "other_root")
+	storeChildJob(t, s, "parent1", "root")
+	storeChildJob(t, s, "parent2", "root")
+
+	// Parents descending from different roots are rejected
+	job := scaffoldJob()
+	job.Name = "child1"
+	job.ParentJobs = []string{"parent1", "other_root"}
+	assert.ErrorIs(t, s.SetJob(ctx, job, false), ErrIndependentParents)
+
+	storeChildJobWithParents(t, s, "child1", "parent1", "parent2")
+
+	// Moving a parent under another root breaks its dependent jobs
+	parent := loadJob(t, s, "parent2")
+	parent.ParentJob = "other_root"
+	assert.ErrorIs(t, s.SetJob(ctx, parent, false), ErrIndependentParents)
+	assert.Equal(t, "root", loadJob(t, s, "parent2").ParentJob)
+}
+
+func TestStore_DependencyCycle(t *testing.T) {
+	s := setupStore(t)
+
+	storeJob(t, s, "job1")
+	storeChildJob(t, s, "job2", "job1")
+	storeChildJob(t, s, "job3", "job2")
+
+	job := scaffoldJob()
+	job.Name = "job1"
+	job.ParentJobs = []string{"job3"}
+	err := s.SetJob(context.Background(), job, false)
+	assert.ErrorIs(t, err, ErrDependencyCycle)
+
+	assert.Empty(t, loadJob(t, s, "job1").ParentJobs)
+	assert.Empty(t, loadJob(t, s, "job3").DependentJobs)
+}
+
+func TestStore_ParentJobDone(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	storeJob(t, s, "root")
+	storeChildJob(t, s, "parent1", "root")
+	storeChildJob(t, s, "parent2", "root")
+	storeChildJobWithParents(t, s, "child1", "parent1", "parent2")
+
+	ready, err := s.ParentJobDone(ctx, "child1", "parent1", 1)
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	// A different workflow run doesn't complete the first one
+	ready, err = s.ParentJobDone(ctx, "child1", "parent2", 2)
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	ready, err = s.ParentJobDone(ctx, "child1", "parent2", 1)
+	require.NoError(t, err)
+	assert.True(t, ready)
+
+	// Reporting the same parent again must not run the job twice
+	ready, err = s.ParentJobDone(ctx, "child1", "parent2", 1)
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	// Pending workflow runs are removed with the job
+	deleteJob(t, s, "child1")
+	err = s.db.View(func(tx *buntdb.Tx) error {
+		n := 0
+		err := tx.AscendKeys(workflowsPrefix+":*", func(key, value string) bool {
+			n++
+			return true
+		})
+		assert.Equal(t, 0, n)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestStore_PendingRetries(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	storeJob(t, s, "job1")
+	storeJob(t, s, "job2")
+
+	now := time.Now()
+	late := &PendingRetry{
+		Execution: &Execution{JobName: "job1", StartedAt: now, NodeName: "node1", Attempt: 2},
+		RunAt:     now.Add(time.Hour),
+	}
+	soon := &PendingRetry{
+		Execution: &Execution{JobName: "job2", StartedAt: now, NodeName: "node1", Attempt: 3},
+		RunAt:     now.Add(time.Minute),
+	}
+	require.NoError(t, s.SetPendingRetry(ctx, late))
+	require.NoError(t, s.SetPendingRetry(ctx, soon))
+
+	retries, err := s.GetPendingRetries(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, retries, 2)
+	assert.Equal(t, "job2", retries[0].Execution.JobName)
+	assert.Equal(t, uint(3), retries[0].Execution.Attempt)
+
+	retries, err = s.GetPendingRetries(ctx, "job1")
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	assert.Equal(t, late.ID(), retries[0].ID())
+
+	// A pending retry can only be claimed once
+	found, err := s.DeletePendingRetry(ctx, "job2", soon.ID())
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = s.DeletePendingRetry(ctx, "job2", soon.ID())
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// Pending retries are removed with the job
+	deleteJob(t, s, "job1")
+	retries, err = s.GetPendingRetries(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+}
+
+func TestStore_DeferredRuns(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	storeJob(t, s, "job1")
+
+	end := time.Now().Add(time.Hour)
+	first := &DeferredRun{Execution: NewExecution("job1", TriggerCron), RunAt: end}
+	require.NoError(t, s.SetDeferredRun(ctx, first))
+
+	// The runs deferred until the same time are merged, until another
+	// time they are kept apart
+	last := &DeferredRun{Execution: NewExecution("job1", TriggerCron), RunAt: end}
+	last.Execution.Group++
+	require.NoError(t, s.SetDeferredRun(ctx, last))
+	later := &DeferredRun{Execution: NewExecution("job1", TriggerCron), RunAt: end.Add(time.Hour)}
+	require.NoError(t, s.SetDeferredRun(ctx, later))
+
+	runs, err := s.GetDeferredRuns(ctx, "job1")
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, last.Execution.Group, runs[0].Execution.Group)
+	assert.True(t, later.RunAt.Equal(runs[1].RunAt))
+
+	// Deferred runs are not listed as pending retries
+	retries, err := s.GetPendingRetries(ctx, "job1")
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+
+	// A deferred run can only be claimed once
+	found, err := s.DeleteDeferredRun(ctx, "job1", last.ID())
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = s.DeleteDeferredRun(ctx, "job1", last.ID())
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// Deferred runs are removed with the job
+	deleteJob(t, s, "job1")
+	runs, err = s.GetDeferredRuns(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+}
+
+func TestStore_ClaimSlot(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	storeJob(t, s, "job1")
+
+	last, err := s.GetLastFire(ctx, "job1")
+	require.NoError(t, err)
+	assert.True(t, last.IsZero())
+
+	fire := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	claimed, err := s.ClaimSlot(ctx, "job1", fire)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// A second claim for the same slot is rejected
+	claimed, err = s.ClaimSlot(ctx, "job1", fire)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// Slots before the last claimed one are rejected
+	claimed, err = s.ClaimSlot(ctx, "job1", fire.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// Only the last claimed slot is kept
+	claimed, err = s.ClaimSlot(ctx, "job1", fire.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	last, err = s.GetLastFire(ctx, "job1")
+	require.NoError(t, err)
+	assert.True(t, fire.Add(time.Hour).Equal(last))
+	keys := 0
+	require.NoError(t, s.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendKeys(firesPrefix+":job1*", func(key, value string) bool {
+			keys++
+			return true
+		})
+	}))
+	assert.Equal(t, 1, keys)
+
+	// Ticks of @every schedules computed by another leader are rejected until
+	// the next tick of the last claimed one
+	job := scaffoldJob()
+	job.Name = "job2"
+	job.Schedule = "@every 1m"
+	require.NoError(t, s.SetJob(ctx, job, false))
+	claimed, err = s.ClaimSlot(ctx, "job2", fire)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, err = s.ClaimSlot(ctx, "job2", fire.Add(20*time.Second))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	claimed, err = s.ClaimSlot(ctx, "job2", fire.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// Changing the misfire policy keeps the last fire
+	job.MisfirePolicy = MisfireRunAll
+	require.NoError(t, s.SetJob(ctx, job, false))
+	last, err = s.GetLastFire(ctx, "job2")
+	require.NoError(t, err)
+	assert.True(t, fire.Add(time.Minute).Equal(last))
+
+	deleteJob(t, s, "job1")
+	last, err = s.GetLastFire(ctx, "job1")
+	require.NoError(t, err)
+	assert.True(t, last.IsZero())
+
+	claimed, err = s.ClaimSlot(ctx, "job1", fire)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestStore_Calendars(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	// Jobs can't use a calendar that doesn't exist
+	job := scaffoldJob()
+	job.Calendar = "us-banking"
+	assert.ErrorIs(t, s.SetJob(ctx, job, false), ErrCalendarNotFound)
+
+	cal := &Calendar{
+		Name:             "us-banking",
+		Holidays:         []string{"2024-12-25"},
+		ExcludedWeekdays: []string{"saturday", "sunday"},
+	}
+	require.NoError(t, s.SetCalendar(ctx, cal))
+	require.Error(t, s.SetCalendar(ctx, &Calendar{Name: "bad", Holidays: []string{"25/12/2024"}}))
+
+	stored, err := s.GetCalendar(ctx, "us-banking")
+	require.NoError(t, err)
+	assert.Equal(t, cal, stored)
+
+	calendars, err := s.GetCalendars(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*Calendar{cal}, calendars)
+
+	require.NoError(t, s.SetJob(ctx, job, false))
+
+	// The next run skips the days excluded by the calendar
+	require.NoError(t, s.SetCalendar(ctx, &Calendar{
+		Name:             "wednesdays",
+		ExcludedWeekdays: []string{"monday", "tuesday", "thursday", "friday", "saturday", "sunday"},
+	}))
+	job.Schedule = "0 0 12 * * *"
+	job.Timezone = "UTC"
+	job.Calendar = "wednesdays"
+	require.NoError(t, s.SetJob(ctx, job, false))
+	sj, err := s.GetJob(ctx, job.Name, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Wednesday, sj.Next.UTC().Weekday())
+	job.Calendar = "us-banking"
+	require.NoError(t, s.SetJob(ctx, job, false))
+	_, err = s.DeleteCalendar(ctx, "wednesdays")
+	require.NoError(t, err)
+
+	// Calendars in use can't be deleted
+	_, err = s.DeleteCalendar(ctx, "us-banking")
+	assert.ErrorIs(t, err, ErrCalendarInUse)
+
+	deleteJob(t, s, job.Name)
+	deleted, err := s.DeleteCalendar(ctx, "us-banking")
+	require.NoError(t, err)
+	assert.Equal(t, cal, deleted)
+
+	_, err = s.GetCalendar(ctx, "us-banking")
+	assert.ErrorIs(t, err, buntdb.ErrNotFound)
+}
+
+func TestStore_JobTemplates(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	// Jobs can't use a template that doesn't exist
+	job := scaffoldJob()
+	job.Template = "batch"
+	job.Executor = ""
+	job.ExecutorConfig = map[string]string{"command": "/bin/true"}
+	assert.ErrorIs(t, s.SetJob(ctx, job, false), ErrJobTemplateNotFound)
+
+	tmpl := &JobTemplate{
+		Name:           "batch",
+		Timezone:       "Europe/Berlin",
+		Tags:           map[string]string{"role": "worker:1"},
+		Retries:        3,
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"shell": "true"},
+	}
+	require.NoError(t, s.SetJobTemplate(ctx, tmpl))
+	require.Error(t, s.SetJobTemplate(ctx, &JobTemplate{Name: "bad", Timezone: "Nowhere/Land"}))
+
+	stored, err := s.GetJobTemplate(ctx, "batch")
+	require.NoError(t, err)
+	assert.Equal(t, tmpl, stored)
+
+	templates, err := s.GetJobTemplates(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*JobTemplate{tmpl}, templates)
+
+	require.NoError(t, s.SetJob(ctx, job, false))
+	// The job of the caller is not resolved
+	assert.Equal(t, "", job.Executor)
+	assert.Equal(t, uint(0), job.Retries)
+
+	// Jobs are returned resolved
+	resolved := loadJob(t, s, job.Name)
+	assert.Equal(t, "Europe/Berlin", resolved.Timezone)
+	assert.Equal(t, uint(3), resolved.Retries)
+	assert.Equal(t, "shell", resolved.Executor)
+	assert.Equal(t, plugin.ExecutorPluginConfig{"shell": "true", "command": "/bin/true"}, resolved.ExecutorConfig)
+
+	jobs, err := s.GetJobs(ctx, &JobOptions{Metadata: nil, Sort: "name"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "Europe/Berlin", jobs[0].Timezone)
+
+	// Storing the resolved job back keeps inheriting, so template changes
+	// apply to the job
+	require.NoError(t, s.SetJob(ctx, resolved, false))
+	tmpl.Retries = 5
+	tmpl.Tags = map[string]string{"role": "batch:1"}
+	require.NoError(t, s.SetJobTemplate(ctx, tmpl))
+	resolved = loadJob(t, s, job.Name)
+	assert.Equal(t, uint(5), resolved.Retries)
+	assert.Equal(t, map[string]string{"role": "batch:1"}, resolved.Tags)
+
+	// Jobs can override inherited fields with their empty value
+	resolved.Retries = 0
+	resolved.Tags = nil
+	resolved.Overrides = []string{"retries", "tags"}
+	require.NoError(t, s.SetJob(ctx, resolved, false))
+	resolved = loadJob(t, s, job.Name)
+	assert.Equal(t, uint(0), resolved.Retries)
+	assert.Empty(t, resolved.Tags)
+	assert.Equal(t, "Europe/Berlin", resolved.Timezone)
+
+	// Jobs whose template can't be read are not returned unresolved
+	require.NoError(t, s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(templatesPrefix + ":batch")
+		return err
+	}))
+	_, err = s.GetJob(ctx, job.Name, nil)
+	assert.ErrorIs(t, err, ErrJobTemplateNotFound)
+	require.NoError(t, s.SetJobTemplate(ctx, tmpl))
+
+	// Templates in use can't be deleted
+	_, err = s.DeleteJobTemplate(ctx, "batch")
+	assert.ErrorIs(t, err, ErrJobTemplateInUse)
+
+	deleteJob(t, s, job.Name)
+	deleted, err := s.DeleteJobTemplate(ctx, "batch")
+	require.NoError(t, err)
+	assert.Equal(t, tmpl, deleted)
+
+	_, err = s.GetJobTemplate(ctx, "batch")
+	assert.ErrorIs(t, err, buntdb.ErrNotFound)
+}
+
+func TestStore_Namespaces(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	// Jobs of other namespaces need their namespace to exist
+	job := scaffoldJob()
+	job.Namespace = "team-a"
+	assert.ErrorIs(t, s.SetJob(ctx, job, false), ErrNamespaceNotFound)
+
+	ns := &Namespace{
+		Name:                    "team-a",
+		Defaults:                &JobTemplate{OwnerEmail: "team-a@example.com"},
+		MaxJobs:                 2,
+		MaxConcurrentExecutions: 2,
+	}
+	require.NoError(t, s.SetNamespace(ctx, ns))
+	require.Error(t, s.SetNamespace(ctx, &Namespace{Name: "bad", MaxJobs: -1}))
+
+	stored, err := s.GetNamespace(ctx, "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, ns, stored)
+
+	namespaces, err := s.GetNamespaces(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*Namespace{ns}, namespaces)
+
+	// Job names are unique per namespace
+	require.NoError(t, s.SetJob(ctx, scaffoldJob(), false))
+	require.NoError(t, s.SetJob(ctx, job, false))
+	// The job of the caller is left as is
+	assert.Equal(t, "test", job.Name)
+
+	defaultJob := loadJob(t, s, "test")
+	assert.Equal(t, DefaultNamespace, defaultJob.Namespace)
+	assert.Empty(t, defaultJob.OwnerEmail)
+
+	// The namespace defaults are set on its jobs
+	nsJob := loadJob(t, s, "team-a/test")
+	assert.Equal(t, "team-a", nsJob.Namespace)
+	assert.Equal(t, "team-a@example.com", nsJob.OwnerEmail)
+	assert.Equal(t, int64(1), nsJob.Version)
+
+	// They are resolved when reading the jobs, changing them changes the
+	// jobs without new versions, even once the jobs are stored again
+	ns.Defaults.OwnerEmail = "a-team@example.com"
+	require.NoError(t, s.SetNamespace(ctx, ns))
+	nsJob = loadJob(t, s, "team-a/test")
+	assert.Equal(t, "a-team@example.com", nsJob.OwnerEmail)
+	nsJob.SuccessCount = 1
+	require.NoError(t, s.SetJob(ctx, nsJob, false))
+	nsJob = loadJob(t, s, "team-a/test")
+	assert.Equal(t, int64(1), nsJob.Version)
+	ns.Defaults.OwnerEmail = "team-a@example.com"
+	require.NoError(t, s.SetNamespace(ctx, ns))
+	assert.Equal(t, "team-a@example.com", loadJob(t, s, "team-a/test").OwnerEmail)
+
+	jobs, err := s.GetJobs(ctx, &JobOptions{Sort: "name", Namespace: "team-a"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "team-a/test", jobs[0].Name)
+
+	jobs, err = s.GetJobs(ctx, &JobOptions{Sort: "name", Namespace: DefaultNamespace})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "test", jobs[0].Name)
+
+	// The max jobs are enforced on the new jobs
+	over := scaffoldJob()
+	over.Name = "over"
+	over.Namespace = "team-a"
+	require.NoError(t, s.SetJob(ctx, over, false))
+
+	third := scaffoldJob()
+	third.Name = "third"
+	third.Namespace = "team-a"
+	assert.ErrorIs(t, s.SetJob(ctx, third, false), ErrNamespaceMaxJobs)
+
+	// Lowering the max jobs doesn't prevent updating the existing jobs
+	ns.MaxJobs = 1
+	require.NoError(t, s.SetNamespace(ctx, ns))
+	nsJob = loadJob(t, s, "team-a/test")
+	nsJob.Schedule = "@every 2m"
+	require.NoError(t, s.SetJob(ctx, nsJob, false))
+
+	// The running executions are counted across the jobs of the namespace
+	for _, name := range []string{"team-a/test", "team-a/over", "test"} {
+		ex := NewExecution(name, TriggerCron)
+		ex.StartedAt = time.Now()
+		_, err := s.SetExecution(ctx, ex)
+		require.NoError(t, err)
+	}
+	finished := NewExecution("team-a/test", TriggerCron)
+	finished.StartedAt = time.Now()
+	finished.FinishedAt = time.Now()
+	finished.NodeName = "other"
+	_, err = s.SetExecution(ctx, finished)
+	require.NoError(t, err)
+	running, err := s.GetNamespaceRunningExecutions(ctx, "team-a")
+	require.NoError(t, err)
+	assert.Len(t, running, 2)
+	running, err = s.GetNamespaceRunningExecutions(ctx, DefaultNamespace)
+	require.NoError(t, err)
+	assert.Len(t, running, 1)
+
+	// Namespaces with jobs can't be deleted
+	_, err = s.DeleteNamespace(ctx, "team-a")
+	assert.ErrorIs(t, err, ErrNamespaceInUse)
+
+	deleteJob(t, s, "team-a/test")
+	deleteJob(t, s, "team-a/over")
+	deleted, err := s.DeleteNamespace(ctx, "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, ns, deleted)
+
+	_, err = s.GetNamespace(ctx, "team-a")
+	assert.ErrorIs(t, err, buntdb.ErrNotFound)
+}
+
+func TestStore_JobVersions(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	job := scaffoldJob()
+	job.UpdatedBy = "alice"
+	job.Revision = 5
+	require.NoError(t, s.SetJob(ctx, job, false))
+
+	// Changes to the definition are recorded as new versions
+	job = loadJob(t, s, job.Name)
+	assert.Equal(t, int64(1), job.Version)
+	assert.Equal(t, uint64(5), job.Revision)
+	assert.Equal(t, "alice", job.UpdatedBy)
+	job.ExecutorConfig = map[string]string{"command": "/bin/true"}
+	job.UpdatedBy = "bob"
+	job.UpdatedAt = time.Now()
+	job.Revision = 8
+	require.NoError(t, s.SetJob(ctx, job, false))
+
+	// The state of the job is not part of the definition
+	job = loadJob(t, s, job.Name)
+	job.SuccessCount = 10
+	job.UpdatedBy = "scheduler"
+	job.Revision = 13
+	require.NoError(t, s.SetJob(ctx, job, false))
+
+	job = loadJob(t, s, job.Name)
+	assert.Equal(t, int64(2), job.Version)
+	assert.Equal(t, uint64(8), job.Revision)
+	assert.Equal(t, "bob", job.UpdatedBy)
+	assert.Equal(t, 10, job.SuccessCount)
+
+	versions, err := s.GetJobVersions(ctx, job.Name)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int64(1), versions[0].Version)
+	assert.Equal(t, "alice", versions[0].Actor)
+	assert.Equal(t, "/bin/false", versions[0].Job.ExecutorConfig["command"])
+	assert.Equal(t, "bob", versions[1].Actor)
+	assert.Equal(t, job.UpdatedAt.Unix(), versions[1].CreatedAt.Unix())
+
+	changes, err := diffJobs(versions[0].Job, versions[1].Job)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "executor_config", changes[0].Field)
+	assert.JSONEq(t, `{"command":"/bin/false"}`, string(changes[0].From))
+	assert.JSONEq(t, `{"command":"/bin/true"}`, string(changes[0].To))
+
+	v, err := s.GetJobVersion(ctx, job.Name, 1)
+	require.NoError(t, err)
+	assert.Equal(t, versions[0], v)
+
+	// Only the last versions are kept
+	for i := 0; i < MaxJobVersions; i++ {
+		job.ExecutorConfig = map[string]string{"command": fmt.Sprintf("echo %d", i)}
+		job.UpdatedAt = time.Now()
+		require.NoError(t, s.SetJob(ctx, job, false))
+	}
+	versions, err = s.GetJobVersions(ctx, job.Name)
+	require.NoError(t, err)
+	require.Len(t, versions, MaxJobVersions)
+	assert.Equal(t, int64(3), versions[0].Version)
+	assert.Equal(t, int64(MaxJobVersions+2), versions[MaxJobVersions-1].Version)
+
+	// Versions are deleted with the job
+	deleteJob(t, s, job.Name)
+	versions, err = s.GetJobVersions(ctx, job.Name)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+	_, err = s.GetJobVersion(ctx, job.Name, 1)
+	assert.ErrorIs(t, err, buntdb.ErrNotFound)
+}
+
+func TestStore_JobUpdatedAt(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	// The time of the change comes with the job
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	job := scaffoldJob()
+	job.UpdatedAt = created
+	require.NoError(t, s.SetJob(ctx, job, false))
+	assert.Equal(t, created, loadJob(t, s, job.Name).UpdatedAt.UTC())
+
+	// Only changes to the definition update it
+	job = loadJob(t, s, job.Name)
+	job.SuccessCount = 3
+	job.UpdatedAt = created.Add(time.Hour)
+	require.NoError(t, s.SetJob(ctx, job, false))
+	assert.Equal(t, created, loadJob(t, s, job.Name).UpdatedAt.UTC())
+	assert.Equal(t, int64(1), loadJob(t, s, job.Name).Version)
+
+	job.Schedule = "@every 2m"
+	require.NoError(t, s.SetJob(ctx, job, false))
+	assert.Equal(t, created.Add(time.Hour), loadJob(t, s, job.Name).UpdatedAt.UTC())
+	assert.Equal(t, int64(2), loadJob(t, s, job.Name).Version)
+}
+
+func TestStore_MaintenanceWindows(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	window := &MaintenanceWindow{
+		Name:     "nightly",
+		Schedule: "0 0 2 * * *",
+		Duration: "1h",
+		Selector: map[string]string{"team": "billing"},
+		Policy:   WindowDefer,
+	}
+	window.StartsAt.Set(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, s.SetMaintenanceWindow(ctx, window))
+	require.Error(t, s.SetMaintenanceWindow(ctx, &MaintenanceWindow{Name: "bad"}))
+
+	stored, err := s.GetMaintenanceWindow(ctx, "nightly")
+	require.NoError(t, err)
+	assert.Equal(t, window, stored)
+
+	windows, err := s.GetMaintenanceWindows(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*MaintenanceWindow{window}, windows)
+
+	deleted, err := s.DeleteMaintenanceWindow(ctx, "nightly")
+	require.NoError(t, err)
+	assert.Equal(t, window, deleted)
+
+	_, err = s.DeleteMaintenanceWindow(ctx, "nightly")
+	assert.ErrorIs(t, err, buntdb.ErrNotFound)
+}
+
+func TestStore_Suppressions(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	storeJob(t, s, "test")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < maxSuppressions+5; i++ {
+		require.NoError(t, s.SetSuppression(ctx, &Suppression{
+			JobName:      "test",
+			Window:       "nightly",
+			Policy:       WindowSkip,
+			Trigger:      TriggerCron,
+			ScheduledAt:  start.Add(time.Duration(i) * time.Minute),
+			SuppressedAt: start.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	// The oldest ones are pruned
+	suppressions, err := s.GetSuppressions(ctx, "test")
+	require.NoError(t, err)
+	require.Len(t, suppressions, maxSuppressions)
+	assert.Equal(t, start.Add(5*time.Minute), suppressions[0].SuppressedAt)
+
+	all, err := s.GetSuppressions(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, all, maxSuppressions)
+
+	// Deleted along with the job
+	deleteJob(t, s, "test")
+	suppressions, err = s.GetSuppressions(ctx, "test")
+	require.NoError(t, err)
+	assert.Empty(t, suppressions)
+}
+
+func TestStore_Pauses(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	expired := &Pause{ID: "expired", Reason: "incident", CreatedAt: now.Add(-2 * time.Hour)}
+	expired.ExpiresAt.Set(now.Add(-time.Hour))
+	require.NoError(t, s.SetPause(ctx, expired))
+
+	pauses, err := s.GetPauses(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pauses)
+
+	// Expired pauses are pruned when a pause is created
+	scoped := &Pause{ID: "scoped", Actor: "oncall", Selector: map[string]string{"team": "billing"}, CreatedAt: now}
+	scoped.ExpiresAt.Set(now.Add(time.Hour))
+	require.NoError(t, s.SetPause(ctx, scoped))
+	require.NoError(t, s.SetPause(ctx, &Pause{ID: "all", CreatedAt: now.Add(time.Second)}))
+	_, err = s.DeletePause(ctx, "expired")
+	assert.ErrorIs(t, err, buntdb.ErrNotFound)
+	_, err = s.DeletePause(ctx, "*")
+	assert.ErrorIs(t, err, ErrWrongPauseID)
+
+	pauses, err = s.GetPauses(ctx)
+	require.NoError(t, err)
+	require.Len(t, pauses, 2)
+	assert.Equal(t, "scoped", pauses[0].ID)
+	assert.Equal(t, "oncall", pauses[0].Actor)
+	assert.Equal(t, map[string]string{"team": "billing"}, pauses[0].Selector)
+	assert.True(t, pauses[0].ExpiresAt.HasValue())
+	assert.False(t, pauses[1].ExpiresAt.HasValue())
+
+	lifted, err := s.DeletePause(ctx, "scoped")
+	require.NoError(t, err)
+	require.Len(t, lifted, 1)
+	assert.Equal(t, "scoped", lifted[0].ID)
+
+	// An empty id lifts all the pauses
+	lifted, err = s.DeletePause(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, lifted, 1)
+	pauses, err = s.GetPauses(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pauses)
+}
+
+func TestStore_WebhookTriggers(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	assert.Error(t, s.SetWebhookTrigger(ctx, &WebhookTrigger{ID: "deploy", JobName: "job1"}))
+	require.NoError(t, s.SetWebhookTrigger(ctx, &WebhookTrigger{ID: "deploy", JobName: "job1", Secret: "s3cr3t"}))
+	require.NoError(t, s.SetWebhookTrigger(ctx, &WebhookTrigger{ID: "build", JobName: "job2", Secret: "s3cr3t", Auth: WebhookAuthHMAC}))
+
+	trigger, err := s.GetWebhookTrigger(ctx, "deploy")
+	require.NoError(t, err)
+	assert.Equal(t, "job1", trigger.JobName)
+	assert.Equal(t, "s3cr3t", trigger.Secret)
+
+	triggers, err := s.GetWebhookTriggers(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, triggers, 2)
+	assert.Equal(t, "build", triggers[0].ID)
+
+	triggers, err = s.GetWebhookTriggers(ctx, "job1")
+	require.NoError(t, err)
+	require.Len(t, triggers, 1)
+	assert.Equal(t, "deploy", triggers[0].ID)
+
+	deleted, err := s.DeleteWebhookTrigger(ctx, "deploy")
+	require.NoError(t, err)
+	assert.Equal(t, "deploy", deleted.ID)
+	_, err = s.DeleteWebhookTrigger(ctx, "deploy")
+	assert.ErrorIs(t, err, buntdb.ErrNotFound)
+}
+
 func TestStore_GetJobsWithMetadata(t *testing.T) {
 	s := setupStore(t)
 
@@ -415,6 +1188,13 @@ func storeChildJob(t *testing.T, s *Store, jobName string, parentName string) {
 	require.NoError(t, s.SetJob(context.Background(), job, false))
 }
 
+func storeChildJobWithParents(t *testing.T, s *Store, jobName string, parentNames ...string) {
+	job := scaffoldJob()
+	job.Name = jobName
+	job.ParentJobs = parentNames
+	require.NoError(t, s.SetJob(context.Background(), job, false))
+}
+
 func scaffoldJob() *Job {
 	return &Job{
 		Name:           "test",
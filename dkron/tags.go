@@ -1,6 +1,10 @@
 package dkron
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -53,3 +57,131 @@ func nodeMatchesTags(node serf.Member, tags map[string]string) bool {
 	// If we matched all key:value pairs, the node matches the tags
 	return true
 }
+
+// ErrWrongConstraint is returned when a tag constraint expression can't be parsed.
+var ErrWrongConstraint = errors.New("invalid tag constraint")
+
+var (
+	constraintExistsRegexp = regexp.MustCompile(`^\s*([^\s]+)\s+(exists|not\s+exists)\s*$`)
+	constraintInRegexp     = regexp.MustCompile(`^\s*([^\s]+)\s+(in|not\s+in)\s*\((.*)\)\s*$`)
+	constraintOpRegexp     = regexp.MustCompile(`^\s*([^\s!=<>~]+)\s*(==|!=|~=|>=|<=|>|<)\s*(.*?)\s*$`)
+)
+
+// tagConstraint is a parsed tag constraint expression, like "os != windows",
+// "zone in (a,b)", "gpu exists", "version ~= ^2\." or "mem_gb >= 16".
+type tagConstraint struct {
+	key    string
+	op     string
+	values []string
+	re     *regexp.Regexp
+	num    float64
+}
+
+// parseTagConstraint parses a tag constraint expression.
+func parseTagConstraint(expr string) (*tagConstraint, error) {
+	if m := constraintExistsRegexp.FindStringSubmatch(expr); m != nil {
+		return &tagConstraint{key: m[1], op: strings.Join(strings.Fields(m[2]), " ")}, nil
+	}
+
+	if m := constraintInRegexp.FindStringSubmatch(expr); m != nil {
+		c := &tagConstraint{key: m[1], op: strings.Join(strings.Fields(m[2]), " ")}
+		for _, v := range strings.Split(m[3], ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				c.values = append(c.values, v)
+			}
+		}
+		if len(c.values) == 0 {
+			return nil, fmt.Errorf("%w: %q has no values", ErrWrongConstraint, expr)
+		}
+		return c, nil
+	}
+
+	m := constraintOpRegexp.FindStringSubmatch(expr)
+	if m == nil || m[3] == "" {
+		return nil, fmt.Errorf("%w: %q", ErrWrongConstraint, expr)
+	}
+	c := &tagConstraint{key: m[1], op: m[2], values: []string{m[3]}}
+	switch c.op {
+	case "~=":
+		re, err := regexp.Compile(m[3])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %s", ErrWrongConstraint, expr, err)
+		}
+		c.re = re
+	case ">", ">=", "<", "<=":
+		num, err := strconv.ParseFloat(m[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q compares with a non numeric value", ErrWrongConstraint, expr)
+		}
+		c.num = num
+	}
+	return c, nil
+}
+
+// parseTagConstraints parses a list of tag constraint expressions.
+func parseTagConstraints(exprs []string) ([]*tagConstraint, error) {
+	constraints := make([]*tagConstraint, 0, len(exprs))
+	for _, expr := range exprs {
+		c, err := parseTagConstraint(expr)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, c)
+	}
+	return constraints, nil
+}
+
+// matches tests if a node satisfies the constraint
+func (c *tagConstraint) matches(node serf.Member) bool {
+	nodeVal, present := node.Tags[c.key]
+
+	switch c.op {
+	case "exists":
+		return present
+	case "not exists":
+		return !present
+	case "!=":
+		return !present || nodeVal != c.values[0]
+	case "not in":
+		return !present || !slices.Contains(c.values, nodeVal)
+	}
+
+	if !present {
+		return false
+	}
+	switch c.op {
+	case "==":
+		return nodeVal == c.values[0]
+	case "in":
+		return slices.Contains(c.values, nodeVal)
+	case "~=":
+		return c.re.MatchString(nodeVal)
+	}
+
+	// Numeric comparisons, nodes with non numeric values don't match
+	num, err := strconv.ParseFloat(nodeVal, 64)
+	if err != nil {
+		return false
+	}
+	switch c.op {
+	case ">":
+		return num > c.num
+	case ">=":
+		return num >= c.num
+	case "<":
+		return num < c.num
+	case "<=":
+		return num <= c.num
+	}
+	return false
+}
+
+// nodeMatchesConstraints tests if a node satisfies all of the provided constraints
+func nodeMatchesConstraints(node serf.Member, constraints []*tagConstraint) bool {
+	for _, c := range constraints {
+		if !c.matches(node) {
+			return false
+		}
+	}
+	return true
+}
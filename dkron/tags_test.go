@@ -3,6 +3,10 @@ package dkron
 import (
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/serf/serf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_cleanTags(t *testing.T) {
@@ -57,3 +61,76 @@ func Test_cleanTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseTagConstraint(t *testing.T) {
+	for _, expr := range []string{
+		"os != windows",
+		"os==linux",
+		"zone in (a, b)",
+		"zone not in (c)",
+		"gpu exists",
+		"gpu not exists",
+		`version ~= ^2\.`,
+		"mem_gb >= 16",
+		"cpus<4",
+	} {
+		_, err := parseTagConstraint(expr)
+		assert.NoError(t, err, expr)
+	}
+
+	for _, expr := range []string{
+		"os",
+		"os windows",
+		"os !=",
+		"zone in ()",
+		"version ~= (",
+		"mem_gb >= lots",
+	} {
+		_, err := parseTagConstraint(expr)
+		assert.ErrorIs(t, err, ErrWrongConstraint, expr)
+	}
+}
+
+func Test_nodeMatchesConstraints(t *testing.T) {
+	node := serf.Member{
+		Tags: map[string]string{
+			"os":      "linux",
+			"zone":    "b",
+			"version": "2.4.1",
+			"mem_gb":  "32",
+			"gpu":     "",
+		},
+	}
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"os == linux", true},
+		{"os != windows", true},
+		{"os != linux", false},
+		{"arch != arm", true},
+		{"zone in (a,b)", true},
+		{"zone in (a,c)", false},
+		{"zone not in (a,c)", true},
+		{"gpu exists", true},
+		{"tpu exists", false},
+		{"tpu not exists", true},
+		{`version ~= ^2\.`, true},
+		{`version ~= ^3\.`, false},
+		{"mem_gb >= 16", true},
+		{"mem_gb > 32", false},
+		{"mem_gb <= 32", true},
+		{"mem_gb < 8", false},
+		{"os > 1", false},
+		{"cpus > 1", false},
+	}
+	for _, tt := range tests {
+		constraints, err := parseTagConstraints([]string{tt.expr})
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, nodeMatchesConstraints(node, constraints), tt.expr)
+	}
+
+	constraints, err := parseTagConstraints([]string{"os == linux", "mem_gb >= 64"})
+	require.NoError(t, err)
+	assert.False(t, nodeMatchesConstraints(node, constraints))
+}
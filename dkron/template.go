@@ -0,0 +1,340 @@
+package dkron
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/distribworks/dkron/v4/plugin"
+	"github.com/tidwall/buntdb"
+)
+
+var (
+	// ErrJobTemplateNotFound is returned when the template of a job is not found.
+	ErrJobTemplateNotFound = errors.New("specified job template not found")
+	// ErrJobTemplateInUse is returned when deleting a job template used by some jobs.
+	ErrJobTemplateInUse = errors.New("the job template is used by some jobs")
+)
+
+// JobTemplate holds the defaults shared by the jobs inheriting from it. The
+// jobs only store the fields they override, so changing the template
+// changes every job inheriting from it.
+type JobTemplate struct {
+	// Template name. Must be unique, acts as the id.
+	Name string `json:"name"`
+
+	// Description of the template.
+	Description string `json:"description"`
+
+	// The timezone where the cron expression will be evaluated in.
+	Timezone string `json:"timezone"`
+
+	// Owner of the job.
+	Owner string `json:"owner"`
+
+	// Owner email of the job.
+	OwnerEmail string `json:"owner_email"`
+
+	// Tags of the target servers to run this job against.
+	Tags map[string]string `json:"tags"`
+
+	// Job metadata describes the job and allows filtering from the API.
+	Metadata map[string]string `json:"metadata"`
+
+	// Number of times to retry a job that failed an execution.
+	Retries uint `json:"retries"`
+
+	// Processors to use for this job.
+	Processors map[string]plugin.Config `json:"processors"`
+
+	// Executor plugin to be used in this job.
+	Executor string `json:"executor"`
+
+	// Configuration arguments for the specific executor.
+	ExecutorConfig plugin.ExecutorPluginConfig `json:"executor_config"`
+}
+
+// NewJobTemplateFromProto maps a proto.JobTemplate to a JobTemplate object
+func NewJobTemplateFromProto(in *proto.JobTemplate) *JobTemplate {
+	t := &JobTemplate{
+		Name:           in.Name,
+		Description:    in.Description,
+		Timezone:       in.Timezone,
+		Owner:          in.Owner,
+		OwnerEmail:     in.OwnerEmail,
+		Tags:           in.Tags,
+		Metadata:       in.Metadata,
+		Retries:        uint(in.Retries),
+		Executor:       in.Executor,
+		ExecutorConfig: in.ExecutorConfig,
+	}
+	if len(in.Processors) > 0 {
+		t.Processors = make(map[string]plugin.Config, len(in.Processors))
+		for k, v := range in.Processors {
+			t.Processors[k] = v.Config
+		}
+	}
+	return t
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current job template.
+func (t *JobTemplate) ToProto() *proto.JobTemplate {
+	pt := &proto.JobTemplate{
+		Name:           t.Name,
+		Description:    t.Description,
+		Timezone:       t.Timezone,
+		Owner:          t.Owner,
+		OwnerEmail:     t.OwnerEmail,
+		Tags:           t.Tags,
+		Metadata:       t.Metadata,
+		Retries:        uint32(t.Retries),
+		Executor:       t.Executor,
+		ExecutorConfig: t.ExecutorConfig,
+	}
+	if len(t.Processors) > 0 {
+		pt.Processors = make(map[string]*proto.PluginConfig, len(t.Processors))
+		for k, v := range t.Processors {
+			pt.Processors[k] = &proto.PluginConfig{Config: v}
+		}
+	}
+	return pt
+}
+
+// Validate validates the job template.
+func (t *JobTemplate) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if valid, chr := isSlug(t.Name); !valid {
+		return fmt.Errorf("name contains illegal character '%s'", chr)
+	}
+
+	if _, err := time.LoadLocation(t.Timezone); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// overridableFields are the fields jobs inherit from their template, by
+// their JSON name, reporting whether the job sets them to their empty value.
+var overridableFields = map[string]func(*Job) bool{
+	"timezone":        func(j *Job) bool { return j.Timezone == "" },
+	"owner":           func(j *Job) bool { return j.Owner == "" },
+	"owner_email":     func(j *Job) bool { return j.OwnerEmail == "" },
+	"retries":         func(j *Job) bool { return j.Retries == 0 },
+	"tags":            func(j *Job) bool { return len(j.Tags) == 0 },
+	"metadata":        func(j *Job) bool { return len(j.Metadata) == 0 },
+	"processors":      func(j *Job) bool { return len(j.Processors) == 0 },
+	"executor_config": func(j *Job) bool { return len(j.ExecutorConfig) == 0 },
+}
+
+// emptyOverrides returns the inherited fields set to their empty value in
+// the given JSON object, which override the template of the job.
+func emptyOverrides(job *Job, fields map[string]json.RawMessage) []string {
+	var overrides []string
+	for field, empty := range overridableFields {
+		if _, ok := fields[field]; ok && empty(job) {
+			overrides = append(overrides, field)
+		}
+	}
+	slices.Sort(overrides)
+	return overrides
+}
+
+// UnmarshalJSON decodes a job, recording the inherited fields it sets to
+// their empty value as overrides when it doesn't list its overrides.
+func (j *Job) UnmarshalJSON(data []byte) error {
+	type plain Job
+	if err := json.Unmarshal(data, (*plain)(j)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if _, ok := fields["overrides"]; !ok {
+		j.Overrides = emptyOverrides(j, fields)
+	}
+	return nil
+}
+
+// overrides reports whether the job sets the given field itself, even to
+// its empty value.
+func (j *Job) overrides(field string) bool {
+	return slices.Contains(j.Overrides, field)
+}
+
+// inheritsExecutor reports whether the job runs the executor of the
+// template, so it inherits its executor config.
+func (t *JobTemplate) inheritsExecutor(job *Job) bool {
+	return job.Executor == "" || job.Executor == t.Executor
+}
+
+// apply resolves the job, setting the fields it doesn't set to the values
+// of the template. Maps are merged, the job entries win, unless the job
+// overrides the whole map.
+func (t *JobTemplate) apply(job *Job) {
+	if job.Timezone == "" && !job.overrides("timezone") {
+		job.Timezone = t.Timezone
+	}
+	if job.Owner == "" && !job.overrides("owner") {
+		job.Owner = t.Owner
+	}
+	if job.OwnerEmail == "" && !job.overrides("owner_email") {
+		job.OwnerEmail = t.OwnerEmail
+	}
+	if job.Retries == 0 && !job.overrides("retries") {
+		job.Retries = t.Retries
+	}
+	if !job.overrides("tags") {
+		job.Tags = mergeMaps(t.Tags, job.Tags)
+	}
+	if !job.overrides("metadata") {
+		job.Metadata = mergeMaps(t.Metadata, job.Metadata)
+	}
+	if !job.overrides("processors") {
+		job.Processors = mergeMaps(t.Processors, job.Processors)
+	}
+	if t.inheritsExecutor(job) {
+		job.Executor = t.Executor
+		if !job.overrides("executor_config") {
+			job.ExecutorConfig = mergeMaps(t.ExecutorConfig, job.ExecutorConfig)
+		}
+	}
+}
+
+// with returns the template completed with the given defaults, for the
+// fields it doesn't set. Either can be nil.
+func (t *JobTemplate) with(defaults *JobTemplate) *JobTemplate {
+	if t == nil {
+		return defaults
+	}
+	if defaults == nil {
+		return t
+	}
+
+	c := *t
+	if c.Timezone == "" {
+		c.Timezone = defaults.Timezone
+	}
+	if c.Owner == "" {
+		c.Owner = defaults.Owner
+	}
+	if c.OwnerEmail == "" {
+		c.OwnerEmail = defaults.OwnerEmail
+	}
+	if c.Retries == 0 {
+		c.Retries = defaults.Retries
+	}
+	c.Tags = mergeMaps(defaults.Tags, t.Tags)
+	c.Metadata = mergeMaps(defaults.Metadata, t.Metadata)
+	c.Processors = mergeMaps(defaults.Processors, t.Processors)
+	if t.Executor == "" || t.Executor == defaults.Executor {
+		c.Executor = defaults.Executor
+		c.ExecutorConfig = mergeMaps(defaults.ExecutorConfig, t.ExecutorConfig)
+	}
+	return &c
+}
+
+// inheritedDefaults returns the defaults the job inherits, its template
+// completed with the defaults of its namespace, nil when it inherits
+// nothing. It fails with ErrJobTemplateNotFound when the template of the
+// job doesn't exist.
+func inheritedDefaults(ctx context.Context, s Storage, job *Job) (*JobTemplate, error) {
+	var template *JobTemplate
+	if job.Template != "" {
+		t, err := s.GetJobTemplate(ctx, job.Template)
+		if errors.Is(err, buntdb.ErrNotFound) {
+			return nil, ErrJobTemplateNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		template = t
+	}
+
+	namespace, _ := splitJobName(job.Name)
+	ns, err := s.GetNamespace(ctx, namespace)
+	if err != nil && !errors.Is(err, buntdb.ErrNotFound) {
+		return nil, err
+	}
+	if ns != nil {
+		return template.with(ns.Defaults), nil
+	}
+	return template, nil
+}
+
+// strip returns a copy of the job without the values it has from the
+// template, to store only its overrides. Fields set to the value of the
+// template keep following the template, unless the job overrides them.
+func (t *JobTemplate) strip(job *Job) *Job {
+	s := *job
+	if s.Timezone == t.Timezone && !job.overrides("timezone") {
+		s.Timezone = ""
+	}
+	if s.Owner == t.Owner && !job.overrides("owner") {
+		s.Owner = ""
+	}
+	if s.OwnerEmail == t.OwnerEmail && !job.overrides("owner_email") {
+		s.OwnerEmail = ""
+	}
+	if s.Retries == t.Retries && !job.overrides("retries") {
+		s.Retries = 0
+	}
+	if !job.overrides("tags") {
+		s.Tags = stripMap(t.Tags, job.Tags)
+	}
+	if !job.overrides("metadata") {
+		s.Metadata = stripMap(t.Metadata, job.Metadata)
+	}
+	if !job.overrides("processors") {
+		s.Processors = stripMap(t.Processors, job.Processors)
+	}
+	if t.inheritsExecutor(job) {
+		s.Executor = ""
+		if !job.overrides("executor_config") {
+			s.ExecutorConfig = stripMap(t.ExecutorConfig, job.ExecutorConfig)
+		}
+	}
+	return &s
+}
+
+// mergeMaps returns the entries of the defaults overridden by the ones of
+// the values.
+func mergeMaps[V any, M ~map[string]V](defaults, values M) M {
+	if len(defaults) == 0 {
+		return values
+	}
+	merged := make(M, len(defaults)+len(values))
+	maps.Copy(merged, defaults)
+	maps.Copy(merged, values)
+	return merged
+}
+
+// stripMap returns the entries of the values that differ from the defaults.
+func stripMap[V any, M ~map[string]V](defaults, values M) M {
+	if len(defaults) == 0 {
+		return values
+	}
+	var stripped M
+	for k, v := range values {
+		// Values are compared printed, so nil and empty configs are equal
+		if d, ok := defaults[k]; ok && fmt.Sprint(d) == fmt.Sprint(v) {
+			continue
+		}
+		if stripped == nil {
+			stripped = make(M)
+		}
+		stripped[k] = v
+	}
+	return stripped
+}
@@ -0,0 +1,91 @@
+package dkron
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/distribworks/dkron/v4/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobTemplateApplyStrip(t *testing.T) {
+	tmpl := &JobTemplate{
+		Name:           "batch",
+		Timezone:       "Europe/Berlin",
+		OwnerEmail:     "platform@example.com",
+		Tags:           map[string]string{"role": "worker:1"},
+		Retries:        3,
+		Processors:     map[string]plugin.Config{"log": {}},
+		Executor:       "shell",
+		ExecutorConfig: map[string]string{"shell": "true", "timeout": "1h"},
+	}
+
+	job := &Job{
+		Name:           "report",
+		Schedule:       "@daily",
+		Template:       "batch",
+		Tags:           map[string]string{"region": "eu:1"},
+		ExecutorConfig: map[string]string{"command": "report.sh", "timeout": "2h"},
+	}
+	tmpl.apply(job)
+
+	assert.Equal(t, "Europe/Berlin", job.Timezone)
+	assert.Equal(t, "platform@example.com", job.OwnerEmail)
+	assert.Equal(t, uint(3), job.Retries)
+	assert.Equal(t, map[string]string{"role": "worker:1", "region": "eu:1"}, job.Tags)
+	assert.Equal(t, "shell", job.Executor)
+	assert.Equal(t, plugin.ExecutorPluginConfig{"shell": "true", "command": "report.sh", "timeout": "2h"}, job.ExecutorConfig)
+	assert.Contains(t, job.Processors, "log")
+
+	// Only the overrides are kept
+	stored := tmpl.strip(job)
+	assert.Equal(t, "", stored.Timezone)
+	assert.Equal(t, "", stored.OwnerEmail)
+	assert.Equal(t, uint(0), stored.Retries)
+	assert.Equal(t, map[string]string{"region": "eu:1"}, stored.Tags)
+	assert.Equal(t, "", stored.Executor)
+	assert.Equal(t, plugin.ExecutorPluginConfig{"command": "report.sh", "timeout": "2h"}, stored.ExecutorConfig)
+	assert.Empty(t, stored.Processors)
+	assert.Equal(t, "Europe/Berlin", job.Timezone)
+
+	// Jobs with their own executor don't inherit the executor config
+	job = &Job{Name: "ping", Template: "batch", Executor: "http", ExecutorConfig: map[string]string{"url": "http://localhost"}}
+	tmpl.apply(job)
+	assert.Equal(t, "http", job.Executor)
+	assert.Equal(t, plugin.ExecutorPluginConfig{"url": "http://localhost"}, job.ExecutorConfig)
+	assert.Equal(t, "http", tmpl.strip(job).Executor)
+
+	// Overridden fields are kept even when empty, and maps are replaced
+	job = &Job{
+		Name:      "quiet",
+		Template:  "batch",
+		Retries:   0,
+		Tags:      map[string]string{"region": "eu:1"},
+		Overrides: []string{"retries", "tags", "timezone"},
+		Timezone:  "Europe/Berlin",
+	}
+	tmpl.apply(job)
+	assert.Equal(t, uint(0), job.Retries)
+	assert.Equal(t, map[string]string{"region": "eu:1"}, job.Tags)
+	stored = tmpl.strip(job)
+	assert.Equal(t, uint(0), stored.Retries)
+	assert.Equal(t, map[string]string{"region": "eu:1"}, stored.Tags)
+	assert.Equal(t, "Europe/Berlin", stored.Timezone)
+	assert.Equal(t, "", stored.OwnerEmail)
+}
+
+func TestJobUnmarshalOverrides(t *testing.T) {
+	var job Job
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"quiet","schedule":"@daily","retries":0,"tags":{},"owner":"me","timezone":""}`), &job))
+	assert.Equal(t, []string{"retries", "tags", "timezone"}, job.Overrides)
+	assert.NoError(t, job.Validate())
+
+	// Listed overrides are kept as they are
+	job = Job{}
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"quiet","retries":0,"overrides":["owner"]}`), &job))
+	assert.Equal(t, []string{"owner"}, job.Overrides)
+
+	job.Overrides = []string{"schedule"}
+	assert.Error(t, job.Validate())
+}
@@ -3,6 +3,7 @@ package dkron
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 
 	version "github.com/hashicorp/go-version"
@@ -20,6 +21,16 @@ func (a int64arr) Len() int           { return len(a) }
 func (a int64arr) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a int64arr) Less(i, j int) bool { return a[i] < a[j] }
 
+// containsAll returns whether all the given items are present in set
+func containsAll(set []string, items []string) bool {
+	for _, item := range items {
+		if !slices.Contains(set, item) {
+			return false
+		}
+	}
+	return true
+}
+
 // ServerParts is used to return the parts of a server role
 type ServerParts struct {
 	Name         string
@@ -0,0 +1,165 @@
+package dkron
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	proto "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+)
+
+const (
+	// WebhookAuthToken authenticates the webhook calls passing the secret
+	// of the trigger in a header.
+	WebhookAuthToken = "token"
+	// WebhookAuthHMAC authenticates the webhook calls signing the body with
+	// the secret of the trigger, using HMAC-SHA256.
+	WebhookAuthHMAC = "hmac-sha256"
+
+	// defaultTokenHeader is the header carrying the secret of token triggers.
+	defaultTokenHeader = "X-Dkron-Webhook-Token"
+	// defaultSignatureHeader is the header carrying the signature of HMAC
+	// triggers, the one used by GitHub.
+	defaultSignatureHeader = "X-Hub-Signature-256"
+
+	// webhookParamPrefix prefixes the parameters holding the webhook request.
+	webhookParamPrefix = "webhook_"
+
+	// maxWebhookBody is the maximum size of the body of a webhook call.
+	maxWebhookBody = 1 << 20
+)
+
+// ErrWrongWebhookAuth is returned when the webhook auth is set to a non existing setting.
+var ErrWrongWebhookAuth = errors.New("invalid webhook auth value, use \"token\" or \"hmac-sha256\"")
+
+// WebhookTrigger runs a job when its webhook is called with its secret.
+type WebhookTrigger struct {
+	// Trigger id, the webhook is /v1/hooks/:id. Must be unique.
+	ID string `json:"id"`
+
+	// Name of the job run by the trigger.
+	JobName string `json:"job_name"`
+
+	// Secret authenticating the webhook calls.
+	Secret string `json:"secret,omitempty"`
+
+	// How the calls are authenticated token/hmac-sha256.
+	Auth string `json:"auth"`
+
+	// Header carrying the secret or the signature of the calls.
+	SignatureHeader string `json:"signature_header"`
+}
+
+// NewWebhookTriggerFromProto maps a proto.WebhookTrigger to a WebhookTrigger object
+func NewWebhookTriggerFromProto(in *proto.WebhookTrigger) *WebhookTrigger {
+	return &WebhookTrigger{
+		ID:              in.Id,
+		JobName:         in.JobName,
+		Secret:          in.Secret,
+		Auth:            in.Auth,
+		SignatureHeader: in.SignatureHeader,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to
+// the representation of the current webhook trigger.
+func (w *WebhookTrigger) ToProto() *proto.WebhookTrigger {
+	return &proto.WebhookTrigger{
+		Id:              w.ID,
+		JobName:         w.JobName,
+		Secret:          w.Secret,
+		Auth:            w.Auth,
+		SignatureHeader: w.SignatureHeader,
+	}
+}
+
+// Validate validates the webhook trigger.
+func (w *WebhookTrigger) Validate() error {
+	if w.ID == "" {
+		return fmt.Errorf("id cannot be empty")
+	}
+
+	if valid, chr := isSlug(w.ID); !valid {
+		return fmt.Errorf("id contains illegal character '%s'", chr)
+	}
+
+	if w.JobName == "" {
+		return fmt.Errorf("job_name cannot be empty")
+	}
+
+	if w.Secret == "" {
+		return fmt.Errorf("secret cannot be empty")
+	}
+
+	if w.Auth != "" && w.Auth != WebhookAuthToken && w.Auth != WebhookAuthHMAC {
+		return ErrWrongWebhookAuth
+	}
+
+	return nil
+}
+
+// redacted returns a copy of the trigger without its secret.
+func (w *WebhookTrigger) redacted() *WebhookTrigger {
+	r := *w
+	r.Secret = ""
+	return &r
+}
+
+// header returns the header carrying the secret or the signature of the calls.
+func (w *WebhookTrigger) header() string {
+	if w.SignatureHeader != "" {
+		return w.SignatureHeader
+	}
+	if w.Auth == WebhookAuthHMAC {
+		return defaultSignatureHeader
+	}
+	return defaultTokenHeader
+}
+
+// authenticate reports whether a call with the given headers and body
+// carries the secret of the trigger, or a valid signature of the body.
+func (w *WebhookTrigger) authenticate(header http.Header, body []byte) bool {
+	value := header.Get(w.header())
+	if value == "" {
+		return false
+	}
+
+	if w.Auth != WebhookAuthHMAC {
+		return subtle.ConstantTimeCompare([]byte(value), []byte(w.Secret)) == 1
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(value, "sha256="))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	mac.Write(body)
+	return hmac.Equal(signature, mac.Sum(nil))
+}
+
+// parameters returns the run parameters exposing a webhook call, the body as
+// webhook_body and every header as webhook_header_<name>, like
+// webhook_header_x_github_event. Only the parameters declared by the job are
+// returned, the headers carrying secrets are left out.
+func (w *WebhookTrigger) parameters(header http.Header, body []byte, declared map[string]*JobParameter) map[string]string {
+	params := make(map[string]string)
+	if _, ok := declared[webhookParamPrefix+"body"]; ok {
+		params[webhookParamPrefix+"body"] = string(body)
+	}
+	for name, values := range header {
+		if strings.EqualFold(name, w.header()) || strings.EqualFold(name, "Authorization") ||
+			strings.EqualFold(name, "X-Dkron-Token") {
+			continue
+		}
+		key := webhookParamPrefix + "header_" + strings.ReplaceAll(strings.ToLower(name), "-", "_")
+		if _, ok := declared[key]; ok {
+			params[key] = strings.Join(values, ", ")
+		}
+	}
+	return params
+}
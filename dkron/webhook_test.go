@@ -0,0 +1,95 @@
+package dkron
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookTriggerValidate(t *testing.T) {
+	trigger := &WebhookTrigger{ID: "deploy", JobName: "test_job", Secret: "s3cr3t"}
+	assert.NoError(t, trigger.Validate())
+
+	trigger.Auth = "basic"
+	assert.ErrorIs(t, trigger.Validate(), ErrWrongWebhookAuth)
+	trigger.Auth = WebhookAuthHMAC
+	assert.NoError(t, trigger.Validate())
+
+	assert.Error(t, (&WebhookTrigger{ID: "de ploy", JobName: "test_job", Secret: "s3cr3t"}).Validate())
+	assert.Error(t, (&WebhookTrigger{ID: "deploy", Secret: "s3cr3t"}).Validate())
+	assert.Error(t, (&WebhookTrigger{ID: "deploy", JobName: "test_job"}).Validate())
+}
+
+func TestWebhookTriggerAuthenticate(t *testing.T) {
+	body := []byte(`{"ref": "refs/heads/main"}`)
+
+	token := &WebhookTrigger{ID: "deploy", Secret: "s3cr3t"}
+	assert.False(t, token.authenticate(http.Header{}, body))
+	assert.False(t, token.authenticate(http.Header{"X-Dkron-Webhook-Token": {"other"}}, body))
+	assert.True(t, token.authenticate(http.Header{"X-Dkron-Webhook-Token": {"s3cr3t"}}, body))
+
+	token.SignatureHeader = "X-Gitlab-Token"
+	assert.False(t, token.authenticate(http.Header{"X-Dkron-Webhook-Token": {"s3cr3t"}}, body))
+	assert.True(t, token.authenticate(http.Header{"X-Gitlab-Token": {"s3cr3t"}}, body))
+
+	mac := hmac.New(sha256.New, []byte("s3cr3t"))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	signed := &WebhookTrigger{ID: "deploy", Secret: "s3cr3t", Auth: WebhookAuthHMAC}
+	assert.True(t, signed.authenticate(http.Header{"X-Hub-Signature-256": {"sha256=" + signature}}, body))
+	assert.True(t, signed.authenticate(http.Header{"X-Hub-Signature-256": {signature}}, body))
+	assert.False(t, signed.authenticate(http.Header{"X-Hub-Signature-256": {"sha256=" + signature}}, []byte("tampered")))
+	assert.False(t, signed.authenticate(http.Header{"X-Hub-Signature-256": {"s3cr3t"}}, body))
+}
+
+func TestWebhookTriggerParameters(t *testing.T) {
+	trigger := &WebhookTrigger{ID: "deploy", Secret: "s3cr3t"}
+	header := http.Header{
+		"X-Github-Event":        {"push"},
+		"X-Dkron-Webhook-Token": {"s3cr3t"},
+		"Authorization":         {"Bearer s3cr3t"},
+		"X-Dkron-Token":         {"acl"},
+		"Accept":                {"text/plain", "application/json"},
+		"User-Agent":            {"GitHub-Hookshot"},
+	}
+	params := trigger.parameters(header, []byte("payload"), map[string]*JobParameter{
+		"webhook_body":                         {},
+		"webhook_header_x_github_event":        {},
+		"webhook_header_accept":                {},
+		"webhook_header_x_dkron_webhook_token": {},
+		"webhook_header_authorization":         {},
+	})
+
+	// Only the declared parameters are passed, never the secrets
+	assert.Equal(t, map[string]string{
+		"webhook_body":                  "payload",
+		"webhook_header_x_github_event": "push",
+		"webhook_header_accept":         "text/plain, application/json",
+	}, params)
+	assert.Empty(t, trigger.parameters(header, []byte("payload"), nil))
+
+	// Declared parameters are resolved, the webhook call ones are kept
+	job := &Job{Name: "test_job", Parameters: map[string]*JobParameter{
+		"mode":         {Default: "full"},
+		"webhook_body": {},
+	}}
+	params["webhook_body"] = "declared"
+	resolved, err := job.resolveEventParameters(params)
+	require.NoError(t, err)
+	assert.Equal(t, "full", resolved["mode"])
+	assert.Equal(t, "declared", resolved["webhook_body"])
+	assert.Equal(t, "push", resolved["webhook_header_x_github_event"])
+
+	_, err = job.resolveEventParameters(map[string]string{"other": "x"})
+	assert.ErrorIs(t, err, ErrUnknownParameter)
+
+	resolved, err = (&Job{Name: "test_job"}).resolveEventParameters(map[string]string{"webhook_body": "payload"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"webhook_body": "payload"}, resolved)
+}
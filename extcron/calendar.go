@@ -0,0 +1,176 @@
+package extcron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// maxCalendarDays is how many consecutive excluded days are searched for
+// the next run before giving up.
+const maxCalendarDays = 3660
+
+// dateLayout is the layout of the calendar holidays.
+const dateLayout = "2006-01-02"
+
+// Calendar excludes days from a schedule, like bank holidays or weekends.
+type Calendar struct {
+	// Location the days are evaluated in, the location of the schedule
+	// times when not set.
+	Location *time.Location
+
+	holidays map[string]bool
+	weekdays [7]bool
+}
+
+// NewCalendar creates a calendar excluding the given holidays, in
+// "2006-01-02" format, and weekdays, by name like "saturday" or "sat".
+func NewCalendar(holidays []string, weekdays []string, loc *time.Location) (*Calendar, error) {
+	c := &Calendar{
+		Location: loc,
+		holidays: make(map[string]bool, len(holidays)),
+	}
+
+	for _, h := range holidays {
+		if _, err := time.Parse(dateLayout, h); err != nil {
+			return nil, fmt.Errorf("failed to parse holiday %s: %s", h, err)
+		}
+		c.holidays[h] = true
+	}
+
+	for _, w := range weekdays {
+		d, err := parseWeekday(w)
+		if err != nil {
+			return nil, err
+		}
+		c.weekdays[d] = true
+	}
+
+	return c, nil
+}
+
+// parseWeekday parses a weekday by its name or its three letters abbreviation.
+func parseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(name)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("failed to parse weekday %s", name)
+}
+
+// Excluded reports whether the day of the given time is excluded.
+func (c *Calendar) Excluded(t time.Time) bool {
+	if c.Location != nil {
+		t = t.In(c.Location)
+	}
+	return c.weekdays[t.Weekday()] || c.holidays[t.Format(dateLayout)]
+}
+
+// CalendarSchedule wraps a schedule to skip the runs on the days excluded by
+// a calendar, or to shift them to the next day that is not excluded.
+type CalendarSchedule struct {
+	Schedule cron.Schedule
+	Calendar *Calendar
+
+	// Shift the runs of the excluded days to the same time of the next
+	// day that is not excluded, instead of skipping them. The runs shifted
+	// to the same time run once.
+	Shift bool
+}
+
+// Next returns the next run of the schedule after the given time that is not
+// in an excluded day, or the zero time if there is none.
+func (s *CalendarSchedule) Next(t time.Time) time.Time {
+	if s.Shift {
+		return s.nextShifted(t)
+	}
+
+	next := s.Schedule.Next(t)
+	for i := 0; i < maxCalendarDays && !next.IsZero() && s.Calendar.Excluded(next); i++ {
+		// Look for the next run from the end of the excluded day, the
+		// schedule is evaluated in the location of the given time
+		next = s.Schedule.Next(s.endOfDay(next).Add(-time.Nanosecond).In(t.Location()))
+	}
+	if !next.IsZero() && s.Calendar.Excluded(next) {
+		return time.Time{}
+	}
+	return next
+}
+
+// nextShifted returns the earliest time after t among the runs of the days
+// that are not excluded, and the runs of the excluded days right before them
+// shifted to the same time.
+func (s *CalendarSchedule) nextShifted(t time.Time) time.Time {
+	orig := t.Location()
+	t = s.in(t)
+
+	day := s.startOfDay(t)
+	for i := 0; i < maxCalendarDays; i++ {
+		nextDay := s.endOfDay(day)
+		if s.Calendar.Excluded(day) {
+			day = nextDay
+			continue
+		}
+
+		// The runs of the day itself
+		from := t
+		if t.Before(day) {
+			from = day.Add(-time.Nanosecond)
+		}
+		var best time.Time
+		if next := s.in(s.Schedule.Next(from.In(orig))); !next.IsZero() && next.Before(nextDay) {
+			best = next
+		}
+
+		// The runs of the excluded days before, shifted to this day. When t
+		// is in this day only the runs later in the day are after t.
+		for e, j := day.AddDate(0, 0, -1), 0; j < maxCalendarDays && s.Calendar.Excluded(e); e, j = e.AddDate(0, 0, -1), j+1 {
+			eFrom := e.Add(-time.Nanosecond)
+			if !t.Before(day) {
+				eFrom = atClock(e, t)
+			}
+			next := s.in(s.Schedule.Next(eFrom.In(orig)))
+			if next.IsZero() || !next.Before(s.endOfDay(e)) {
+				continue
+			}
+			if shifted := atClock(day, next); best.IsZero() || shifted.Before(best) {
+				best = shifted
+			}
+		}
+
+		if !best.IsZero() {
+			return best.In(orig)
+		}
+		day = nextDay
+	}
+
+	return time.Time{}
+}
+
+// in returns the time in the calendar location.
+func (s *CalendarSchedule) in(t time.Time) time.Time {
+	if s.Calendar.Location != nil && !t.IsZero() {
+		return t.In(s.Calendar.Location)
+	}
+	return t
+}
+
+func (s *CalendarSchedule) startOfDay(t time.Time) time.Time {
+	t = s.in(t)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func (s *CalendarSchedule) endOfDay(t time.Time) time.Time {
+	t = s.in(t)
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+}
+
+// atClock returns the time of the day of d at the clock time of c.
+func atClock(d, c time.Time) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), c.Hour(), c.Minute(), c.Second(), c.Nanosecond(), d.Location())
+}
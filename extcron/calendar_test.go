@@ -0,0 +1,84 @@
+package extcron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewCalendar(t *testing.T) {
+	if _, err := NewCalendar([]string{"2024-12-25"}, []string{"Saturday", "sun"}, nil); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if _, err := NewCalendar([]string{"25/12/2024"}, nil, nil); err == nil {
+		t.Error("expected error parsing holiday")
+	}
+	if _, err := NewCalendar(nil, []string{"someday"}, nil); err == nil {
+		t.Error("expected error parsing weekday")
+	}
+}
+
+func TestCalendarScheduleNext(t *testing.T) {
+	// 2024-03-29 is a Friday and a holiday, 2024-04-01 is Easter Monday
+	cal, err := NewCalendar([]string{"2024-03-29", "2024-04-01"}, []string{"saturday", "sunday"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		spec     string
+		shift    bool
+		time     string
+		expected string
+	}{
+		// Days not excluded
+		{"0 0 9 * * *", false, "2024-03-27T10:00:00Z", "2024-03-28T09:00:00Z"},
+		{"0 0 9 * * *", true, "2024-03-27T10:00:00Z", "2024-03-28T09:00:00Z"},
+		// Skip the long weekend
+		{"0 0 9 * * *", false, "2024-03-28T10:00:00Z", "2024-04-02T09:00:00Z"},
+		{"0 0 * * * *", false, "2024-03-28T23:30:00Z", "2024-04-02T00:00:00Z"},
+		// Shift the runs of the long weekend to the next day, they run once
+		{"0 0 9 * * *", true, "2024-03-28T10:00:00Z", "2024-04-02T09:00:00Z"},
+		{"0 0 9 * * *", true, "2024-04-02T09:00:00Z", "2024-04-03T09:00:00Z"},
+		// Shifted runs are kept when looking from an excluded day
+		{"0 0 9 * * *", true, "2024-03-30T12:00:00Z", "2024-04-02T09:00:00Z"},
+		// Monthly run on an excluded day is shifted, the runs of the day itself
+		// are kept
+		{"0 0 12 29 * *", true, "2024-03-01T00:00:00Z", "2024-04-02T12:00:00Z"},
+		{"0 0 12 29 * *", false, "2024-03-01T00:00:00Z", "2024-04-29T12:00:00Z"},
+		{"0 0 8,12 2 * *", true, "2024-04-02T08:00:00Z", "2024-04-02T12:00:00Z"},
+	}
+
+	for _, c := range tests {
+		sched, err := Parse(c.spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		now, _ := time.Parse(time.RFC3339, c.time)
+		expected, _ := time.Parse(time.RFC3339, c.expected)
+
+		s := &CalendarSchedule{Schedule: sched, Calendar: cal, Shift: c.shift}
+		if actual := s.Next(now); !actual.Equal(expected) {
+			t.Errorf("%s shift %v, %s: (expected) %v != %v (actual)", c.spec, c.shift, c.time, expected, actual)
+		}
+	}
+}
+
+func TestCalendarScheduleLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cal, err := NewCalendar([]string{"2024-07-04"}, nil, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2024-07-05T02:00:00Z is still July 4th in New York
+	sched, _ := Parse("0 0 2 * * *")
+	s := &CalendarSchedule{Schedule: sched, Calendar: cal}
+	now, _ := time.Parse(time.RFC3339, "2024-07-04T12:00:00Z")
+	expected, _ := time.Parse(time.RFC3339, "2024-07-06T02:00:00Z")
+	if actual := s.Next(now); !actual.Equal(expected) {
+		t.Errorf("(expected) %v != %v (actual)", expected, actual)
+	}
+}
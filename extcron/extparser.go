@@ -21,8 +21,15 @@ func NewParser() cron.ScheduleParser {
 
 // Parse parses a cron schedule specification. It accepts the cron spec with
 // mandatory seconds parameter, descriptors and the custom descriptors
-// "@at <date>", "@manually" and "@minutely".
+// "@at <date>", "@manually", "@minutely" and "@rrule <rule>".
 func (p ExtParser) Parse(spec string) (cron.Schedule, error) {
+	if rule, loc, ok, err := cutRRule(spec); ok {
+		if err != nil {
+			return nil, err
+		}
+		return ParseRRule(rule, loc)
+	}
+
 	switch spec {
 	case "@manually":
 		return At(time.Time{}), nil
@@ -44,6 +51,31 @@ func (p ExtParser) Parse(spec string) (cron.Schedule, error) {
 	return p.parser.Parse(spec)
 }
 
+// cutRRule returns the rule of a "@rrule <rule>" spec, optionally preceded by
+// "TZ=<zone>" or "CRON_TZ=<zone>", and the location it is evaluated in.
+func cutRRule(spec string) (string, *time.Location, bool, error) {
+	const rrule = "@rrule "
+
+	loc := time.Local
+	tz, rest, _ := strings.Cut(spec, " ")
+	if zone, ok := strings.CutPrefix(tz, "TZ="); ok || strings.HasPrefix(tz, "CRON_TZ=") {
+		if !ok {
+			zone = strings.TrimPrefix(tz, "CRON_TZ=")
+		}
+		if !strings.HasPrefix(rest, rrule) {
+			return "", nil, false, nil
+		}
+		var err error
+		if loc, err = time.LoadLocation(zone); err != nil {
+			return "", nil, true, fmt.Errorf("provided bad location %s: %v", zone, err)
+		}
+		spec = rest
+	}
+
+	rule, ok := strings.CutPrefix(spec, rrule)
+	return rule, loc, ok, nil
+}
+
 var standaloneParser = NewParser()
 
 // Parse parses a cron schedule. This is a convenience function to not have
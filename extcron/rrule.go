@@ -0,0 +1,588 @@
+package extcron
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxRRulePeriods is how many periods of a rule are searched for the
+	// next occurrence before giving up.
+	maxRRulePeriods = 100000
+	// maxRRuleYears is how many years ahead are searched for the next
+	// occurrence before giving up.
+	maxRRuleYears = 100
+)
+
+// Frequency is the FREQ part of a recurrence rule.
+type Frequency int
+
+// Frequencies of a recurrence rule, shortest first.
+const (
+	Secondly Frequency = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = map[string]Frequency{
+	"SECONDLY": Secondly,
+	"MINUTELY": Minutely,
+	"HOURLY":   Hourly,
+	"DAILY":    Daily,
+	"WEEKLY":   Weekly,
+	"MONTHLY":  Monthly,
+	"YEARLY":   Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayNum is a BYDAY value, like "MO" or "-1FR" for the last Friday of
+// the month or the year.
+type WeekdayNum struct {
+	// N is the occurrence of the weekday in the month or the year, counting
+	// from the end when negative, and any occurrence when 0.
+	N       int
+	Weekday time.Weekday
+}
+
+// RRule is a schedule following an iCalendar recurrence rule as defined in
+// RFC 5545, like "FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=18". BYWEEKNO is not
+// supported, rules using it are rejected.
+type RRule struct {
+	Freq     Frequency
+	Interval int
+	Count    int
+	Until    time.Time
+
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByYearDay  []int
+	ByMonth    []int
+	BySetPos   []int
+	Wkst       time.Weekday
+
+	// Dtstart is the first time of the rule, it anchors the intervals and
+	// the count, and gives the values of the missing BYxxx parts. The rule
+	// has no occurrences until it's set.
+	Dtstart time.Time
+
+	// Location the rule is evaluated in.
+	Location *time.Location
+
+	// cursor remembers where the last occurrence of a rule with a count
+	// was found, so the next one doesn't count again from the start.
+	mu     sync.Mutex
+	cursor countCursor
+}
+
+// countCursor is a period of a rule with a count and the number of
+// occurrences before it.
+type countCursor struct {
+	dtstart time.Time
+	period  time.Time
+	k       int64
+	count   int
+}
+
+// ParseRRule parses a recurrence rule, with or without the "RRULE:" prefix,
+// evaluated in the given location.
+func ParseRRule(rule string, loc *time.Location) (*RRule, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	r := &RRule{
+		Interval: 1,
+		Wkst:     time.Monday,
+		Location: loc,
+	}
+
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+	hasFreq := false
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("failed to parse rrule part %q", part)
+		}
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq, hasFreq = frequencies[value]
+			if !hasFreq {
+				err = fmt.Errorf("unknown frequency %s", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err == nil && r.Interval < 1 {
+				err = fmt.Errorf("interval must be positive")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err == nil && r.Count < 1 {
+				err = fmt.Errorf("count must be positive")
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(value, loc)
+		case "BYSECOND":
+			r.BySecond, err = parseInts(value, 0, 59, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(value, 0, 59, false)
+		case "BYHOUR":
+			r.ByHour, err = parseInts(value, 0, 23, false)
+		case "BYDAY":
+			r.ByDay, err = parseWeekdayNums(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(value, 1, 31, true)
+		case "BYYEARDAY":
+			r.ByYearDay, err = parseInts(value, 1, 366, true)
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(value, 1, 12, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(value, 1, 366, true)
+		case "WKST":
+			var ok bool
+			if r.Wkst, ok = weekdays[value]; !ok {
+				err = fmt.Errorf("unknown weekday %s", value)
+			}
+		case "BYWEEKNO":
+			err = fmt.Errorf("BYWEEKNO is not supported")
+		default:
+			err = fmt.Errorf("unknown part")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rrule %s: %s", name, err)
+		}
+	}
+
+	if !hasFreq {
+		return nil, fmt.Errorf("failed to parse rrule: FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("failed to parse rrule: COUNT and UNTIL can't be used together")
+	}
+	if r.Freq != Monthly && r.Freq != Yearly {
+		for _, wd := range r.ByDay {
+			if wd.N != 0 {
+				return nil, fmt.Errorf("failed to parse rrule BYDAY: numbered weekdays need a MONTHLY or YEARLY frequency")
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// parseUntil parses an UNTIL value, a UTC time, a local time or a date. A
+// date includes the whole day.
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %s", value)
+	}
+	return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
+
+// parseInts parses a comma separated list of numbers between min and max,
+// or between -max and -min when negative numbers are allowed.
+func parseInts(value string, min, max int, negative bool) ([]int, error) {
+	var out []int
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", s)
+		}
+		abs := n
+		if negative && n < 0 {
+			abs = -n
+		}
+		if abs < min || abs > max {
+			return nil, fmt.Errorf("%s out of range", s)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// parseWeekdayNums parses a comma separated list of BYDAY values.
+func parseWeekdayNums(value string) ([]WeekdayNum, error) {
+	var out []WeekdayNum
+	for _, s := range strings.Split(value, ",") {
+		if len(s) < 2 {
+			return nil, fmt.Errorf("invalid weekday %s", s)
+		}
+		wd, ok := weekdays[s[len(s)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %s", s)
+		}
+
+		var n int
+		if num := s[:len(s)-2]; num != "" {
+			var err error
+			n, err = strconv.Atoi(num)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid weekday %s", s)
+			}
+		}
+		out = append(out, WeekdayNum{N: n, Weekday: wd})
+	}
+	return out, nil
+}
+
+// Next returns the next occurrence of the rule after the given time, or the
+// zero time if there is none.
+func (r *RRule) Next(t time.Time) time.Time {
+	if r.Dtstart.IsZero() {
+		return time.Time{}
+	}
+
+	orig := t.Location()
+	t = t.In(r.Location)
+	start := r.Dtstart.In(r.Location)
+	interval := int64(r.Interval)
+
+	// Rules with a count are walked from the start to count the occurrences,
+	// or from the period of the last occurrence found before t
+	var k int64
+	count := 0
+	if r.Count == 0 && t.After(start) {
+		k = r.periods(start, t) / interval
+	}
+	if r.Count > 0 {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if c := r.cursor; c.dtstart.Equal(start) && !t.Before(c.period) {
+			k, count = c.k, c.count
+		}
+	}
+
+	limit := t
+	if start.After(limit) {
+		limit = start
+	}
+	limit = limit.AddDate(maxRRuleYears, 0, 0)
+
+	for i := 0; i < maxRRulePeriods; i++ {
+		p := r.periodStart(start, k)
+		if p.After(limit) || !r.Until.IsZero() && p.After(r.Until) {
+			break
+		}
+
+		if r.Count > 0 {
+			r.cursor = countCursor{dtstart: start, period: p, k: k, count: count}
+		}
+		for _, o := range r.occurrences(start, p) {
+			if !r.Until.IsZero() && o.After(r.Until) {
+				return time.Time{}
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return time.Time{}
+			}
+			if o.After(t) {
+				return o.In(orig)
+			}
+		}
+
+		// Jump over the days or hours excluded by the rule when the
+		// periods are shorter, they have no occurrences to count
+		next := k + 1
+		if to := r.skip(start, p); !to.IsZero() {
+			if n := (r.periods(start, to) + interval - 1) / interval; n > next {
+				next = n
+			}
+		}
+		k = next
+	}
+
+	return time.Time{}
+}
+
+// anchor returns the start of the period containing the given time when
+// the periods are shorter than a day.
+func (r *RRule) anchor(t time.Time) time.Time {
+	switch r.Freq {
+	case Hourly:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case Minutely:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	}
+}
+
+// unit returns the length of the periods shorter than a day.
+func (r *RRule) unit() time.Duration {
+	switch r.Freq {
+	case Hourly:
+		return time.Hour
+	case Minutely:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// weekStart returns the first day of the week of the given time.
+func (r *RRule) weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) - int(r.Wkst) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// periods returns the number of whole periods between the period of the
+// start and the period of t.
+func (r *RRule) periods(start, t time.Time) int64 {
+	switch r.Freq {
+	case Yearly:
+		return int64(t.Year() - start.Year())
+	case Monthly:
+		return int64((t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month()))
+	case Weekly:
+		return (dayNumber(r.weekStart(t)) - dayNumber(r.weekStart(start))) / 7
+	case Daily:
+		return dayNumber(t) - dayNumber(start)
+	default:
+		return int64(t.Sub(r.anchor(start)) / r.unit())
+	}
+}
+
+// periodStart returns the start of the kth period of the rule.
+func (r *RRule) periodStart(start time.Time, k int64) time.Time {
+	n := int(k) * r.Interval
+	loc := start.Location()
+	switch r.Freq {
+	case Yearly:
+		return time.Date(start.Year()+n, time.January, 1, 0, 0, 0, 0, loc)
+	case Monthly:
+		return time.Date(start.Year(), start.Month()+time.Month(n), 1, 0, 0, 0, 0, loc)
+	case Weekly:
+		ws := r.weekStart(start)
+		return time.Date(ws.Year(), ws.Month(), ws.Day()+7*n, 0, 0, 0, 0, loc)
+	case Daily:
+		return time.Date(start.Year(), start.Month(), start.Day()+n, 0, 0, 0, 0, loc)
+	default:
+		return r.anchor(start).Add(time.Duration(n) * r.unit())
+	}
+}
+
+// occurrences returns the occurrences of the rule in the period starting
+// at p, sorted, not before the start of the rule.
+func (r *RRule) occurrences(start, p time.Time) []time.Time {
+	var set []time.Time
+	if r.Freq < Daily {
+		set = r.subDaily(start, p)
+	} else {
+		for _, d := range r.days(start, p) {
+			for _, h := range orDefault(r.ByHour, start.Hour()) {
+				for _, m := range orDefault(r.ByMinute, start.Minute()) {
+					for _, s := range orDefault(r.BySecond, start.Second()) {
+						set = append(set, time.Date(d.Year(), d.Month(), d.Day(), h, m, s, 0, d.Location()))
+					}
+				}
+			}
+		}
+	}
+
+	sort.Slice(set, func(i, j int) bool { return set[i].Before(set[j]) })
+	set = slices.CompactFunc(set, time.Time.Equal)
+
+	if len(r.BySetPos) > 0 {
+		var pos []time.Time
+		for _, n := range r.BySetPos {
+			i := n - 1
+			if n < 0 {
+				i = len(set) + n
+			}
+			if i >= 0 && i < len(set) {
+				pos = append(pos, set[i])
+			}
+		}
+		sort.Slice(pos, func(i, j int) bool { return pos[i].Before(pos[j]) })
+		set = slices.CompactFunc(pos, time.Time.Equal)
+	}
+
+	out := set[:0]
+	for _, o := range set {
+		if !o.Before(start) {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// subDaily returns the occurrences in the period starting at p when the
+// periods are shorter than a day.
+func (r *RRule) subDaily(start, p time.Time) []time.Time {
+	if !r.dayMatches(start, p) || !matches(r.ByHour, p.Hour()) {
+		return nil
+	}
+
+	var set []time.Time
+	switch r.Freq {
+	case Hourly:
+		for _, m := range orDefault(r.ByMinute, start.Minute()) {
+			for _, s := range orDefault(r.BySecond, start.Second()) {
+				set = append(set, p.Add(time.Duration(m)*time.Minute+time.Duration(s)*time.Second))
+			}
+		}
+	case Minutely:
+		if matches(r.ByMinute, p.Minute()) {
+			for _, s := range orDefault(r.BySecond, start.Second()) {
+				set = append(set, p.Add(time.Duration(s)*time.Second))
+			}
+		}
+	default:
+		if matches(r.ByMinute, p.Minute()) && matches(r.BySecond, p.Second()) {
+			set = append(set, p)
+		}
+	}
+	return set
+}
+
+// skip returns the time to continue from when the period starting at p is
+// in a day or an hour excluded by the rule, or the zero time.
+func (r *RRule) skip(start, p time.Time) time.Time {
+	if r.Freq >= Daily {
+		return time.Time{}
+	}
+	if !r.dayMatches(start, p) {
+		return time.Date(p.Year(), p.Month(), p.Day()+1, 0, 0, 0, 0, p.Location())
+	}
+	if r.Freq < Hourly && !matches(r.ByHour, p.Hour()) {
+		return time.Date(p.Year(), p.Month(), p.Day(), p.Hour()+1, 0, 0, 0, p.Location())
+	}
+	if r.Freq < Minutely && !matches(r.ByMinute, p.Minute()) {
+		return time.Date(p.Year(), p.Month(), p.Day(), p.Hour(), p.Minute()+1, 0, 0, p.Location())
+	}
+	return time.Time{}
+}
+
+// days returns the days of the period starting at p matching the rule.
+func (r *RRule) days(start, p time.Time) []time.Time {
+	var n int
+	switch r.Freq {
+	case Yearly:
+		n = daysIn(p.Year(), 13)
+	case Monthly:
+		n = daysIn(p.Year(), p.Month())
+	case Weekly:
+		n = 7
+	default:
+		n = 1
+	}
+
+	var out []time.Time
+	for i := 0; i < n; i++ {
+		d := time.Date(p.Year(), p.Month(), p.Day()+i, 0, 0, 0, 0, p.Location())
+		if r.dayMatches(start, d) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// dayMatches reports whether the day of the given time matches the rule.
+func (r *RRule) dayMatches(start, d time.Time) bool {
+	if !matches(r.ByMonth, int(d.Month())) ||
+		!matchesNum(r.ByYearDay, d.YearDay(), daysIn(d.Year(), 13)) ||
+		!matchesNum(r.ByMonthDay, d.Day(), daysIn(d.Year(), d.Month())) ||
+		len(r.ByDay) > 0 && !r.weekdayMatches(d) {
+		return false
+	}
+
+	// The day of the start is the default of the missing day parts
+	noDays := len(r.ByYearDay) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0
+	switch {
+	case r.Freq == Yearly && noDays:
+		return (len(r.ByMonth) > 0 || d.Month() == start.Month()) && d.Day() == start.Day()
+	case r.Freq == Monthly && noDays:
+		return d.Day() == start.Day()
+	case r.Freq == Weekly && noDays:
+		return d.Weekday() == start.Weekday()
+	}
+	return true
+}
+
+// weekdayMatches reports whether the day of the given time matches BYDAY.
+// Numbered weekdays count in the month, or in the year for yearly rules
+// without BYMONTH.
+func (r *RRule) weekdayMatches(d time.Time) bool {
+	pos, total := d.Day(), daysIn(d.Year(), d.Month())
+	if r.Freq == Yearly && len(r.ByMonth) == 0 {
+		pos, total = d.YearDay(), daysIn(d.Year(), 13)
+	}
+
+	for _, wd := range r.ByDay {
+		if wd.Weekday != d.Weekday() {
+			continue
+		}
+		if wd.N == 0 ||
+			wd.N > 0 && (pos-1)/7+1 == wd.N ||
+			wd.N < 0 && (total-pos)/7+1 == -wd.N {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether v is in the values, or the values are empty.
+func matches(values []int, v int) bool {
+	return len(values) == 0 || slices.Contains(values, v)
+}
+
+// matchesNum reports whether v is in the values, counting the negative
+// values from the end of a total, or the values are empty.
+func matchesNum(values []int, v, total int) bool {
+	for _, n := range values {
+		if n == v || n < 0 && total+n+1 == v {
+			return true
+		}
+	}
+	return len(values) == 0
+}
+
+// orDefault returns the values, or the default when there are none.
+func orDefault(values []int, def int) []int {
+	if len(values) == 0 {
+		return []int{def}
+	}
+	return values
+}
+
+// daysIn returns the number of days of a month, or of the year for month 13.
+func daysIn(year int, month time.Month) int {
+	if month == 13 {
+		return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// dayNumber returns the number of days from the Unix epoch to the day of
+// the given time.
+func dayNumber(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
@@ -0,0 +1,118 @@
+package extcron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRRuleNext(t *testing.T) {
+	tests := []struct {
+		rule     string
+		dtstart  string
+		time     string
+		expected string
+	}{
+		// Last Friday of the month
+		{"FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=18", "2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z", "2024-01-26T18:00:00Z"},
+		{"FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=18", "2024-01-01T00:00:00Z", "2024-01-26T18:00:00Z", "2024-02-23T18:00:00Z"},
+		// Second Tuesday of the month
+		{"RRULE:FREQ=MONTHLY;BYDAY=2TU;BYHOUR=9", "2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z", "2024-01-09T09:00:00Z"},
+		// Every second Tuesday from the start
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU", "2024-01-02T09:00:00Z", "2024-01-02T09:00:00Z", "2024-01-16T09:00:00Z"},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU", "2024-01-02T09:00:00Z", "2024-01-10T00:00:00Z", "2024-01-16T09:00:00Z"},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU", "2024-01-02T09:00:00Z", "2023-12-01T00:00:00Z", "2024-01-02T09:00:00Z"},
+		// Count and until
+		{"FREQ=DAILY;COUNT=3", "2024-01-01T10:00:00Z", "2024-01-02T10:00:00Z", "2024-01-03T10:00:00Z"},
+		{"FREQ=DAILY;COUNT=3", "2024-01-01T10:00:00Z", "2024-01-03T10:00:00Z", ""},
+		{"FREQ=DAILY;UNTIL=20240103T000000Z", "2024-01-01T10:00:00Z", "2024-01-02T10:00:00Z", ""},
+		{"FREQ=DAILY;UNTIL=20240103", "2024-01-01T10:00:00Z", "2024-01-02T10:00:00Z", "2024-01-03T10:00:00Z"},
+		// Last business day of the month
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "2024-01-01T00:00:00Z", "2024-03-01T00:00:00Z", "2024-03-29T00:00:00Z"},
+		// Leap days
+		{"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29", "2024-01-01T00:00:00Z", "2024-03-01T00:00:00Z", "2028-02-29T00:00:00Z"},
+		// Defaults from the start
+		{"FREQ=YEARLY", "2020-07-15T12:00:00Z", "2024-08-01T00:00:00Z", "2025-07-15T12:00:00Z"},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", "2024-01-01T08:30:00Z", "2024-02-01T00:00:00Z", "2024-02-29T08:30:00Z"},
+		// Periods shorter than a day
+		{"FREQ=HOURLY;INTERVAL=6", "2024-01-01T00:00:00Z", "2024-01-01T01:00:00Z", "2024-01-01T06:00:00Z"},
+		{"FREQ=MINUTELY;INTERVAL=15;BYHOUR=9,10;BYDAY=MO", "2024-01-01T00:00:00Z", "2024-01-06T00:00:00Z", "2024-01-08T09:00:00Z"},
+		{"FREQ=SECONDLY;BYHOUR=18;BYMINUTE=30;BYSECOND=15", "2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z", "2024-01-01T18:30:15Z"},
+		// Never happens
+		{"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", "2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z", ""},
+	}
+
+	for _, c := range tests {
+		r, err := ParseRRule(c.rule, time.UTC)
+		require.NoError(t, err, c.rule)
+		r.Dtstart, _ = time.Parse(time.RFC3339, c.dtstart)
+		now, _ := time.Parse(time.RFC3339, c.time)
+
+		var expected time.Time
+		if c.expected != "" {
+			expected, _ = time.Parse(time.RFC3339, c.expected)
+		}
+		assert.Equal(t, expected, r.Next(now), "%s from %s at %s", c.rule, c.dtstart, c.time)
+	}
+}
+
+func TestRRuleNoStart(t *testing.T) {
+	r, err := ParseRRule("FREQ=DAILY", time.UTC)
+	require.NoError(t, err)
+	assert.True(t, r.Next(time.Now()).IsZero())
+}
+
+func TestRRuleCount(t *testing.T) {
+	r, err := ParseRRule("FREQ=WEEKLY;BYDAY=MO,FR;COUNT=5", time.UTC)
+	require.NoError(t, err)
+	r.Dtstart = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Walk the occurrences like the scheduler does
+	var runs []time.Time
+	for next := r.Next(r.Dtstart.Add(-time.Second)); !next.IsZero(); next = r.Next(next) {
+		runs = append(runs, next)
+	}
+	require.Len(t, runs, 5)
+	assert.Equal(t, time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC), runs[4])
+
+	// Times before the last occurrence found count from the start again
+	assert.Equal(t, runs[1], r.Next(runs[0]))
+	assert.True(t, r.Next(runs[4]).IsZero())
+
+	// Changing the start counts from the new start
+	r.Dtstart = time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 2, 2, 9, 0, 0, 0, time.UTC), r.Next(time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)))
+}
+
+func TestRRuleParse(t *testing.T) {
+	// Timezone of the rule
+	s, err := Parse("CRON_TZ=America/New_York @rrule FREQ=DAILY;BYHOUR=9")
+	require.NoError(t, err)
+	s.(*RRule).Dtstart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC), s.Next(now))
+
+	s, err = Parse("@rrule FREQ=WEEKLY;BYDAY=SA,SU")
+	require.NoError(t, err)
+	assert.Equal(t, time.Local, s.(*RRule).Location)
+
+	// Timezones of cron specs are still parsed by cron
+	_, err = Parse("TZ=UTC 0 0 * * * *")
+	require.NoError(t, err)
+
+	for _, spec := range []string{
+		"@rrule BYHOUR=9",
+		"@rrule FREQ=DAILY;BYWEEKNO=1",
+		"@rrule FREQ=DAILY;COUNT=2;UNTIL=20240101",
+		"@rrule FREQ=DAILY;BYDAY=1MO",
+		"@rrule FREQ=DAILY;BYHOUR=24",
+		"@rrule FREQ=MONTHLY;BYMONTHDAY=-32",
+		"@rrule FREQ=SOMETIMES",
+		"TZ=Nowhere/Land @rrule FREQ=DAILY",
+	} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
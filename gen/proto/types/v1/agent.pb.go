@@ -171,6 +171,102 @@ func (x *AgentRunResponse) GetPayload() []byte {
 	return nil
 }
 
+type AgentCancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionId   string                 `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentCancelRequest) Reset() {
+	*x = AgentCancelRequest{}
+	mi := &file_types_v1_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentCancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentCancelRequest) ProtoMessage() {}
+
+func (x *AgentCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentCancelRequest.ProtoReflect.Descriptor instead.
+func (*AgentCancelRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AgentCancelRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type AgentCancelResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Found          bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	NotCancellable bool                   `protobuf:"varint,2,opt,name=not_cancellable,json=notCancellable,proto3" json:"not_cancellable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AgentCancelResponse) Reset() {
+	*x = AgentCancelResponse{}
+	mi := &file_types_v1_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentCancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentCancelResponse) ProtoMessage() {}
+
+func (x *AgentCancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentCancelResponse.ProtoReflect.Descriptor instead.
+func (*AgentCancelResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AgentCancelResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *AgentCancelResponse) GetNotCancellable() bool {
+	if x != nil {
+		return x.NotCancellable
+	}
+	return false
+}
+
 var File_types_v1_agent_proto protoreflect.FileDescriptor
 
 const file_types_v1_agent_proto_rawDesc = "" +
@@ -183,9 +279,15 @@ const file_types_v1_agent_proto_rawDesc = "" +
 	"\texecution\x18\x01 \x01(\v2\x13.types.v1.ExecutionR\texecution\"@\n" +
 	"\x10AgentRunResponse\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\fR\apayload2Q\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"7\n" +
+	"\x12AgentCancelRequest\x12!\n" +
+	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\"T\n" +
+	"\x13AgentCancelResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12'\n" +
+	"\x0fnot_cancellable\x18\x02 \x01(\bR\x0enotCancellable2\x9d\x01\n" +
 	"\fAgentService\x12A\n" +
-	"\bAgentRun\x12\x19.types.v1.AgentRunRequest\x1a\x18.types.v1.AgentRunStream0\x01B\x94\x01\n" +
+	"\bAgentRun\x12\x19.types.v1.AgentRunRequest\x1a\x18.types.v1.AgentRunStream0\x01\x12J\n" +
+	"\vAgentCancel\x12\x1c.types.v1.AgentCancelRequest\x1a\x1d.types.v1.AgentCancelResponseB\x94\x01\n" +
 	"\fcom.types.v1B\n" +
 	"AgentProtoP\x01Z7github.com/distribworks/dkron/v4/types/types/v1;typesv1\xa2\x02\x03TXX\xaa\x02\bTypes.V1\xca\x02\bTypes\\V1\xe2\x02\x14Types\\V1\\GPBMetadata\xea\x02\tTypes::V1b\x06proto3"
 
@@ -201,22 +303,26 @@ func file_types_v1_agent_proto_rawDescGZIP() []byte {
 	return file_types_v1_agent_proto_rawDescData
 }
 
-var file_types_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_types_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_types_v1_agent_proto_goTypes = []any{
-	(*AgentRunRequest)(nil),  // 0: types.v1.AgentRunRequest
-	(*AgentRunStream)(nil),   // 1: types.v1.AgentRunStream
-	(*AgentRunResponse)(nil), // 2: types.v1.AgentRunResponse
-	(*Job)(nil),              // 3: types.v1.Job
-	(*Execution)(nil),        // 4: types.v1.Execution
+	(*AgentRunRequest)(nil),     // 0: types.v1.AgentRunRequest
+	(*AgentRunStream)(nil),      // 1: types.v1.AgentRunStream
+	(*AgentRunResponse)(nil),    // 2: types.v1.AgentRunResponse
+	(*AgentCancelRequest)(nil),  // 3: types.v1.AgentCancelRequest
+	(*AgentCancelResponse)(nil), // 4: types.v1.AgentCancelResponse
+	(*Job)(nil),                 // 5: types.v1.Job
+	(*Execution)(nil),           // 6: types.v1.Execution
 }
 var file_types_v1_agent_proto_depIdxs = []int32{
-	3, // 0: types.v1.AgentRunRequest.job:type_name -> types.v1.Job
-	4, // 1: types.v1.AgentRunRequest.execution:type_name -> types.v1.Execution
-	4, // 2: types.v1.AgentRunStream.execution:type_name -> types.v1.Execution
+	5, // 0: types.v1.AgentRunRequest.job:type_name -> types.v1.Job
+	6, // 1: types.v1.AgentRunRequest.execution:type_name -> types.v1.Execution
+	6, // 2: types.v1.AgentRunStream.execution:type_name -> types.v1.Execution
 	0, // 3: types.v1.AgentService.AgentRun:input_type -> types.v1.AgentRunRequest
-	1, // 4: types.v1.AgentService.AgentRun:output_type -> types.v1.AgentRunStream
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
+	3, // 4: types.v1.AgentService.AgentCancel:input_type -> types.v1.AgentCancelRequest
+	1, // 5: types.v1.AgentService.AgentRun:output_type -> types.v1.AgentRunStream
+	4, // 6: types.v1.AgentService.AgentCancel:output_type -> types.v1.AgentCancelResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -234,7 +340,7 @@ func file_types_v1_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_v1_agent_proto_rawDesc), len(file_types_v1_agent_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AgentService_AgentRun_FullMethodName = "/types.v1.AgentService/AgentRun"
+	AgentService_AgentRun_FullMethodName    = "/types.v1.AgentService/AgentRun"
+	AgentService_AgentCancel_FullMethodName = "/types.v1.AgentService/AgentCancel"
 )
 
 // AgentServiceClient is the client API for AgentService service.
@@ -30,6 +31,7 @@ const (
 type AgentServiceClient interface {
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	AgentRun(ctx context.Context, in *AgentRunRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AgentRunStream], error)
+	AgentCancel(ctx context.Context, in *AgentCancelRequest, opts ...grpc.CallOption) (*AgentCancelResponse, error)
 }
 
 type agentServiceClient struct {
@@ -59,12 +61,23 @@ func (c *agentServiceClient) AgentRun(ctx context.Context, in *AgentRunRequest,
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AgentService_AgentRunClient = grpc.ServerStreamingClient[AgentRunStream]
 
+func (c *agentServiceClient) AgentCancel(ctx context.Context, in *AgentCancelRequest, opts ...grpc.CallOption) (*AgentCancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentCancelResponse)
+	err := c.cc.Invoke(ctx, AgentService_AgentCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServiceServer is the server API for AgentService service.
 // All implementations must embed UnimplementedAgentServiceServer
 // for forward compatibility.
 type AgentServiceServer interface {
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	AgentRun(*AgentRunRequest, grpc.ServerStreamingServer[AgentRunStream]) error
+	AgentCancel(context.Context, *AgentCancelRequest) (*AgentCancelResponse, error)
 	mustEmbedUnimplementedAgentServiceServer()
 }
 
@@ -78,6 +91,9 @@ type UnimplementedAgentServiceServer struct{}
 func (UnimplementedAgentServiceServer) AgentRun(*AgentRunRequest, grpc.ServerStreamingServer[AgentRunStream]) error {
 	return status.Error(codes.Unimplemented, "method AgentRun not implemented")
 }
+func (UnimplementedAgentServiceServer) AgentCancel(context.Context, *AgentCancelRequest) (*AgentCancelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AgentCancel not implemented")
+}
 func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
 func (UnimplementedAgentServiceServer) testEmbeddedByValue()                      {}
 
@@ -110,13 +126,36 @@ func _AgentService_AgentRun_Handler(srv interface{}, stream grpc.ServerStream) e
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AgentService_AgentRunServer = grpc.ServerStreamingServer[AgentRunStream]
 
+func _AgentService_AgentCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).AgentCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_AgentCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).AgentCancel(ctx, req.(*AgentCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var AgentService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "types.v1.AgentService",
 	HandlerType: (*AgentServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AgentCancel",
+			Handler:    _AgentService_AgentCancel_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "AgentRun",
@@ -26,34 +26,58 @@ const (
 )
 
 type Job struct {
-	state          protoimpl.MessageState   `protogen:"open.v1"`
-	Name           string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Timezone       string                   `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	Schedule       string                   `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Owner          string                   `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
-	OwnerEmail     string                   `protobuf:"bytes,8,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
-	SuccessCount   int32                    `protobuf:"varint,9,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	ErrorCount     int32                    `protobuf:"varint,10,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
-	Disabled       bool                     `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Tags           map[string]string        `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Retries        uint32                   `protobuf:"varint,13,opt,name=retries,proto3" json:"retries,omitempty"`
-	DependentJobs  []string                 `protobuf:"bytes,14,rep,name=dependent_jobs,json=dependentJobs,proto3" json:"dependent_jobs,omitempty"`
-	ParentJob      string                   `protobuf:"bytes,15,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
-	Concurrency    string                   `protobuf:"bytes,16,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	Executor       string                   `protobuf:"bytes,17,opt,name=executor,proto3" json:"executor,omitempty"`
-	ExecutorConfig map[string]string        `protobuf:"bytes,18,rep,name=executor_config,json=executorConfig,proto3" json:"executor_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Status         string                   `protobuf:"bytes,19,opt,name=status,proto3" json:"status,omitempty"`
-	Metadata       map[string]string        `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	LastSuccess    *Job_NullableTime        `protobuf:"bytes,25,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
-	LastError      *Job_NullableTime        `protobuf:"bytes,26,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	Next           *timestamppb.Timestamp   `protobuf:"bytes,23,opt,name=next,proto3" json:"next,omitempty"`
-	Displayname    string                   `protobuf:"bytes,24,opt,name=displayname,proto3" json:"displayname,omitempty"`
-	Processors     map[string]*PluginConfig `protobuf:"bytes,27,rep,name=processors,proto3" json:"processors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Ephemeral      bool                     `protobuf:"varint,28,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
-	ExpiresAt      *Job_NullableTime        `protobuf:"bytes,29,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	StartsAt       *Job_NullableTime        `protobuf:"bytes,30,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Name               string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Timezone           string                   `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Schedule           string                   `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Owner              string                   `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerEmail         string                   `protobuf:"bytes,8,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	SuccessCount       int32                    `protobuf:"varint,9,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	ErrorCount         int32                    `protobuf:"varint,10,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	Disabled           bool                     `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Tags               map[string]string        `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Retries            uint32                   `protobuf:"varint,13,opt,name=retries,proto3" json:"retries,omitempty"`
+	DependentJobs      []string                 `protobuf:"bytes,14,rep,name=dependent_jobs,json=dependentJobs,proto3" json:"dependent_jobs,omitempty"`
+	ParentJob          string                   `protobuf:"bytes,15,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
+	Concurrency        string                   `protobuf:"bytes,16,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Executor           string                   `protobuf:"bytes,17,opt,name=executor,proto3" json:"executor,omitempty"`
+	ExecutorConfig     map[string]string        `protobuf:"bytes,18,rep,name=executor_config,json=executorConfig,proto3" json:"executor_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status             string                   `protobuf:"bytes,19,opt,name=status,proto3" json:"status,omitempty"`
+	Metadata           map[string]string        `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	LastSuccess        *Job_NullableTime        `protobuf:"bytes,25,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError          *Job_NullableTime        `protobuf:"bytes,26,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Next               *timestamppb.Timestamp   `protobuf:"bytes,23,opt,name=next,proto3" json:"next,omitempty"`
+	Displayname        string                   `protobuf:"bytes,24,opt,name=displayname,proto3" json:"displayname,omitempty"`
+	Processors         map[string]*PluginConfig `protobuf:"bytes,27,rep,name=processors,proto3" json:"processors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ephemeral          bool                     `protobuf:"varint,28,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	ExpiresAt          *Job_NullableTime        `protobuf:"bytes,29,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	StartsAt           *Job_NullableTime        `protobuf:"bytes,30,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ParentJobs         []string                 `protobuf:"bytes,31,rep,name=parent_jobs,json=parentJobs,proto3" json:"parent_jobs,omitempty"`
+	DependencyTriggers map[string]string        `protobuf:"bytes,32,rep,name=dependency_triggers,json=dependencyTriggers,proto3" json:"dependency_triggers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Parameters         map[string]*JobParameter `protobuf:"bytes,33,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MaxConcurrency     int32                    `protobuf:"varint,34,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	MaxQueueDepth      int32                    `protobuf:"varint,35,opt,name=max_queue_depth,json=maxQueueDepth,proto3" json:"max_queue_depth,omitempty"`
+	RetryBackoff       *RetryBackoff            `protobuf:"bytes,36,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	RetryPlacement     string                   `protobuf:"bytes,37,opt,name=retry_placement,json=retryPlacement,proto3" json:"retry_placement,omitempty"`
+	Selector           string                   `protobuf:"bytes,38,opt,name=selector,proto3" json:"selector,omitempty"`
+	Constraints        []string                 `protobuf:"bytes,39,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	MisfirePolicy      string                   `protobuf:"bytes,40,opt,name=misfire_policy,json=misfirePolicy,proto3" json:"misfire_policy,omitempty"`
+	MisfireLimit       int32                    `protobuf:"varint,41,opt,name=misfire_limit,json=misfireLimit,proto3" json:"misfire_limit,omitempty"`
+	MisfireGrace       string                   `protobuf:"bytes,42,opt,name=misfire_grace,json=misfireGrace,proto3" json:"misfire_grace,omitempty"`
+	Calendar           string                   `protobuf:"bytes,43,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	CalendarPolicy     string                   `protobuf:"bytes,44,opt,name=calendar_policy,json=calendarPolicy,proto3" json:"calendar_policy,omitempty"`
+	Trigger            string                   `protobuf:"bytes,45,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	TriggerConfig      map[string]string        `protobuf:"bytes,46,rep,name=trigger_config,json=triggerConfig,proto3" json:"trigger_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Template           string                   `protobuf:"bytes,47,opt,name=template,proto3" json:"template,omitempty"`
+	Version            int64                    `protobuf:"varint,48,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp   `protobuf:"bytes,49,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy          string                   `protobuf:"bytes,50,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Revision           uint64                   `protobuf:"varint,51,opt,name=revision,proto3" json:"revision,omitempty"`
+	Namespace          string                   `protobuf:"bytes,52,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Overrides          []string                 `protobuf:"bytes,53,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	CreatedAt          *timestamppb.Timestamp   `protobuf:"bytes,54,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Job) Reset() {
@@ -261,27 +285,198 @@ func (x *Job) GetStartsAt() *Job_NullableTime {
 	return nil
 }
 
-type PluginConfig struct {
+func (x *Job) GetParentJobs() []string {
+	if x != nil {
+		return x.ParentJobs
+	}
+	return nil
+}
+
+func (x *Job) GetDependencyTriggers() map[string]string {
+	if x != nil {
+		return x.DependencyTriggers
+	}
+	return nil
+}
+
+func (x *Job) GetParameters() map[string]*JobParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Job) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *Job) GetMaxQueueDepth() int32 {
+	if x != nil {
+		return x.MaxQueueDepth
+	}
+	return 0
+}
+
+func (x *Job) GetRetryBackoff() *RetryBackoff {
+	if x != nil {
+		return x.RetryBackoff
+	}
+	return nil
+}
+
+func (x *Job) GetRetryPlacement() string {
+	if x != nil {
+		return x.RetryPlacement
+	}
+	return ""
+}
+
+func (x *Job) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *Job) GetConstraints() []string {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *Job) GetMisfirePolicy() string {
+	if x != nil {
+		return x.MisfirePolicy
+	}
+	return ""
+}
+
+func (x *Job) GetMisfireLimit() int32 {
+	if x != nil {
+		return x.MisfireLimit
+	}
+	return 0
+}
+
+func (x *Job) GetMisfireGrace() string {
+	if x != nil {
+		return x.MisfireGrace
+	}
+	return ""
+}
+
+func (x *Job) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+func (x *Job) GetCalendarPolicy() string {
+	if x != nil {
+		return x.CalendarPolicy
+	}
+	return ""
+}
+
+func (x *Job) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *Job) GetTriggerConfig() map[string]string {
+	if x != nil {
+		return x.TriggerConfig
+	}
+	return nil
+}
+
+func (x *Job) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Job) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Job) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Job) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Job) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Job) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Job) GetOverrides() []string {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *Job) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RetryBackoff struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        map[string]string      `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Strategy      string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	BaseDelay     string                 `protobuf:"bytes,2,opt,name=base_delay,json=baseDelay,proto3" json:"base_delay,omitempty"`
+	MaxDelay      string                 `protobuf:"bytes,3,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	Jitter        float64                `protobuf:"fixed64,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginConfig) Reset() {
-	*x = PluginConfig{}
+func (x *RetryBackoff) Reset() {
+	*x = RetryBackoff{}
 	mi := &file_types_v1_dkron_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginConfig) String() string {
+func (x *RetryBackoff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PluginConfig) ProtoMessage() {}
+func (*RetryBackoff) ProtoMessage() {}
 
-func (x *PluginConfig) ProtoReflect() protoreflect.Message {
+func (x *RetryBackoff) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v1_dkron_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,39 +488,62 @@ func (x *PluginConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PluginConfig.ProtoReflect.Descriptor instead.
-func (*PluginConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetryBackoff.ProtoReflect.Descriptor instead.
+func (*RetryBackoff) Descriptor() ([]byte, []int) {
 	return file_types_v1_dkron_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *PluginConfig) GetConfig() map[string]string {
+func (x *RetryBackoff) GetStrategy() string {
 	if x != nil {
-		return x.Config
+		return x.Strategy
 	}
-	return nil
+	return ""
 }
 
-type SetJobRequest struct {
+func (x *RetryBackoff) GetBaseDelay() string {
+	if x != nil {
+		return x.BaseDelay
+	}
+	return ""
+}
+
+func (x *RetryBackoff) GetMaxDelay() string {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return ""
+}
+
+func (x *RetryBackoff) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+type JobParameter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetJobRequest) Reset() {
-	*x = SetJobRequest{}
+func (x *JobParameter) Reset() {
+	*x = JobParameter{}
 	mi := &file_types_v1_dkron_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetJobRequest) String() string {
+func (x *JobParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetJobRequest) ProtoMessage() {}
+func (*JobParameter) ProtoMessage() {}
 
-func (x *SetJobRequest) ProtoReflect() protoreflect.Message {
+func (x *JobParameter) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v1_dkron_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -337,39 +555,53 @@ func (x *SetJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetJobRequest.ProtoReflect.Descriptor instead.
-func (*SetJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobParameter.ProtoReflect.Descriptor instead.
+func (*JobParameter) Descriptor() ([]byte, []int) {
 	return file_types_v1_dkron_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SetJobRequest) GetJob() *Job {
+func (x *JobParameter) GetDefaultValue() string {
 	if x != nil {
-		return x.Job
+		return x.DefaultValue
 	}
-	return nil
+	return ""
 }
 
-type SetJobResponse struct {
+func (x *JobParameter) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *JobParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type PluginConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Config        map[string]string      `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetJobResponse) Reset() {
-	*x = SetJobResponse{}
+func (x *PluginConfig) Reset() {
+	*x = PluginConfig{}
 	mi := &file_types_v1_dkron_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetJobResponse) String() string {
+func (x *PluginConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetJobResponse) ProtoMessage() {}
+func (*PluginConfig) ProtoMessage() {}
 
-func (x *SetJobResponse) ProtoReflect() protoreflect.Message {
+func (x *PluginConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v1_dkron_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,39 +613,40 @@ func (x *SetJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetJobResponse.ProtoReflect.Descriptor instead.
-func (*SetJobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginConfig.ProtoReflect.Descriptor instead.
+func (*PluginConfig) Descriptor() ([]byte, []int) {
 	return file_types_v1_dkron_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SetJobResponse) GetJob() *Job {
+func (x *PluginConfig) GetConfig() map[string]string {
 	if x != nil {
-		return x.Job
+		return x.Config
 	}
 	return nil
 }
 
-type DeleteJobRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetJobRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Job              *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	ExpectedRevision uint64                 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DeleteJobRequest) Reset() {
-	*x = DeleteJobRequest{}
+func (x *SetJobRequest) Reset() {
+	*x = SetJobRequest{}
 	mi := &file_types_v1_dkron_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJobRequest) String() string {
+func (x *SetJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobRequest) ProtoMessage() {}
+func (*SetJobRequest) ProtoMessage() {}
 
-func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+func (x *SetJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v1_dkron_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -425,39 +658,46 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetJobRequest.ProtoReflect.Descriptor instead.
+func (*SetJobRequest) Descriptor() ([]byte, []int) {
 	return file_types_v1_dkron_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteJobRequest) GetJobName() string {
+func (x *SetJobRequest) GetJob() *Job {
 	if x != nil {
-		return x.JobName
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-type DeleteJobResponse struct {
+func (x *SetJobRequest) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+type SetJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJobResponse) Reset() {
-	*x = DeleteJobResponse{}
+func (x *SetJobResponse) Reset() {
+	*x = SetJobResponse{}
 	mi := &file_types_v1_dkron_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJobResponse) String() string {
+func (x *SetJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobResponse) ProtoMessage() {}
+func (*SetJobResponse) ProtoMessage() {}
 
-func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+func (x *SetJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v1_dkron_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -469,39 +709,43 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetJobResponse.ProtoReflect.Descriptor instead.
+func (*SetJobResponse) Descriptor() ([]byte, []int) {
 	return file_types_v1_dkron_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteJobResponse) GetJob() *Job {
+func (x *SetJobResponse) GetJob() *Job {
 	if x != nil {
 		return x.Job
 	}
 	return nil
 }
 
-type GetJobRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PatchJobRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	JobName          string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	PatchType        string                 `protobuf:"bytes,2,opt,name=patch_type,json=patchType,proto3" json:"patch_type,omitempty"`
+	Patch            []byte                 `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	ExpectedRevision uint64                 `protobuf:"varint,4,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+	Actor            string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetJobRequest) Reset() {
-	*x = GetJobRequest{}
+func (x *PatchJobRequest) Reset() {
+	*x = PatchJobRequest{}
 	mi := &file_types_v1_dkron_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJobRequest) String() string {
+func (x *PatchJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobRequest) ProtoMessage() {}
+func (*PatchJobRequest) ProtoMessage() {}
 
-func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+func (x *PatchJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v1_dkron_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,9 +757,213 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use PatchJobRequest.ProtoReflect.Descriptor instead.
+func (*PatchJobRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PatchJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *PatchJobRequest) GetPatchType() string {
+	if x != nil {
+		return x.PatchType
+	}
+	return ""
+}
+
+func (x *PatchJobRequest) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *PatchJobRequest) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *PatchJobRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type PatchJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchJobResponse) Reset() {
+	*x = PatchJobResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchJobResponse) ProtoMessage() {}
+
+func (x *PatchJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchJobResponse.ProtoReflect.Descriptor instead.
+func (*PatchJobResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PatchJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type DeleteJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+type DeleteJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobResponse) ProtoMessage() {}
+
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
 func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{6}
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetJobRequest) GetJobName() string {
@@ -534,7 +982,7 @@ type GetJobResponse struct {
 
 func (x *GetJobResponse) Reset() {
 	*x = GetJobResponse{}
-	mi := &file_types_v1_dkron_proto_msgTypes[7]
+	mi := &file_types_v1_dkron_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +994,7 @@ func (x *GetJobResponse) String() string {
 func (*GetJobResponse) ProtoMessage() {}
 
 func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[7]
+	mi := &file_types_v1_dkron_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +1007,7 @@ func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
 func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{7}
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetJobResponse) GetJob() *Job {
@@ -570,22 +1018,29 @@ func (x *GetJobResponse) GetJob() *Job {
 }
 
 type Execution struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Output        []byte                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
-	NodeName      string                 `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	Group         int64                  `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
-	Attempt       uint32                 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobName         string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Success         bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Output          []byte                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	NodeName        string                 `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Group           int64                  `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
+	Attempt         uint32                 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	WorkflowRun     int64                  `protobuf:"varint,9,opt,name=workflow_run,json=workflowRun,proto3" json:"workflow_run,omitempty"`
+	ParentExecution *Execution             `protobuf:"bytes,10,opt,name=parent_execution,json=parentExecution,proto3" json:"parent_execution,omitempty"`
+	Parameters      map[string]string      `protobuf:"bytes,11,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Cancelled       bool                   `protobuf:"varint,12,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	ScheduledAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	Trigger         string                 `protobuf:"bytes,14,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	GroupSize       uint32                 `protobuf:"varint,15,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Execution) Reset() {
 	*x = Execution{}
-	mi := &file_types_v1_dkron_proto_msgTypes[8]
+	mi := &file_types_v1_dkron_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -597,7 +1052,7 @@ func (x *Execution) String() string {
 func (*Execution) ProtoMessage() {}
 
 func (x *Execution) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[8]
+	mi := &file_types_v1_dkron_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +1065,7 @@ func (x *Execution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Execution.ProtoReflect.Descriptor instead.
 func (*Execution) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{8}
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Execution) GetJobName() string {
@@ -669,6 +1124,55 @@ func (x *Execution) GetFinishedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Execution) GetWorkflowRun() int64 {
+	if x != nil {
+		return x.WorkflowRun
+	}
+	return 0
+}
+
+func (x *Execution) GetParentExecution() *Execution {
+	if x != nil {
+		return x.ParentExecution
+	}
+	return nil
+}
+
+func (x *Execution) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Execution) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *Execution) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Execution) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *Execution) GetGroupSize() uint32 {
+	if x != nil {
+		return x.GroupSize
+	}
+	return 0
+}
+
 type ExecutionDoneRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -678,7 +1182,7 @@ type ExecutionDoneRequest struct {
 
 func (x *ExecutionDoneRequest) Reset() {
 	*x = ExecutionDoneRequest{}
-	mi := &file_types_v1_dkron_proto_msgTypes[9]
+	mi := &file_types_v1_dkron_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -690,7 +1194,7 @@ func (x *ExecutionDoneRequest) String() string {
 func (*ExecutionDoneRequest) ProtoMessage() {}
 
 func (x *ExecutionDoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[9]
+	mi := &file_types_v1_dkron_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +1207,7 @@ func (x *ExecutionDoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionDoneRequest.ProtoReflect.Descriptor instead.
 func (*ExecutionDoneRequest) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{9}
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ExecutionDoneRequest) GetExecution() *Execution {
@@ -723,7 +1227,7 @@ type ExecutionDoneResponse struct {
 
 func (x *ExecutionDoneResponse) Reset() {
 	*x = ExecutionDoneResponse{}
-	mi := &file_types_v1_dkron_proto_msgTypes[10]
+	mi := &file_types_v1_dkron_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -735,7 +1239,7 @@ func (x *ExecutionDoneResponse) String() string {
 func (*ExecutionDoneResponse) ProtoMessage() {}
 
 func (x *ExecutionDoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[10]
+	mi := &file_types_v1_dkron_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +1252,7 @@ func (x *ExecutionDoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionDoneResponse.ProtoReflect.Descriptor instead.
 func (*ExecutionDoneResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{10}
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ExecutionDoneResponse) GetFrom() string {
@@ -768,25 +1272,2406 @@ func (x *ExecutionDoneResponse) GetPayload() []byte {
 type RunJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Parameters    map[string]string      `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Trigger       string                 `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RunJobRequest) Reset() {
 	*x = RunJobRequest{}
-	mi := &file_types_v1_dkron_proto_msgTypes[11]
+	mi := &file_types_v1_dkron_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunJobRequest) ProtoMessage() {}
+
+func (x *RunJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunJobRequest.ProtoReflect.Descriptor instead.
+func (*RunJobRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RunJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *RunJobRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *RunJobRequest) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+type RunJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunJobResponse) Reset() {
+	*x = RunJobResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunJobResponse) ProtoMessage() {}
+
+func (x *RunJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunJobResponse.ProtoReflect.Descriptor instead.
+func (*RunJobResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RunJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type DeleteExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExecutionsRequest) Reset() {
+	*x = DeleteExecutionsRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExecutionsRequest) ProtoMessage() {}
+
+func (x *DeleteExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteExecutionsRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+type DeleteExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExecutionsResponse) Reset() {
+	*x = DeleteExecutionsResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExecutionsResponse) ProtoMessage() {}
+
+func (x *DeleteExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteExecutionsResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ToggleJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleJobRequest) Reset() {
+	*x = ToggleJobRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleJobRequest) ProtoMessage() {}
+
+func (x *ToggleJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleJobRequest.ProtoReflect.Descriptor instead.
+func (*ToggleJobRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ToggleJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+type ToggleJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleJobResponse) Reset() {
+	*x = ToggleJobResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleJobResponse) ProtoMessage() {}
+
+func (x *ToggleJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleJobResponse.ProtoReflect.Descriptor instead.
+func (*ToggleJobResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ToggleJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ParentJobDoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ParentJob     string                 `protobuf:"bytes,2,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
+	WorkflowRun   int64                  `protobuf:"varint,3,opt,name=workflow_run,json=workflowRun,proto3" json:"workflow_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParentJobDoneRequest) Reset() {
+	*x = ParentJobDoneRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParentJobDoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentJobDoneRequest) ProtoMessage() {}
+
+func (x *ParentJobDoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParentJobDoneRequest.ProtoReflect.Descriptor instead.
+func (*ParentJobDoneRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ParentJobDoneRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ParentJobDoneRequest) GetParentJob() string {
+	if x != nil {
+		return x.ParentJob
+	}
+	return ""
+}
+
+func (x *ParentJobDoneRequest) GetWorkflowRun() int64 {
+	if x != nil {
+		return x.WorkflowRun
+	}
+	return 0
+}
+
+type QueueExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	MaxDepth      int32                  `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueExecutionRequest) Reset() {
+	*x = QueueExecutionRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueExecutionRequest) ProtoMessage() {}
+
+func (x *QueueExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueExecutionRequest.ProtoReflect.Descriptor instead.
+func (*QueueExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueueExecutionRequest) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *QueueExecutionRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type DequeueExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DequeueExecutionRequest) Reset() {
+	*x = DequeueExecutionRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DequeueExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueExecutionRequest) ProtoMessage() {}
+
+func (x *DequeueExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DequeueExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DequeueExecutionRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+type PendingRetry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	RunAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingRetry) Reset() {
+	*x = PendingRetry{}
+	mi := &file_types_v1_dkron_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingRetry) ProtoMessage() {}
+
+func (x *PendingRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingRetry.ProtoReflect.Descriptor instead.
+func (*PendingRetry) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PendingRetry) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *PendingRetry) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+type DeletePendingRetryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePendingRetryRequest) Reset() {
+	*x = DeletePendingRetryRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePendingRetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePendingRetryRequest) ProtoMessage() {}
+
+func (x *DeletePendingRetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePendingRetryRequest.ProtoReflect.Descriptor instead.
+func (*DeletePendingRetryRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeletePendingRetryRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *DeletePendingRetryRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type ClaimSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimSlotRequest) Reset() {
+	*x = ClaimSlotRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimSlotRequest) ProtoMessage() {}
+
+func (x *ClaimSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimSlotRequest.ProtoReflect.Descriptor instead.
+func (*ClaimSlotRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClaimSlotRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ClaimSlotRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type WorkflowRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	WorkflowRun   int64                  `protobuf:"varint,2,opt,name=workflow_run,json=workflowRun,proto3" json:"workflow_run,omitempty"`
+	DoneParents   []string               `protobuf:"bytes,3,rep,name=done_parents,json=doneParents,proto3" json:"done_parents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRun) Reset() {
+	*x = WorkflowRun{}
+	mi := &file_types_v1_dkron_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRun) ProtoMessage() {}
+
+func (x *WorkflowRun) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRun.ProtoReflect.Descriptor instead.
+func (*WorkflowRun) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WorkflowRun) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *WorkflowRun) GetWorkflowRun() int64 {
+	if x != nil {
+		return x.WorkflowRun
+	}
+	return 0
+}
+
+func (x *WorkflowRun) GetDoneParents() []string {
+	if x != nil {
+		return x.DoneParents
+	}
+	return nil
+}
+
+type RaftServer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Leader        bool                   `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	Voter         bool                   `protobuf:"varint,5,opt,name=voter,proto3" json:"voter,omitempty"`
+	RaftProtocol  string                 `protobuf:"bytes,6,opt,name=raft_protocol,json=raftProtocol,proto3" json:"raft_protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaftServer) Reset() {
+	*x = RaftServer{}
+	mi := &file_types_v1_dkron_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaftServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftServer) ProtoMessage() {}
+
+func (x *RaftServer) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftServer.ProtoReflect.Descriptor instead.
+func (*RaftServer) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RaftServer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RaftServer) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *RaftServer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RaftServer) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+func (x *RaftServer) GetVoter() bool {
+	if x != nil {
+		return x.Voter
+	}
+	return false
+}
+
+func (x *RaftServer) GetRaftProtocol() string {
+	if x != nil {
+		return x.RaftProtocol
+	}
+	return ""
+}
+
+type RaftGetConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*RaftServer          `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Index         uint64                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaftGetConfigurationResponse) Reset() {
+	*x = RaftGetConfigurationResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaftGetConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftGetConfigurationResponse) ProtoMessage() {}
+
+func (x *RaftGetConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftGetConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*RaftGetConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RaftGetConfigurationResponse) GetServers() []*RaftServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *RaftGetConfigurationResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type RaftRemovePeerByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaftRemovePeerByIDRequest) Reset() {
+	*x = RaftRemovePeerByIDRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaftRemovePeerByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftRemovePeerByIDRequest) ProtoMessage() {}
+
+func (x *RaftRemovePeerByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftRemovePeerByIDRequest.ProtoReflect.Descriptor instead.
+func (*RaftRemovePeerByIDRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RaftRemovePeerByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetActiveExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*Execution           `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveExecutionsResponse) Reset() {
+	*x = GetActiveExecutionsResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveExecutionsResponse) ProtoMessage() {}
+
+func (x *GetActiveExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetActiveExecutionsResponse) GetExecutions() []*Execution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type CancelExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelExecutionRequest) Reset() {
+	*x = CancelExecutionRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelExecutionRequest) ProtoMessage() {}
+
+func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelExecutionRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *CancelExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type CancelExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelExecutionResponse) Reset() {
+	*x = CancelExecutionResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelExecutionResponse) ProtoMessage() {}
+
+func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelExecutionResponse) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+type Calendar struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Timezone         string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Holidays         []string               `protobuf:"bytes,4,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	ExcludedWeekdays []string               `protobuf:"bytes,5,rep,name=excluded_weekdays,json=excludedWeekdays,proto3" json:"excluded_weekdays,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_types_v1_dkron_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Calendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Calendar) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Calendar) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Calendar) GetHolidays() []string {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *Calendar) GetExcludedWeekdays() []string {
+	if x != nil {
+		return x.ExcludedWeekdays
+	}
+	return nil
+}
+
+type SetCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCalendarRequest) Reset() {
+	*x = SetCalendarRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCalendarRequest) ProtoMessage() {}
+
+func (x *SetCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCalendarRequest.ProtoReflect.Descriptor instead.
+func (*SetCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetCalendarRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type SetCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCalendarResponse) Reset() {
+	*x = SetCalendarResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCalendarResponse) ProtoMessage() {}
+
+func (x *SetCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCalendarResponse.ProtoReflect.Descriptor instead.
+func (*SetCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetCalendarResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type DeleteCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarRequest) Reset() {
+	*x = DeleteCalendarRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarResponse) Reset() {
+	*x = DeleteCalendarResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarResponse) ProtoMessage() {}
+
+func (x *DeleteCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteCalendarResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type JobTemplate struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	Name           string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Timezone       string                   `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Owner          string                   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerEmail     string                   `protobuf:"bytes,5,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	Tags           map[string]string        `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata       map[string]string        `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Retries        uint32                   `protobuf:"varint,8,opt,name=retries,proto3" json:"retries,omitempty"`
+	Processors     map[string]*PluginConfig `protobuf:"bytes,9,rep,name=processors,proto3" json:"processors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Executor       string                   `protobuf:"bytes,10,opt,name=executor,proto3" json:"executor,omitempty"`
+	ExecutorConfig map[string]string        `protobuf:"bytes,11,rep,name=executor_config,json=executorConfig,proto3" json:"executor_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobTemplate) Reset() {
+	*x = JobTemplate{}
+	mi := &file_types_v1_dkron_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTemplate) ProtoMessage() {}
+
+func (x *JobTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTemplate.ProtoReflect.Descriptor instead.
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *JobTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *JobTemplate) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *JobTemplate) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetProcessors() map[string]*PluginConfig {
+	if x != nil {
+		return x.Processors
+	}
+	return nil
+}
+
+func (x *JobTemplate) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetExecutorConfig() map[string]string {
+	if x != nil {
+		return x.ExecutorConfig
+	}
+	return nil
+}
+
+type SetJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *JobTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetJobTemplateRequest) Reset() {
+	*x = SetJobTemplateRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetJobTemplateRequest) ProtoMessage() {}
+
+func (x *SetJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetJobTemplateRequest) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SetJobTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *JobTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetJobTemplateResponse) Reset() {
+	*x = SetJobTemplateResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetJobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetJobTemplateResponse) ProtoMessage() {}
+
+func (x *SetJobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetJobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SetJobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetJobTemplateResponse) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobTemplateRequest) Reset() {
+	*x = DeleteJobTemplateRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteJobTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteJobTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *JobTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobTemplateResponse) Reset() {
+	*x = DeleteJobTemplateResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteJobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteJobTemplateResponse) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type Namespace struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description             string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Defaults                *JobTemplate           `protobuf:"bytes,3,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	MaxJobs                 int32                  `protobuf:"varint,4,opt,name=max_jobs,json=maxJobs,proto3" json:"max_jobs,omitempty"`
+	MaxConcurrentExecutions int32                  `protobuf:"varint,5,opt,name=max_concurrent_executions,json=maxConcurrentExecutions,proto3" json:"max_concurrent_executions,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	mi := &file_types_v1_dkron_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Namespace) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Namespace) GetDefaults() *JobTemplate {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *Namespace) GetMaxJobs() int32 {
+	if x != nil {
+		return x.MaxJobs
+	}
+	return 0
+}
+
+func (x *Namespace) GetMaxConcurrentExecutions() int32 {
+	if x != nil {
+		return x.MaxConcurrentExecutions
+	}
+	return 0
+}
+
+type SetNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNamespaceRequest) Reset() {
+	*x = SetNamespaceRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceRequest) ProtoMessage() {}
+
+func (x *SetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetNamespaceRequest) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type SetNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNamespaceResponse) Reset() {
+	*x = SetNamespaceResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceResponse) ProtoMessage() {}
+
+func (x *SetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*SetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type DeleteNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceRequest) Reset() {
+	*x = DeleteNamespaceRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceRequest) ProtoMessage() {}
+
+func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceResponse) Reset() {
+	*x = DeleteNamespaceResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceResponse) ProtoMessage() {}
+
+func (x *DeleteNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type JobVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Job           *Job                   `protobuf:"bytes,5,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobVersion) Reset() {
+	*x = JobVersion{}
+	mi := &file_types_v1_dkron_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobVersion) ProtoMessage() {}
+
+func (x *JobVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobVersion.ProtoReflect.Descriptor instead.
+func (*JobVersion) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *JobVersion) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *JobVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *JobVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JobVersion) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *JobVersion) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Schedule      string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Duration      string                 `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Timezone      string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Selector      map[string]string      `protobuf:"bytes,8,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Policy        string                 `protobuf:"bytes,9,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_types_v1_dkron_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MaintenanceWindow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type SetMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceWindowRequest) Reset() {
+	*x = SetMaintenanceWindowRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type SetMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceWindowResponse) Reset() {
+	*x = SetMaintenanceWindowResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type DeleteMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowResponse) Reset() {
+	*x = DeleteMaintenanceWindowResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type Suppression struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Window        string                 `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	Policy        string                 `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	Trigger       string                 `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	SuppressedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=suppressed_at,json=suppressedAt,proto3" json:"suppressed_at,omitempty"`
+	RunAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suppression) Reset() {
+	*x = Suppression{}
+	mi := &file_types_v1_dkron_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suppression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suppression) ProtoMessage() {}
+
+func (x *Suppression) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suppression.ProtoReflect.Descriptor instead.
+func (*Suppression) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Suppression) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *Suppression) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *Suppression) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *Suppression) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *Suppression) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Suppression) GetSuppressedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuppressedAt
+	}
+	return nil
+}
+
+func (x *Suppression) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+type SetSuppressionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppression   *Suppression           `protobuf:"bytes,1,opt,name=suppression,proto3" json:"suppression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSuppressionRequest) Reset() {
+	*x = SetSuppressionRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSuppressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSuppressionRequest) ProtoMessage() {}
+
+func (x *SetSuppressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSuppressionRequest.ProtoReflect.Descriptor instead.
+func (*SetSuppressionRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetSuppressionRequest) GetSuppression() *Suppression {
+	if x != nil {
+		return x.Suppression
+	}
+	return nil
+}
+
+type DeferredRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	RunAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeferredRun) Reset() {
+	*x = DeferredRun{}
+	mi := &file_types_v1_dkron_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferredRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferredRun) ProtoMessage() {}
+
+func (x *DeferredRun) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferredRun.ProtoReflect.Descriptor instead.
+func (*DeferredRun) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeferredRun) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *DeferredRun) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+type DeleteDeferredRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeferredRunRequest) Reset() {
+	*x = DeleteDeferredRunRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeferredRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeferredRunRequest) ProtoMessage() {}
+
+func (x *DeleteDeferredRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeferredRunRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeferredRunRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteDeferredRunRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *DeleteDeferredRunRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Pause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Selector      map[string]string      `protobuf:"bytes,4,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pause) Reset() {
+	*x = Pause{}
+	mi := &file_types_v1_dkron_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunJobRequest) String() string {
+func (x *Pause) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunJobRequest) ProtoMessage() {}
+func (*Pause) ProtoMessage() {}
 
-func (x *RunJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[11]
+func (x *Pause) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,84 +3682,75 @@ func (x *RunJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunJobRequest.ProtoReflect.Descriptor instead.
-func (*RunJobRequest) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Pause.ProtoReflect.Descriptor instead.
+func (*Pause) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RunJobRequest) GetJobName() string {
+func (x *Pause) GetId() string {
 	if x != nil {
-		return x.JobName
+		return x.Id
 	}
 	return ""
 }
 
-type RunJobResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RunJobResponse) Reset() {
-	*x = RunJobResponse{}
-	mi := &file_types_v1_dkron_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Pause) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *RunJobResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Pause) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
 }
 
-func (*RunJobResponse) ProtoMessage() {}
-
-func (x *RunJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[12]
+func (x *Pause) GetSelector() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Selector
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RunJobResponse.ProtoReflect.Descriptor instead.
-func (*RunJobResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{12}
+func (x *Pause) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *RunJobResponse) GetJob() *Job {
+func (x *Pause) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Job
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type DeleteExecutionsRequest struct {
+type SetPauseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Pause         *Pause                 `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExecutionsRequest) Reset() {
-	*x = DeleteExecutionsRequest{}
-	mi := &file_types_v1_dkron_proto_msgTypes[13]
+func (x *SetPauseRequest) Reset() {
+	*x = SetPauseRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExecutionsRequest) String() string {
+func (x *SetPauseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExecutionsRequest) ProtoMessage() {}
+func (*SetPauseRequest) ProtoMessage() {}
 
-func (x *DeleteExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[13]
+func (x *SetPauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,40 +3761,40 @@ func (x *DeleteExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetPauseRequest.ProtoReflect.Descriptor instead.
+func (*SetPauseRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteExecutionsRequest) GetJobName() string {
+func (x *SetPauseRequest) GetPause() *Pause {
 	if x != nil {
-		return x.JobName
+		return x.Pause
 	}
-	return ""
+	return nil
 }
 
-type DeleteExecutionsResponse struct {
+type SetPauseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Pause         *Pause                 `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExecutionsResponse) Reset() {
-	*x = DeleteExecutionsResponse{}
-	mi := &file_types_v1_dkron_proto_msgTypes[14]
+func (x *SetPauseResponse) Reset() {
+	*x = SetPauseResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExecutionsResponse) String() string {
+func (x *SetPauseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExecutionsResponse) ProtoMessage() {}
+func (*SetPauseResponse) ProtoMessage() {}
 
-func (x *DeleteExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[14]
+func (x *SetPauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,40 +3805,40 @@ func (x *DeleteExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*DeleteExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SetPauseResponse.ProtoReflect.Descriptor instead.
+func (*SetPauseResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DeleteExecutionsResponse) GetJob() *Job {
+func (x *SetPauseResponse) GetPause() *Pause {
 	if x != nil {
-		return x.Job
+		return x.Pause
 	}
 	return nil
 }
 
-type ToggleJobRequest struct {
+type DeletePauseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleJobRequest) Reset() {
-	*x = ToggleJobRequest{}
-	mi := &file_types_v1_dkron_proto_msgTypes[15]
+func (x *DeletePauseRequest) Reset() {
+	*x = DeletePauseRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleJobRequest) String() string {
+func (x *DeletePauseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleJobRequest) ProtoMessage() {}
+func (*DeletePauseRequest) ProtoMessage() {}
 
-func (x *ToggleJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[15]
+func (x *DeletePauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,40 +3849,40 @@ func (x *ToggleJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleJobRequest.ProtoReflect.Descriptor instead.
-func (*ToggleJobRequest) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeletePauseRequest.ProtoReflect.Descriptor instead.
+func (*DeletePauseRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ToggleJobRequest) GetJobName() string {
+func (x *DeletePauseRequest) GetId() string {
 	if x != nil {
-		return x.JobName
+		return x.Id
 	}
 	return ""
 }
 
-type ToggleJobResponse struct {
+type DeletePauseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Pauses        []*Pause               `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleJobResponse) Reset() {
-	*x = ToggleJobResponse{}
-	mi := &file_types_v1_dkron_proto_msgTypes[16]
+func (x *DeletePauseResponse) Reset() {
+	*x = DeletePauseResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleJobResponse) String() string {
+func (x *DeletePauseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleJobResponse) ProtoMessage() {}
+func (*DeletePauseResponse) ProtoMessage() {}
 
-func (x *ToggleJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[16]
+func (x *DeletePauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,45 +3893,44 @@ func (x *ToggleJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleJobResponse.ProtoReflect.Descriptor instead.
-func (*ToggleJobResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeletePauseResponse.ProtoReflect.Descriptor instead.
+func (*DeletePauseResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ToggleJobResponse) GetJob() *Job {
+func (x *DeletePauseResponse) GetPauses() []*Pause {
 	if x != nil {
-		return x.Job
+		return x.Pauses
 	}
 	return nil
 }
 
-type RaftServer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Leader        bool                   `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	Voter         bool                   `protobuf:"varint,5,opt,name=voter,proto3" json:"voter,omitempty"`
-	RaftProtocol  string                 `protobuf:"bytes,6,opt,name=raft_protocol,json=raftProtocol,proto3" json:"raft_protocol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WebhookTrigger struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobName         string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Secret          string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Auth            string                 `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	SignatureHeader string                 `protobuf:"bytes,5,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RaftServer) Reset() {
-	*x = RaftServer{}
-	mi := &file_types_v1_dkron_proto_msgTypes[17]
+func (x *WebhookTrigger) Reset() {
+	*x = WebhookTrigger{}
+	mi := &file_types_v1_dkron_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RaftServer) String() string {
+func (x *WebhookTrigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RaftServer) ProtoMessage() {}
+func (*WebhookTrigger) ProtoMessage() {}
 
-func (x *RaftServer) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[17]
+func (x *WebhookTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,76 +3941,68 @@ func (x *RaftServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RaftServer.ProtoReflect.Descriptor instead.
-func (*RaftServer) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use WebhookTrigger.ProtoReflect.Descriptor instead.
+func (*WebhookTrigger) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *RaftServer) GetId() string {
+func (x *WebhookTrigger) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RaftServer) GetNode() string {
+func (x *WebhookTrigger) GetJobName() string {
 	if x != nil {
-		return x.Node
+		return x.JobName
 	}
 	return ""
 }
 
-func (x *RaftServer) GetAddress() string {
+func (x *WebhookTrigger) GetSecret() string {
 	if x != nil {
-		return x.Address
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *RaftServer) GetLeader() bool {
-	if x != nil {
-		return x.Leader
-	}
-	return false
-}
-
-func (x *RaftServer) GetVoter() bool {
+func (x *WebhookTrigger) GetAuth() string {
 	if x != nil {
-		return x.Voter
+		return x.Auth
 	}
-	return false
+	return ""
 }
 
-func (x *RaftServer) GetRaftProtocol() string {
+func (x *WebhookTrigger) GetSignatureHeader() string {
 	if x != nil {
-		return x.RaftProtocol
+		return x.SignatureHeader
 	}
 	return ""
 }
 
-type RaftGetConfigurationResponse struct {
+type SetWebhookTriggerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Servers       []*RaftServer          `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
-	Index         uint64                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Trigger       *WebhookTrigger        `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RaftGetConfigurationResponse) Reset() {
-	*x = RaftGetConfigurationResponse{}
-	mi := &file_types_v1_dkron_proto_msgTypes[18]
+func (x *SetWebhookTriggerRequest) Reset() {
+	*x = SetWebhookTriggerRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RaftGetConfigurationResponse) String() string {
+func (x *SetWebhookTriggerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RaftGetConfigurationResponse) ProtoMessage() {}
+func (*SetWebhookTriggerRequest) ProtoMessage() {}
 
-func (x *RaftGetConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[18]
+func (x *SetWebhookTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,47 +4013,84 @@ func (x *RaftGetConfigurationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RaftGetConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*RaftGetConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetWebhookTriggerRequest.ProtoReflect.Descriptor instead.
+func (*SetWebhookTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *RaftGetConfigurationResponse) GetServers() []*RaftServer {
+func (x *SetWebhookTriggerRequest) GetTrigger() *WebhookTrigger {
 	if x != nil {
-		return x.Servers
+		return x.Trigger
 	}
 	return nil
 }
 
-func (x *RaftGetConfigurationResponse) GetIndex() uint64 {
+type SetWebhookTriggerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trigger       *WebhookTrigger        `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWebhookTriggerResponse) Reset() {
+	*x = SetWebhookTriggerResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWebhookTriggerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWebhookTriggerResponse) ProtoMessage() {}
+
+func (x *SetWebhookTriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[66]
 	if x != nil {
-		return x.Index
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type RaftRemovePeerByIDRequest struct {
+// Deprecated: Use SetWebhookTriggerResponse.ProtoReflect.Descriptor instead.
+func (*SetWebhookTriggerResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetWebhookTriggerResponse) GetTrigger() *WebhookTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+type DeleteWebhookTriggerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RaftRemovePeerByIDRequest) Reset() {
-	*x = RaftRemovePeerByIDRequest{}
-	mi := &file_types_v1_dkron_proto_msgTypes[19]
+func (x *DeleteWebhookTriggerRequest) Reset() {
+	*x = DeleteWebhookTriggerRequest{}
+	mi := &file_types_v1_dkron_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RaftRemovePeerByIDRequest) String() string {
+func (x *DeleteWebhookTriggerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RaftRemovePeerByIDRequest) ProtoMessage() {}
+func (*DeleteWebhookTriggerRequest) ProtoMessage() {}
 
-func (x *RaftRemovePeerByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[19]
+func (x *DeleteWebhookTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,40 +4101,40 @@ func (x *RaftRemovePeerByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RaftRemovePeerByIDRequest.ProtoReflect.Descriptor instead.
-func (*RaftRemovePeerByIDRequest) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteWebhookTriggerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *RaftRemovePeerByIDRequest) GetId() string {
+func (x *DeleteWebhookTriggerRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetActiveExecutionsResponse struct {
+type DeleteWebhookTriggerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*Execution           `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	Trigger       *WebhookTrigger        `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActiveExecutionsResponse) Reset() {
-	*x = GetActiveExecutionsResponse{}
-	mi := &file_types_v1_dkron_proto_msgTypes[20]
+func (x *DeleteWebhookTriggerResponse) Reset() {
+	*x = DeleteWebhookTriggerResponse{}
+	mi := &file_types_v1_dkron_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActiveExecutionsResponse) String() string {
+func (x *DeleteWebhookTriggerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveExecutionsResponse) ProtoMessage() {}
+func (*DeleteWebhookTriggerResponse) ProtoMessage() {}
 
-func (x *GetActiveExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[20]
+func (x *DeleteWebhookTriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_dkron_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,14 +4145,14 @@ func (x *GetActiveExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*GetActiveExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_dkron_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeleteWebhookTriggerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookTriggerResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_dkron_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetActiveExecutionsResponse) GetExecutions() []*Execution {
+func (x *DeleteWebhookTriggerResponse) GetTrigger() *WebhookTrigger {
 	if x != nil {
-		return x.Executions
+		return x.Trigger
 	}
 	return nil
 }
@@ -1263,7 +4167,7 @@ type Job_NullableTime struct {
 
 func (x *Job_NullableTime) Reset() {
 	*x = Job_NullableTime{}
-	mi := &file_types_v1_dkron_proto_msgTypes[24]
+	mi := &file_types_v1_dkron_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1275,7 +4179,7 @@ func (x *Job_NullableTime) String() string {
 func (*Job_NullableTime) ProtoMessage() {}
 
 func (x *Job_NullableTime) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_dkron_proto_msgTypes[24]
+	mi := &file_types_v1_dkron_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,8 +4213,7 @@ var File_types_v1_dkron_proto protoreflect.FileDescriptor
 
 const file_types_v1_dkron_proto_rawDesc = "" +
 	"\n" +
-	"\x14types/v1/dkron.proto\x12\btypes.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdc\n" +
-	"\n" +
+	"\x14types/v1/dkron.proto\x12\btypes.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x14\n" +
 	"\x03Job\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x1a\n" +
@@ -1344,7 +4247,37 @@ const file_types_v1_dkron_proto_rawDesc = "" +
 	"\tephemeral\x18\x1c \x01(\bR\tephemeral\x129\n" +
 	"\n" +
 	"expires_at\x18\x1d \x01(\v2\x1a.types.v1.Job.NullableTimeR\texpiresAt\x127\n" +
-	"\tstarts_at\x18\x1e \x01(\v2\x1a.types.v1.Job.NullableTimeR\bstartsAt\x1a7\n" +
+	"\tstarts_at\x18\x1e \x01(\v2\x1a.types.v1.Job.NullableTimeR\bstartsAt\x12\x1f\n" +
+	"\vparent_jobs\x18\x1f \x03(\tR\n" +
+	"parentJobs\x12V\n" +
+	"\x13dependency_triggers\x18  \x03(\v2%.types.v1.Job.DependencyTriggersEntryR\x12dependencyTriggers\x12=\n" +
+	"\n" +
+	"parameters\x18! \x03(\v2\x1d.types.v1.Job.ParametersEntryR\n" +
+	"parameters\x12'\n" +
+	"\x0fmax_concurrency\x18\" \x01(\x05R\x0emaxConcurrency\x12&\n" +
+	"\x0fmax_queue_depth\x18# \x01(\x05R\rmaxQueueDepth\x12;\n" +
+	"\rretry_backoff\x18$ \x01(\v2\x16.types.v1.RetryBackoffR\fretryBackoff\x12'\n" +
+	"\x0fretry_placement\x18% \x01(\tR\x0eretryPlacement\x12\x1a\n" +
+	"\bselector\x18& \x01(\tR\bselector\x12 \n" +
+	"\vconstraints\x18' \x03(\tR\vconstraints\x12%\n" +
+	"\x0emisfire_policy\x18( \x01(\tR\rmisfirePolicy\x12#\n" +
+	"\rmisfire_limit\x18) \x01(\x05R\fmisfireLimit\x12#\n" +
+	"\rmisfire_grace\x18* \x01(\tR\fmisfireGrace\x12\x1a\n" +
+	"\bcalendar\x18+ \x01(\tR\bcalendar\x12'\n" +
+	"\x0fcalendar_policy\x18, \x01(\tR\x0ecalendarPolicy\x12\x18\n" +
+	"\atrigger\x18- \x01(\tR\atrigger\x12G\n" +
+	"\x0etrigger_config\x18. \x03(\v2 .types.v1.Job.TriggerConfigEntryR\rtriggerConfig\x12\x1a\n" +
+	"\btemplate\x18/ \x01(\tR\btemplate\x12\x18\n" +
+	"\aversion\x180 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"updated_at\x181 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x182 \x01(\tR\tupdatedBy\x12\x1a\n" +
+	"\brevision\x183 \x01(\x04R\brevision\x12\x1c\n" +
+	"\tnamespace\x184 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\toverrides\x185 \x03(\tR\toverrides\x129\n" +
+	"\n" +
+	"created_at\x186 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
@@ -1359,15 +4292,44 @@ const file_types_v1_dkron_proto_rawDesc = "" +
 	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x1aU\n" +
 	"\x0fProcessorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.types.v1.PluginConfigR\x05value:\x028\x01\"\x85\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.types.v1.PluginConfigR\x05value:\x028\x01\x1aE\n" +
+	"\x17DependencyTriggersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.types.v1.JobParameterR\x05value:\x028\x01\x1a@\n" +
+	"\x12TriggerConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"~\n" +
+	"\fRetryBackoff\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12\x1d\n" +
+	"\n" +
+	"base_delay\x18\x02 \x01(\tR\tbaseDelay\x12\x1b\n" +
+	"\tmax_delay\x18\x03 \x01(\tR\bmaxDelay\x12\x16\n" +
+	"\x06jitter\x18\x04 \x01(\x01R\x06jitter\"q\n" +
+	"\fJobParameter\x12#\n" +
+	"\rdefault_value\x18\x01 \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x85\x01\n" +
 	"\fPluginConfig\x12:\n" +
 	"\x06config\x18\x01 \x03(\v2\".types.v1.PluginConfig.ConfigEntryR\x06config\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"]\n" +
 	"\rSetJobRequest\x12\x1f\n" +
-	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"1\n" +
+	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\x12+\n" +
+	"\x11expected_revision\x18\x02 \x01(\x04R\x10expectedRevision\"1\n" +
 	"\x0eSetJobResponse\x12\x1f\n" +
+	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"\xa4\x01\n" +
+	"\x0fPatchJobRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x1d\n" +
+	"\n" +
+	"patch_type\x18\x02 \x01(\tR\tpatchType\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\fR\x05patch\x12+\n" +
+	"\x11expected_revision\x18\x04 \x01(\x04R\x10expectedRevision\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\"3\n" +
+	"\x10PatchJobResponse\x12\x1f\n" +
 	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"-\n" +
 	"\x10DeleteJobRequest\x12\x19\n" +
 	"\bjob_name\x18\x01 \x01(\tR\ajobName\"4\n" +
@@ -1376,7 +4338,7 @@ const file_types_v1_dkron_proto_rawDesc = "" +
 	"\rGetJobRequest\x12\x19\n" +
 	"\bjob_name\x18\x01 \x01(\tR\ajobName\"1\n" +
 	"\x0eGetJobResponse\x12\x1f\n" +
-	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"\x9d\x02\n" +
+	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"\x9a\x05\n" +
 	"\tExecution\x12\x19\n" +
 	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x16\n" +
@@ -1387,14 +4349,35 @@ const file_types_v1_dkron_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
 	"\vfinished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"finishedAt\"I\n" +
+	"finishedAt\x12!\n" +
+	"\fworkflow_run\x18\t \x01(\x03R\vworkflowRun\x12>\n" +
+	"\x10parent_execution\x18\n" +
+	" \x01(\v2\x13.types.v1.ExecutionR\x0fparentExecution\x12C\n" +
+	"\n" +
+	"parameters\x18\v \x03(\v2#.types.v1.Execution.ParametersEntryR\n" +
+	"parameters\x12\x1c\n" +
+	"\tcancelled\x18\f \x01(\bR\tcancelled\x12=\n" +
+	"\fscheduled_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x18\n" +
+	"\atrigger\x18\x0e \x01(\tR\atrigger\x12\x1d\n" +
+	"\n" +
+	"group_size\x18\x0f \x01(\rR\tgroupSize\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
 	"\x14ExecutionDoneRequest\x121\n" +
 	"\texecution\x18\x01 \x01(\v2\x13.types.v1.ExecutionR\texecution\"E\n" +
 	"\x15ExecutionDoneResponse\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\fR\apayload\"*\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"\xcc\x01\n" +
 	"\rRunJobRequest\x12\x19\n" +
-	"\bjob_name\x18\x01 \x01(\tR\ajobName\"1\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12G\n" +
+	"\n" +
+	"parameters\x18\x02 \x03(\v2'.types.v1.RunJobRequest.ParametersEntryR\n" +
+	"parameters\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"1\n" +
 	"\x0eRunJobResponse\x12\x1f\n" +
 	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"4\n" +
 	"\x17DeleteExecutionsRequest\x12\x19\n" +
@@ -1404,7 +4387,30 @@ const file_types_v1_dkron_proto_rawDesc = "" +
 	"\x10ToggleJobRequest\x12\x19\n" +
 	"\bjob_name\x18\x01 \x01(\tR\ajobName\"4\n" +
 	"\x11ToggleJobResponse\x12\x1f\n" +
-	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"\x9d\x01\n" +
+	"\x03job\x18\x01 \x01(\v2\r.types.v1.JobR\x03job\"y\n" +
+	"\x14ParentJobDoneRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x1d\n" +
+	"\n" +
+	"parent_job\x18\x02 \x01(\tR\tparentJob\x12!\n" +
+	"\fworkflow_run\x18\x03 \x01(\x03R\vworkflowRunJ\x04\b\x04\x10\x05\"g\n" +
+	"\x15QueueExecutionRequest\x121\n" +
+	"\texecution\x18\x01 \x01(\v2\x13.types.v1.ExecutionR\texecution\x12\x1b\n" +
+	"\tmax_depth\x18\x02 \x01(\x05R\bmaxDepth\"4\n" +
+	"\x17DequeueExecutionRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\"t\n" +
+	"\fPendingRetry\x121\n" +
+	"\texecution\x18\x01 \x01(\v2\x13.types.v1.ExecutionR\texecution\x121\n" +
+	"\x06run_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05runAt\"Y\n" +
+	"\x19DeletePendingRetryRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"l\n" +
+	"\x10ClaimSlotRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12=\n" +
+	"\fscheduled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"t\n" +
+	"\vWorkflowRun\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12!\n" +
+	"\fworkflow_run\x18\x02 \x01(\x03R\vworkflowRun\x12!\n" +
+	"\fdone_parents\x18\x03 \x03(\tR\vdoneParentsJ\x04\b\x04\x10\x05\"\x9d\x01\n" +
 	"\n" +
 	"RaftServer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1421,12 +4427,161 @@ const file_types_v1_dkron_proto_rawDesc = "" +
 	"\x1bGetActiveExecutionsResponse\x123\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2\x13.types.v1.ExecutionR\n" +
-	"executions2\xee\x06\n" +
+	"executions\"V\n" +
+	"\x16CancelExecutionRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"L\n" +
+	"\x17CancelExecutionResponse\x121\n" +
+	"\texecution\x18\x01 \x01(\v2\x13.types.v1.ExecutionR\texecution\"\xa5\x01\n" +
+	"\bCalendar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bholidays\x18\x04 \x03(\tR\bholidays\x12+\n" +
+	"\x11excluded_weekdays\x18\x05 \x03(\tR\x10excludedWeekdays\"D\n" +
+	"\x12SetCalendarRequest\x12.\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x12.types.v1.CalendarR\bcalendar\"E\n" +
+	"\x13SetCalendarResponse\x12.\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x12.types.v1.CalendarR\bcalendar\"+\n" +
+	"\x15DeleteCalendarRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"H\n" +
+	"\x16DeleteCalendarResponse\x12.\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x12.types.v1.CalendarR\bcalendar\"\xed\x05\n" +
+	"\vJobTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x1f\n" +
+	"\vowner_email\x18\x05 \x01(\tR\n" +
+	"ownerEmail\x123\n" +
+	"\x04tags\x18\x06 \x03(\v2\x1f.types.v1.JobTemplate.TagsEntryR\x04tags\x12?\n" +
+	"\bmetadata\x18\a \x03(\v2#.types.v1.JobTemplate.MetadataEntryR\bmetadata\x12\x18\n" +
+	"\aretries\x18\b \x01(\rR\aretries\x12E\n" +
+	"\n" +
+	"processors\x18\t \x03(\v2%.types.v1.JobTemplate.ProcessorsEntryR\n" +
+	"processors\x12\x1a\n" +
+	"\bexecutor\x18\n" +
+	" \x01(\tR\bexecutor\x12R\n" +
+	"\x0fexecutor_config\x18\v \x03(\v2).types.v1.JobTemplate.ExecutorConfigEntryR\x0eexecutorConfig\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\x0fProcessorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.types.v1.PluginConfigR\x05value:\x028\x01\x1aA\n" +
+	"\x13ExecutorConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x15SetJobTemplateRequest\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.types.v1.JobTemplateR\btemplate\"K\n" +
+	"\x16SetJobTemplateResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.types.v1.JobTemplateR\btemplate\".\n" +
+	"\x18DeleteJobTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
+	"\x19DeleteJobTemplateResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.types.v1.JobTemplateR\btemplate\"\xcb\x01\n" +
+	"\tNamespace\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\bdefaults\x18\x03 \x01(\v2\x15.types.v1.JobTemplateR\bdefaults\x12\x19\n" +
+	"\bmax_jobs\x18\x04 \x01(\x05R\amaxJobs\x12:\n" +
+	"\x19max_concurrent_executions\x18\x05 \x01(\x05R\x17maxConcurrentExecutions\"H\n" +
+	"\x13SetNamespaceRequest\x121\n" +
+	"\tnamespace\x18\x01 \x01(\v2\x13.types.v1.NamespaceR\tnamespace\"I\n" +
+	"\x14SetNamespaceResponse\x121\n" +
+	"\tnamespace\x18\x01 \x01(\v2\x13.types.v1.NamespaceR\tnamespace\",\n" +
+	"\x16DeleteNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x17DeleteNamespaceResponse\x121\n" +
+	"\tnamespace\x18\x01 \x01(\v2\x13.types.v1.NamespaceR\tnamespace\"\xb3\x01\n" +
+	"\n" +
+	"JobVersion\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x1f\n" +
+	"\x03job\x18\x05 \x01(\v2\r.types.v1.JobR\x03job\"\xa7\x03\n" +
+	"\x11MaintenanceWindow\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1a\n" +
+	"\bschedule\x18\x05 \x01(\tR\bschedule\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\tR\bduration\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12E\n" +
+	"\bselector\x18\b \x03(\v2).types.v1.MaintenanceWindow.SelectorEntryR\bselector\x12\x16\n" +
+	"\x06policy\x18\t \x01(\tR\x06policy\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
+	"\x1bSetMaintenanceWindowRequest\x123\n" +
+	"\x06window\x18\x01 \x01(\v2\x1b.types.v1.MaintenanceWindowR\x06window\"S\n" +
+	"\x1cSetMaintenanceWindowResponse\x123\n" +
+	"\x06window\x18\x01 \x01(\v2\x1b.types.v1.MaintenanceWindowR\x06window\"4\n" +
+	"\x1eDeleteMaintenanceWindowRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"V\n" +
+	"\x1fDeleteMaintenanceWindowResponse\x123\n" +
+	"\x06window\x18\x01 \x01(\v2\x1b.types.v1.MaintenanceWindowR\x06window\"\xa5\x02\n" +
+	"\vSuppression\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x16\n" +
+	"\x06window\x18\x02 \x01(\tR\x06window\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x12\x18\n" +
+	"\atrigger\x18\x04 \x01(\tR\atrigger\x12=\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12?\n" +
+	"\rsuppressed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fsuppressedAt\x121\n" +
+	"\x06run_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05runAt\"P\n" +
+	"\x15SetSuppressionRequest\x127\n" +
+	"\vsuppression\x18\x01 \x01(\v2\x15.types.v1.SuppressionR\vsuppression\"s\n" +
+	"\vDeferredRun\x121\n" +
+	"\texecution\x18\x01 \x01(\v2\x13.types.v1.ExecutionR\texecution\x121\n" +
+	"\x06run_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05runAt\"E\n" +
+	"\x18DeleteDeferredRunRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xb3\x02\n" +
+	"\x05Pause\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x129\n" +
+	"\bselector\x18\x04 \x03(\v2\x1d.types.v1.Pause.SelectorEntryR\bselector\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
+	"\x0fSetPauseRequest\x12%\n" +
+	"\x05pause\x18\x01 \x01(\v2\x0f.types.v1.PauseR\x05pause\"9\n" +
+	"\x10SetPauseResponse\x12%\n" +
+	"\x05pause\x18\x01 \x01(\v2\x0f.types.v1.PauseR\x05pause\"$\n" +
+	"\x12DeletePauseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x13DeletePauseResponse\x12'\n" +
+	"\x06pauses\x18\x01 \x03(\v2\x0f.types.v1.PauseR\x06pauses\"\x92\x01\n" +
+	"\x0eWebhookTrigger\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bjob_name\x18\x02 \x01(\tR\ajobName\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x12\n" +
+	"\x04auth\x18\x04 \x01(\tR\x04auth\x12)\n" +
+	"\x10signature_header\x18\x05 \x01(\tR\x0fsignatureHeader\"N\n" +
+	"\x18SetWebhookTriggerRequest\x122\n" +
+	"\atrigger\x18\x01 \x01(\v2\x18.types.v1.WebhookTriggerR\atrigger\"O\n" +
+	"\x19SetWebhookTriggerResponse\x122\n" +
+	"\atrigger\x18\x01 \x01(\v2\x18.types.v1.WebhookTriggerR\atrigger\"-\n" +
+	"\x1bDeleteWebhookTriggerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x1cDeleteWebhookTriggerResponse\x122\n" +
+	"\atrigger\x18\x01 \x01(\v2\x18.types.v1.WebhookTriggerR\atrigger2\xaf\x10\n" +
 	"\x05Dkron\x12;\n" +
 	"\x06GetJob\x12\x17.types.v1.GetJobRequest\x1a\x18.types.v1.GetJobResponse\x12P\n" +
 	"\rExecutionDone\x12\x1e.types.v1.ExecutionDoneRequest\x1a\x1f.types.v1.ExecutionDoneResponse\x127\n" +
 	"\x05Leave\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12;\n" +
-	"\x06SetJob\x12\x17.types.v1.SetJobRequest\x1a\x18.types.v1.SetJobResponse\x12D\n" +
+	"\x06SetJob\x12\x17.types.v1.SetJobRequest\x1a\x18.types.v1.SetJobResponse\x12A\n" +
+	"\bPatchJob\x12\x19.types.v1.PatchJobRequest\x1a\x1a.types.v1.PatchJobResponse\x12D\n" +
 	"\tDeleteJob\x12\x1a.types.v1.DeleteJobRequest\x1a\x1b.types.v1.DeleteJobResponse\x12;\n" +
 	"\x06RunJob\x12\x17.types.v1.RunJobRequest\x1a\x18.types.v1.RunJobResponse\x12Y\n" +
 	"\x10DeleteExecutions\x12!.types.v1.DeleteExecutionsRequest\x1a\".types.v1.DeleteExecutionsResponse\x12D\n" +
@@ -1434,7 +4589,20 @@ const file_types_v1_dkron_proto_rawDesc = "" +
 	"\x14RaftGetConfiguration\x12\x16.google.protobuf.Empty\x1a&.types.v1.RaftGetConfigurationResponse\x12Q\n" +
 	"\x12RaftRemovePeerByID\x12#.types.v1.RaftRemovePeerByIDRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
 	"\x13GetActiveExecutions\x12\x16.google.protobuf.Empty\x1a%.types.v1.GetActiveExecutionsResponse\x12;\n" +
-	"\fSetExecution\x12\x13.types.v1.Execution\x1a\x16.google.protobuf.EmptyB\x94\x01\n" +
+	"\fSetExecution\x12\x13.types.v1.Execution\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x0fCancelExecution\x12 .types.v1.CancelExecutionRequest\x1a!.types.v1.CancelExecutionResponse\x12J\n" +
+	"\vSetCalendar\x12\x1c.types.v1.SetCalendarRequest\x1a\x1d.types.v1.SetCalendarResponse\x12S\n" +
+	"\x0eDeleteCalendar\x12\x1f.types.v1.DeleteCalendarRequest\x1a .types.v1.DeleteCalendarResponse\x12e\n" +
+	"\x14SetMaintenanceWindow\x12%.types.v1.SetMaintenanceWindowRequest\x1a&.types.v1.SetMaintenanceWindowResponse\x12n\n" +
+	"\x17DeleteMaintenanceWindow\x12(.types.v1.DeleteMaintenanceWindowRequest\x1a).types.v1.DeleteMaintenanceWindowResponse\x12A\n" +
+	"\bSetPause\x12\x19.types.v1.SetPauseRequest\x1a\x1a.types.v1.SetPauseResponse\x12J\n" +
+	"\vDeletePause\x12\x1c.types.v1.DeletePauseRequest\x1a\x1d.types.v1.DeletePauseResponse\x12\\\n" +
+	"\x11SetWebhookTrigger\x12\".types.v1.SetWebhookTriggerRequest\x1a#.types.v1.SetWebhookTriggerResponse\x12e\n" +
+	"\x14DeleteWebhookTrigger\x12%.types.v1.DeleteWebhookTriggerRequest\x1a&.types.v1.DeleteWebhookTriggerResponse\x12S\n" +
+	"\x0eSetJobTemplate\x12\x1f.types.v1.SetJobTemplateRequest\x1a .types.v1.SetJobTemplateResponse\x12\\\n" +
+	"\x11DeleteJobTemplate\x12\".types.v1.DeleteJobTemplateRequest\x1a#.types.v1.DeleteJobTemplateResponse\x12M\n" +
+	"\fSetNamespace\x12\x1d.types.v1.SetNamespaceRequest\x1a\x1e.types.v1.SetNamespaceResponse\x12V\n" +
+	"\x0fDeleteNamespace\x12 .types.v1.DeleteNamespaceRequest\x1a!.types.v1.DeleteNamespaceResponseB\x94\x01\n" +
 	"\fcom.types.v1B\n" +
 	"DkronProtoP\x01Z7github.com/distribworks/dkron/v4/types/types/v1;typesv1\xa2\x02\x03TXX\xaa\x02\bTypes.V1\xca\x02\bTypes\\V1\xe2\x02\x14Types\\V1\\GPBMetadata\xea\x02\tTypes::V1b\x06proto3"
 
@@ -1450,92 +4618,234 @@ func file_types_v1_dkron_proto_rawDescGZIP() []byte {
 	return file_types_v1_dkron_proto_rawDescData
 }
 
-var file_types_v1_dkron_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_types_v1_dkron_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_types_v1_dkron_proto_goTypes = []any{
-	(*Job)(nil),                          // 0: types.v1.Job
-	(*PluginConfig)(nil),                 // 1: types.v1.PluginConfig
-	(*SetJobRequest)(nil),                // 2: types.v1.SetJobRequest
-	(*SetJobResponse)(nil),               // 3: types.v1.SetJobResponse
-	(*DeleteJobRequest)(nil),             // 4: types.v1.DeleteJobRequest
-	(*DeleteJobResponse)(nil),            // 5: types.v1.DeleteJobResponse
-	(*GetJobRequest)(nil),                // 6: types.v1.GetJobRequest
-	(*GetJobResponse)(nil),               // 7: types.v1.GetJobResponse
-	(*Execution)(nil),                    // 8: types.v1.Execution
-	(*ExecutionDoneRequest)(nil),         // 9: types.v1.ExecutionDoneRequest
-	(*ExecutionDoneResponse)(nil),        // 10: types.v1.ExecutionDoneResponse
-	(*RunJobRequest)(nil),                // 11: types.v1.RunJobRequest
-	(*RunJobResponse)(nil),               // 12: types.v1.RunJobResponse
-	(*DeleteExecutionsRequest)(nil),      // 13: types.v1.DeleteExecutionsRequest
-	(*DeleteExecutionsResponse)(nil),     // 14: types.v1.DeleteExecutionsResponse
-	(*ToggleJobRequest)(nil),             // 15: types.v1.ToggleJobRequest
-	(*ToggleJobResponse)(nil),            // 16: types.v1.ToggleJobResponse
-	(*RaftServer)(nil),                   // 17: types.v1.RaftServer
-	(*RaftGetConfigurationResponse)(nil), // 18: types.v1.RaftGetConfigurationResponse
-	(*RaftRemovePeerByIDRequest)(nil),    // 19: types.v1.RaftRemovePeerByIDRequest
-	(*GetActiveExecutionsResponse)(nil),  // 20: types.v1.GetActiveExecutionsResponse
-	nil,                                  // 21: types.v1.Job.TagsEntry
-	nil,                                  // 22: types.v1.Job.ExecutorConfigEntry
-	nil,                                  // 23: types.v1.Job.MetadataEntry
-	(*Job_NullableTime)(nil),             // 24: types.v1.Job.NullableTime
-	nil,                                  // 25: types.v1.Job.ProcessorsEntry
-	nil,                                  // 26: types.v1.PluginConfig.ConfigEntry
-	(*timestamppb.Timestamp)(nil),        // 27: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                // 28: google.protobuf.Empty
+	(*Job)(nil),                             // 0: types.v1.Job
+	(*RetryBackoff)(nil),                    // 1: types.v1.RetryBackoff
+	(*JobParameter)(nil),                    // 2: types.v1.JobParameter
+	(*PluginConfig)(nil),                    // 3: types.v1.PluginConfig
+	(*SetJobRequest)(nil),                   // 4: types.v1.SetJobRequest
+	(*SetJobResponse)(nil),                  // 5: types.v1.SetJobResponse
+	(*PatchJobRequest)(nil),                 // 6: types.v1.PatchJobRequest
+	(*PatchJobResponse)(nil),                // 7: types.v1.PatchJobResponse
+	(*DeleteJobRequest)(nil),                // 8: types.v1.DeleteJobRequest
+	(*DeleteJobResponse)(nil),               // 9: types.v1.DeleteJobResponse
+	(*GetJobRequest)(nil),                   // 10: types.v1.GetJobRequest
+	(*GetJobResponse)(nil),                  // 11: types.v1.GetJobResponse
+	(*Execution)(nil),                       // 12: types.v1.Execution
+	(*ExecutionDoneRequest)(nil),            // 13: types.v1.ExecutionDoneRequest
+	(*ExecutionDoneResponse)(nil),           // 14: types.v1.ExecutionDoneResponse
+	(*RunJobRequest)(nil),                   // 15: types.v1.RunJobRequest
+	(*RunJobResponse)(nil),                  // 16: types.v1.RunJobResponse
+	(*DeleteExecutionsRequest)(nil),         // 17: types.v1.DeleteExecutionsRequest
+	(*DeleteExecutionsResponse)(nil),        // 18: types.v1.DeleteExecutionsResponse
+	(*ToggleJobRequest)(nil),                // 19: types.v1.ToggleJobRequest
+	(*ToggleJobResponse)(nil),               // 20: types.v1.ToggleJobResponse
+	(*ParentJobDoneRequest)(nil),            // 21: types.v1.ParentJobDoneRequest
+	(*QueueExecutionRequest)(nil),           // 22: types.v1.QueueExecutionRequest
+	(*DequeueExecutionRequest)(nil),         // 23: types.v1.DequeueExecutionRequest
+	(*PendingRetry)(nil),                    // 24: types.v1.PendingRetry
+	(*DeletePendingRetryRequest)(nil),       // 25: types.v1.DeletePendingRetryRequest
+	(*ClaimSlotRequest)(nil),                // 26: types.v1.ClaimSlotRequest
+	(*WorkflowRun)(nil),                     // 27: types.v1.WorkflowRun
+	(*RaftServer)(nil),                      // 28: types.v1.RaftServer
+	(*RaftGetConfigurationResponse)(nil),    // 29: types.v1.RaftGetConfigurationResponse
+	(*RaftRemovePeerByIDRequest)(nil),       // 30: types.v1.RaftRemovePeerByIDRequest
+	(*GetActiveExecutionsResponse)(nil),     // 31: types.v1.GetActiveExecutionsResponse
+	(*CancelExecutionRequest)(nil),          // 32: types.v1.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),         // 33: types.v1.CancelExecutionResponse
+	(*Calendar)(nil),                        // 34: types.v1.Calendar
+	(*SetCalendarRequest)(nil),              // 35: types.v1.SetCalendarRequest
+	(*SetCalendarResponse)(nil),             // 36: types.v1.SetCalendarResponse
+	(*DeleteCalendarRequest)(nil),           // 37: types.v1.DeleteCalendarRequest
+	(*DeleteCalendarResponse)(nil),          // 38: types.v1.DeleteCalendarResponse
+	(*JobTemplate)(nil),                     // 39: types.v1.JobTemplate
+	(*SetJobTemplateRequest)(nil),           // 40: types.v1.SetJobTemplateRequest
+	(*SetJobTemplateResponse)(nil),          // 41: types.v1.SetJobTemplateResponse
+	(*DeleteJobTemplateRequest)(nil),        // 42: types.v1.DeleteJobTemplateRequest
+	(*DeleteJobTemplateResponse)(nil),       // 43: types.v1.DeleteJobTemplateResponse
+	(*Namespace)(nil),                       // 44: types.v1.Namespace
+	(*SetNamespaceRequest)(nil),             // 45: types.v1.SetNamespaceRequest
+	(*SetNamespaceResponse)(nil),            // 46: types.v1.SetNamespaceResponse
+	(*DeleteNamespaceRequest)(nil),          // 47: types.v1.DeleteNamespaceRequest
+	(*DeleteNamespaceResponse)(nil),         // 48: types.v1.DeleteNamespaceResponse
+	(*JobVersion)(nil),                      // 49: types.v1.JobVersion
+	(*MaintenanceWindow)(nil),               // 50: types.v1.MaintenanceWindow
+	(*SetMaintenanceWindowRequest)(nil),     // 51: types.v1.SetMaintenanceWindowRequest
+	(*SetMaintenanceWindowResponse)(nil),    // 52: types.v1.SetMaintenanceWindowResponse
+	(*DeleteMaintenanceWindowRequest)(nil),  // 53: types.v1.DeleteMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowResponse)(nil), // 54: types.v1.DeleteMaintenanceWindowResponse
+	(*Suppression)(nil),                     // 55: types.v1.Suppression
+	(*SetSuppressionRequest)(nil),           // 56: types.v1.SetSuppressionRequest
+	(*DeferredRun)(nil),                     // 57: types.v1.DeferredRun
+	(*DeleteDeferredRunRequest)(nil),        // 58: types.v1.DeleteDeferredRunRequest
+	(*Pause)(nil),                           // 59: types.v1.Pause
+	(*SetPauseRequest)(nil),                 // 60: types.v1.SetPauseRequest
+	(*SetPauseResponse)(nil),                // 61: types.v1.SetPauseResponse
+	(*DeletePauseRequest)(nil),              // 62: types.v1.DeletePauseRequest
+	(*DeletePauseResponse)(nil),             // 63: types.v1.DeletePauseResponse
+	(*WebhookTrigger)(nil),                  // 64: types.v1.WebhookTrigger
+	(*SetWebhookTriggerRequest)(nil),        // 65: types.v1.SetWebhookTriggerRequest
+	(*SetWebhookTriggerResponse)(nil),       // 66: types.v1.SetWebhookTriggerResponse
+	(*DeleteWebhookTriggerRequest)(nil),     // 67: types.v1.DeleteWebhookTriggerRequest
+	(*DeleteWebhookTriggerResponse)(nil),    // 68: types.v1.DeleteWebhookTriggerResponse
+	nil,                                     // 69: types.v1.Job.TagsEntry
+	nil,                                     // 70: types.v1.Job.ExecutorConfigEntry
+	nil,                                     // 71: types.v1.Job.MetadataEntry
+	(*Job_NullableTime)(nil),                // 72: types.v1.Job.NullableTime
+	nil,                                     // 73: types.v1.Job.ProcessorsEntry
+	nil,                                     // 74: types.v1.Job.DependencyTriggersEntry
+	nil,                                     // 75: types.v1.Job.ParametersEntry
+	nil,                                     // 76: types.v1.Job.TriggerConfigEntry
+	nil,                                     // 77: types.v1.PluginConfig.ConfigEntry
+	nil,                                     // 78: types.v1.Execution.ParametersEntry
+	nil,                                     // 79: types.v1.RunJobRequest.ParametersEntry
+	nil,                                     // 80: types.v1.JobTemplate.TagsEntry
+	nil,                                     // 81: types.v1.JobTemplate.MetadataEntry
+	nil,                                     // 82: types.v1.JobTemplate.ProcessorsEntry
+	nil,                                     // 83: types.v1.JobTemplate.ExecutorConfigEntry
+	nil,                                     // 84: types.v1.MaintenanceWindow.SelectorEntry
+	nil,                                     // 85: types.v1.Pause.SelectorEntry
+	(*timestamppb.Timestamp)(nil),           // 86: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 87: google.protobuf.Empty
 }
 var file_types_v1_dkron_proto_depIdxs = []int32{
-	21, // 0: types.v1.Job.tags:type_name -> types.v1.Job.TagsEntry
-	22, // 1: types.v1.Job.executor_config:type_name -> types.v1.Job.ExecutorConfigEntry
-	23, // 2: types.v1.Job.metadata:type_name -> types.v1.Job.MetadataEntry
-	24, // 3: types.v1.Job.last_success:type_name -> types.v1.Job.NullableTime
-	24, // 4: types.v1.Job.last_error:type_name -> types.v1.Job.NullableTime
-	27, // 5: types.v1.Job.next:type_name -> google.protobuf.Timestamp
-	25, // 6: types.v1.Job.processors:type_name -> types.v1.Job.ProcessorsEntry
-	24, // 7: types.v1.Job.expires_at:type_name -> types.v1.Job.NullableTime
-	24, // 8: types.v1.Job.starts_at:type_name -> types.v1.Job.NullableTime
-	26, // 9: types.v1.PluginConfig.config:type_name -> types.v1.PluginConfig.ConfigEntry
-	0,  // 10: types.v1.SetJobRequest.job:type_name -> types.v1.Job
-	0,  // 11: types.v1.SetJobResponse.job:type_name -> types.v1.Job
-	0,  // 12: types.v1.DeleteJobResponse.job:type_name -> types.v1.Job
-	0,  // 13: types.v1.GetJobResponse.job:type_name -> types.v1.Job
-	27, // 14: types.v1.Execution.started_at:type_name -> google.protobuf.Timestamp
-	27, // 15: types.v1.Execution.finished_at:type_name -> google.protobuf.Timestamp
-	8,  // 16: types.v1.ExecutionDoneRequest.execution:type_name -> types.v1.Execution
-	0,  // 17: types.v1.RunJobResponse.job:type_name -> types.v1.Job
-	0,  // 18: types.v1.DeleteExecutionsResponse.job:type_name -> types.v1.Job
-	0,  // 19: types.v1.ToggleJobResponse.job:type_name -> types.v1.Job
-	17, // 20: types.v1.RaftGetConfigurationResponse.servers:type_name -> types.v1.RaftServer
-	8,  // 21: types.v1.GetActiveExecutionsResponse.executions:type_name -> types.v1.Execution
-	27, // 22: types.v1.Job.NullableTime.time:type_name -> google.protobuf.Timestamp
-	1,  // 23: types.v1.Job.ProcessorsEntry.value:type_name -> types.v1.PluginConfig
-	6,  // 24: types.v1.Dkron.GetJob:input_type -> types.v1.GetJobRequest
-	9,  // 25: types.v1.Dkron.ExecutionDone:input_type -> types.v1.ExecutionDoneRequest
-	28, // 26: types.v1.Dkron.Leave:input_type -> google.protobuf.Empty
-	2,  // 27: types.v1.Dkron.SetJob:input_type -> types.v1.SetJobRequest
-	4,  // 28: types.v1.Dkron.DeleteJob:input_type -> types.v1.DeleteJobRequest
-	11, // 29: types.v1.Dkron.RunJob:input_type -> types.v1.RunJobRequest
-	13, // 30: types.v1.Dkron.DeleteExecutions:input_type -> types.v1.DeleteExecutionsRequest
-	15, // 31: types.v1.Dkron.ToggleJob:input_type -> types.v1.ToggleJobRequest
-	28, // 32: types.v1.Dkron.RaftGetConfiguration:input_type -> google.protobuf.Empty
-	19, // 33: types.v1.Dkron.RaftRemovePeerByID:input_type -> types.v1.RaftRemovePeerByIDRequest
-	28, // 34: types.v1.Dkron.GetActiveExecutions:input_type -> google.protobuf.Empty
-	8,  // 35: types.v1.Dkron.SetExecution:input_type -> types.v1.Execution
-	7,  // 36: types.v1.Dkron.GetJob:output_type -> types.v1.GetJobResponse
-	10, // 37: types.v1.Dkron.ExecutionDone:output_type -> types.v1.ExecutionDoneResponse
-	28, // 38: types.v1.Dkron.Leave:output_type -> google.protobuf.Empty
-	3,  // 39: types.v1.Dkron.SetJob:output_type -> types.v1.SetJobResponse
-	5,  // 40: types.v1.Dkron.DeleteJob:output_type -> types.v1.DeleteJobResponse
-	12, // 41: types.v1.Dkron.RunJob:output_type -> types.v1.RunJobResponse
-	14, // 42: types.v1.Dkron.DeleteExecutions:output_type -> types.v1.DeleteExecutionsResponse
-	16, // 43: types.v1.Dkron.ToggleJob:output_type -> types.v1.ToggleJobResponse
-	18, // 44: types.v1.Dkron.RaftGetConfiguration:output_type -> types.v1.RaftGetConfigurationResponse
-	28, // 45: types.v1.Dkron.RaftRemovePeerByID:output_type -> google.protobuf.Empty
-	20, // 46: types.v1.Dkron.GetActiveExecutions:output_type -> types.v1.GetActiveExecutionsResponse
-	28, // 47: types.v1.Dkron.SetExecution:output_type -> google.protobuf.Empty
-	36, // [36:48] is the sub-list for method output_type
-	24, // [24:36] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	69,  // 0: types.v1.Job.tags:type_name -> types.v1.Job.TagsEntry
+	70,  // 1: types.v1.Job.executor_config:type_name -> types.v1.Job.ExecutorConfigEntry
+	71,  // 2: types.v1.Job.metadata:type_name -> types.v1.Job.MetadataEntry
+	72,  // 3: types.v1.Job.last_success:type_name -> types.v1.Job.NullableTime
+	72,  // 4: types.v1.Job.last_error:type_name -> types.v1.Job.NullableTime
+	86,  // 5: types.v1.Job.next:type_name -> google.protobuf.Timestamp
+	73,  // 6: types.v1.Job.processors:type_name -> types.v1.Job.ProcessorsEntry
+	72,  // 7: types.v1.Job.expires_at:type_name -> types.v1.Job.NullableTime
+	72,  // 8: types.v1.Job.starts_at:type_name -> types.v1.Job.NullableTime
+	74,  // 9: types.v1.Job.dependency_triggers:type_name -> types.v1.Job.DependencyTriggersEntry
+	75,  // 10: types.v1.Job.parameters:type_name -> types.v1.Job.ParametersEntry
+	1,   // 11: types.v1.Job.retry_backoff:type_name -> types.v1.RetryBackoff
+	76,  // 12: types.v1.Job.trigger_config:type_name -> types.v1.Job.TriggerConfigEntry
+	86,  // 13: types.v1.Job.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 14: types.v1.Job.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 15: types.v1.PluginConfig.config:type_name -> types.v1.PluginConfig.ConfigEntry
+	0,   // 16: types.v1.SetJobRequest.job:type_name -> types.v1.Job
+	0,   // 17: types.v1.SetJobResponse.job:type_name -> types.v1.Job
+	0,   // 18: types.v1.PatchJobResponse.job:type_name -> types.v1.Job
+	0,   // 19: types.v1.DeleteJobResponse.job:type_name -> types.v1.Job
+	0,   // 20: types.v1.GetJobResponse.job:type_name -> types.v1.Job
+	86,  // 21: types.v1.Execution.started_at:type_name -> google.protobuf.Timestamp
+	86,  // 22: types.v1.Execution.finished_at:type_name -> google.protobuf.Timestamp
+	12,  // 23: types.v1.Execution.parent_execution:type_name -> types.v1.Execution
+	78,  // 24: types.v1.Execution.parameters:type_name -> types.v1.Execution.ParametersEntry
+	86,  // 25: types.v1.Execution.scheduled_at:type_name -> google.protobuf.Timestamp
+	12,  // 26: types.v1.ExecutionDoneRequest.execution:type_name -> types.v1.Execution
+	79,  // 27: types.v1.RunJobRequest.parameters:type_name -> types.v1.RunJobRequest.ParametersEntry
+	0,   // 28: types.v1.RunJobResponse.job:type_name -> types.v1.Job
+	0,   // 29: types.v1.DeleteExecutionsResponse.job:type_name -> types.v1.Job
+	0,   // 30: types.v1.ToggleJobResponse.job:type_name -> types.v1.Job
+	12,  // 31: types.v1.QueueExecutionRequest.execution:type_name -> types.v1.Execution
+	12,  // 32: types.v1.PendingRetry.execution:type_name -> types.v1.Execution
+	86,  // 33: types.v1.PendingRetry.run_at:type_name -> google.protobuf.Timestamp
+	86,  // 34: types.v1.ClaimSlotRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	28,  // 35: types.v1.RaftGetConfigurationResponse.servers:type_name -> types.v1.RaftServer
+	12,  // 36: types.v1.GetActiveExecutionsResponse.executions:type_name -> types.v1.Execution
+	12,  // 37: types.v1.CancelExecutionResponse.execution:type_name -> types.v1.Execution
+	34,  // 38: types.v1.SetCalendarRequest.calendar:type_name -> types.v1.Calendar
+	34,  // 39: types.v1.SetCalendarResponse.calendar:type_name -> types.v1.Calendar
+	34,  // 40: types.v1.DeleteCalendarResponse.calendar:type_name -> types.v1.Calendar
+	80,  // 41: types.v1.JobTemplate.tags:type_name -> types.v1.JobTemplate.TagsEntry
+	81,  // 42: types.v1.JobTemplate.metadata:type_name -> types.v1.JobTemplate.MetadataEntry
+	82,  // 43: types.v1.JobTemplate.processors:type_name -> types.v1.JobTemplate.ProcessorsEntry
+	83,  // 44: types.v1.JobTemplate.executor_config:type_name -> types.v1.JobTemplate.ExecutorConfigEntry
+	39,  // 45: types.v1.SetJobTemplateRequest.template:type_name -> types.v1.JobTemplate
+	39,  // 46: types.v1.SetJobTemplateResponse.template:type_name -> types.v1.JobTemplate
+	39,  // 47: types.v1.DeleteJobTemplateResponse.template:type_name -> types.v1.JobTemplate
+	39,  // 48: types.v1.Namespace.defaults:type_name -> types.v1.JobTemplate
+	44,  // 49: types.v1.SetNamespaceRequest.namespace:type_name -> types.v1.Namespace
+	44,  // 50: types.v1.SetNamespaceResponse.namespace:type_name -> types.v1.Namespace
+	44,  // 51: types.v1.DeleteNamespaceResponse.namespace:type_name -> types.v1.Namespace
+	86,  // 52: types.v1.JobVersion.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 53: types.v1.JobVersion.job:type_name -> types.v1.Job
+	86,  // 54: types.v1.MaintenanceWindow.starts_at:type_name -> google.protobuf.Timestamp
+	86,  // 55: types.v1.MaintenanceWindow.ends_at:type_name -> google.protobuf.Timestamp
+	84,  // 56: types.v1.MaintenanceWindow.selector:type_name -> types.v1.MaintenanceWindow.SelectorEntry
+	50,  // 57: types.v1.SetMaintenanceWindowRequest.window:type_name -> types.v1.MaintenanceWindow
+	50,  // 58: types.v1.SetMaintenanceWindowResponse.window:type_name -> types.v1.MaintenanceWindow
+	50,  // 59: types.v1.DeleteMaintenanceWindowResponse.window:type_name -> types.v1.MaintenanceWindow
+	86,  // 60: types.v1.Suppression.scheduled_at:type_name -> google.protobuf.Timestamp
+	86,  // 61: types.v1.Suppression.suppressed_at:type_name -> google.protobuf.Timestamp
+	86,  // 62: types.v1.Suppression.run_at:type_name -> google.protobuf.Timestamp
+	55,  // 63: types.v1.SetSuppressionRequest.suppression:type_name -> types.v1.Suppression
+	12,  // 64: types.v1.DeferredRun.execution:type_name -> types.v1.Execution
+	86,  // 65: types.v1.DeferredRun.run_at:type_name -> google.protobuf.Timestamp
+	85,  // 66: types.v1.Pause.selector:type_name -> types.v1.Pause.SelectorEntry
+	86,  // 67: types.v1.Pause.created_at:type_name -> google.protobuf.Timestamp
+	86,  // 68: types.v1.Pause.expires_at:type_name -> google.protobuf.Timestamp
+	59,  // 69: types.v1.SetPauseRequest.pause:type_name -> types.v1.Pause
+	59,  // 70: types.v1.SetPauseResponse.pause:type_name -> types.v1.Pause
+	59,  // 71: types.v1.DeletePauseResponse.pauses:type_name -> types.v1.Pause
+	64,  // 72: types.v1.SetWebhookTriggerRequest.trigger:type_name -> types.v1.WebhookTrigger
+	64,  // 73: types.v1.SetWebhookTriggerResponse.trigger:type_name -> types.v1.WebhookTrigger
+	64,  // 74: types.v1.DeleteWebhookTriggerResponse.trigger:type_name -> types.v1.WebhookTrigger
+	86,  // 75: types.v1.Job.NullableTime.time:type_name -> google.protobuf.Timestamp
+	3,   // 76: types.v1.Job.ProcessorsEntry.value:type_name -> types.v1.PluginConfig
+	2,   // 77: types.v1.Job.ParametersEntry.value:type_name -> types.v1.JobParameter
+	3,   // 78: types.v1.JobTemplate.ProcessorsEntry.value:type_name -> types.v1.PluginConfig
+	10,  // 79: types.v1.Dkron.GetJob:input_type -> types.v1.GetJobRequest
+	13,  // 80: types.v1.Dkron.ExecutionDone:input_type -> types.v1.ExecutionDoneRequest
+	87,  // 81: types.v1.Dkron.Leave:input_type -> google.protobuf.Empty
+	4,   // 82: types.v1.Dkron.SetJob:input_type -> types.v1.SetJobRequest
+	6,   // 83: types.v1.Dkron.PatchJob:input_type -> types.v1.PatchJobRequest
+	8,   // 84: types.v1.Dkron.DeleteJob:input_type -> types.v1.DeleteJobRequest
+	15,  // 85: types.v1.Dkron.RunJob:input_type -> types.v1.RunJobRequest
+	17,  // 86: types.v1.Dkron.DeleteExecutions:input_type -> types.v1.DeleteExecutionsRequest
+	19,  // 87: types.v1.Dkron.ToggleJob:input_type -> types.v1.ToggleJobRequest
+	87,  // 88: types.v1.Dkron.RaftGetConfiguration:input_type -> google.protobuf.Empty
+	30,  // 89: types.v1.Dkron.RaftRemovePeerByID:input_type -> types.v1.RaftRemovePeerByIDRequest
+	87,  // 90: types.v1.Dkron.GetActiveExecutions:input_type -> google.protobuf.Empty
+	12,  // 91: types.v1.Dkron.SetExecution:input_type -> types.v1.Execution
+	32,  // 92: types.v1.Dkron.CancelExecution:input_type -> types.v1.CancelExecutionRequest
+	35,  // 93: types.v1.Dkron.SetCalendar:input_type -> types.v1.SetCalendarRequest
+	37,  // 94: types.v1.Dkron.DeleteCalendar:input_type -> types.v1.DeleteCalendarRequest
+	51,  // 95: types.v1.Dkron.SetMaintenanceWindow:input_type -> types.v1.SetMaintenanceWindowRequest
+	53,  // 96: types.v1.Dkron.DeleteMaintenanceWindow:input_type -> types.v1.DeleteMaintenanceWindowRequest
+	60,  // 97: types.v1.Dkron.SetPause:input_type -> types.v1.SetPauseRequest
+	62,  // 98: types.v1.Dkron.DeletePause:input_type -> types.v1.DeletePauseRequest
+	65,  // 99: types.v1.Dkron.SetWebhookTrigger:input_type -> types.v1.SetWebhookTriggerRequest
+	67,  // 100: types.v1.Dkron.DeleteWebhookTrigger:input_type -> types.v1.DeleteWebhookTriggerRequest
+	40,  // 101: types.v1.Dkron.SetJobTemplate:input_type -> types.v1.SetJobTemplateRequest
+	42,  // 102: types.v1.Dkron.DeleteJobTemplate:input_type -> types.v1.DeleteJobTemplateRequest
+	45,  // 103: types.v1.Dkron.SetNamespace:input_type -> types.v1.SetNamespaceRequest
+	47,  // 104: types.v1.Dkron.DeleteNamespace:input_type -> types.v1.DeleteNamespaceRequest
+	11,  // 105: types.v1.Dkron.GetJob:output_type -> types.v1.GetJobResponse
+	14,  // 106: types.v1.Dkron.ExecutionDone:output_type -> types.v1.ExecutionDoneResponse
+	87,  // 107: types.v1.Dkron.Leave:output_type -> google.protobuf.Empty
+	5,   // 108: types.v1.Dkron.SetJob:output_type -> types.v1.SetJobResponse
+	7,   // 109: types.v1.Dkron.PatchJob:output_type -> types.v1.PatchJobResponse
+	9,   // 110: types.v1.Dkron.DeleteJob:output_type -> types.v1.DeleteJobResponse
+	16,  // 111: types.v1.Dkron.RunJob:output_type -> types.v1.RunJobResponse
+	18,  // 112: types.v1.Dkron.DeleteExecutions:output_type -> types.v1.DeleteExecutionsResponse
+	20,  // 113: types.v1.Dkron.ToggleJob:output_type -> types.v1.ToggleJobResponse
+	29,  // 114: types.v1.Dkron.RaftGetConfiguration:output_type -> types.v1.RaftGetConfigurationResponse
+	87,  // 115: types.v1.Dkron.RaftRemovePeerByID:output_type -> google.protobuf.Empty
+	31,  // 116: types.v1.Dkron.GetActiveExecutions:output_type -> types.v1.GetActiveExecutionsResponse
+	87,  // 117: types.v1.Dkron.SetExecution:output_type -> google.protobuf.Empty
+	33,  // 118: types.v1.Dkron.CancelExecution:output_type -> types.v1.CancelExecutionResponse
+	36,  // 119: types.v1.Dkron.SetCalendar:output_type -> types.v1.SetCalendarResponse
+	38,  // 120: types.v1.Dkron.DeleteCalendar:output_type -> types.v1.DeleteCalendarResponse
+	52,  // 121: types.v1.Dkron.SetMaintenanceWindow:output_type -> types.v1.SetMaintenanceWindowResponse
+	54,  // 122: types.v1.Dkron.DeleteMaintenanceWindow:output_type -> types.v1.DeleteMaintenanceWindowResponse
+	61,  // 123: types.v1.Dkron.SetPause:output_type -> types.v1.SetPauseResponse
+	63,  // 124: types.v1.Dkron.DeletePause:output_type -> types.v1.DeletePauseResponse
+	66,  // 125: types.v1.Dkron.SetWebhookTrigger:output_type -> types.v1.SetWebhookTriggerResponse
+	68,  // 126: types.v1.Dkron.DeleteWebhookTrigger:output_type -> types.v1.DeleteWebhookTriggerResponse
+	41,  // 127: types.v1.Dkron.SetJobTemplate:output_type -> types.v1.SetJobTemplateResponse
+	43,  // 128: types.v1.Dkron.DeleteJobTemplate:output_type -> types.v1.DeleteJobTemplateResponse
+	46,  // 129: types.v1.Dkron.SetNamespace:output_type -> types.v1.SetNamespaceResponse
+	48,  // 130: types.v1.Dkron.DeleteNamespace:output_type -> types.v1.DeleteNamespaceResponse
+	105, // [105:131] is the sub-list for method output_type
+	79,  // [79:105] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_types_v1_dkron_proto_init() }
@@ -1549,7 +4859,7 @@ func file_types_v1_dkron_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_v1_dkron_proto_rawDesc), len(file_types_v1_dkron_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
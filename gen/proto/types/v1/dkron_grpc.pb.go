@@ -22,18 +22,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Dkron_GetJob_FullMethodName               = "/types.v1.Dkron/GetJob"
-	Dkron_ExecutionDone_FullMethodName        = "/types.v1.Dkron/ExecutionDone"
-	Dkron_Leave_FullMethodName                = "/types.v1.Dkron/Leave"
-	Dkron_SetJob_FullMethodName               = "/types.v1.Dkron/SetJob"
-	Dkron_DeleteJob_FullMethodName            = "/types.v1.Dkron/DeleteJob"
-	Dkron_RunJob_FullMethodName               = "/types.v1.Dkron/RunJob"
-	Dkron_DeleteExecutions_FullMethodName     = "/types.v1.Dkron/DeleteExecutions"
-	Dkron_ToggleJob_FullMethodName            = "/types.v1.Dkron/ToggleJob"
-	Dkron_RaftGetConfiguration_FullMethodName = "/types.v1.Dkron/RaftGetConfiguration"
-	Dkron_RaftRemovePeerByID_FullMethodName   = "/types.v1.Dkron/RaftRemovePeerByID"
-	Dkron_GetActiveExecutions_FullMethodName  = "/types.v1.Dkron/GetActiveExecutions"
-	Dkron_SetExecution_FullMethodName         = "/types.v1.Dkron/SetExecution"
+	Dkron_GetJob_FullMethodName                  = "/types.v1.Dkron/GetJob"
+	Dkron_ExecutionDone_FullMethodName           = "/types.v1.Dkron/ExecutionDone"
+	Dkron_Leave_FullMethodName                   = "/types.v1.Dkron/Leave"
+	Dkron_SetJob_FullMethodName                  = "/types.v1.Dkron/SetJob"
+	Dkron_PatchJob_FullMethodName                = "/types.v1.Dkron/PatchJob"
+	Dkron_DeleteJob_FullMethodName               = "/types.v1.Dkron/DeleteJob"
+	Dkron_RunJob_FullMethodName                  = "/types.v1.Dkron/RunJob"
+	Dkron_DeleteExecutions_FullMethodName        = "/types.v1.Dkron/DeleteExecutions"
+	Dkron_ToggleJob_FullMethodName               = "/types.v1.Dkron/ToggleJob"
+	Dkron_RaftGetConfiguration_FullMethodName    = "/types.v1.Dkron/RaftGetConfiguration"
+	Dkron_RaftRemovePeerByID_FullMethodName      = "/types.v1.Dkron/RaftRemovePeerByID"
+	Dkron_GetActiveExecutions_FullMethodName     = "/types.v1.Dkron/GetActiveExecutions"
+	Dkron_SetExecution_FullMethodName            = "/types.v1.Dkron/SetExecution"
+	Dkron_CancelExecution_FullMethodName         = "/types.v1.Dkron/CancelExecution"
+	Dkron_SetCalendar_FullMethodName             = "/types.v1.Dkron/SetCalendar"
+	Dkron_DeleteCalendar_FullMethodName          = "/types.v1.Dkron/DeleteCalendar"
+	Dkron_SetMaintenanceWindow_FullMethodName    = "/types.v1.Dkron/SetMaintenanceWindow"
+	Dkron_DeleteMaintenanceWindow_FullMethodName = "/types.v1.Dkron/DeleteMaintenanceWindow"
+	Dkron_SetPause_FullMethodName                = "/types.v1.Dkron/SetPause"
+	Dkron_DeletePause_FullMethodName             = "/types.v1.Dkron/DeletePause"
+	Dkron_SetWebhookTrigger_FullMethodName       = "/types.v1.Dkron/SetWebhookTrigger"
+	Dkron_DeleteWebhookTrigger_FullMethodName    = "/types.v1.Dkron/DeleteWebhookTrigger"
+	Dkron_SetJobTemplate_FullMethodName          = "/types.v1.Dkron/SetJobTemplate"
+	Dkron_DeleteJobTemplate_FullMethodName       = "/types.v1.Dkron/DeleteJobTemplate"
+	Dkron_SetNamespace_FullMethodName            = "/types.v1.Dkron/SetNamespace"
+	Dkron_DeleteNamespace_FullMethodName         = "/types.v1.Dkron/DeleteNamespace"
 )
 
 // DkronClient is the client API for Dkron service.
@@ -49,6 +63,7 @@ type DkronClient interface {
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	Leave(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetJob(ctx context.Context, in *SetJobRequest, opts ...grpc.CallOption) (*SetJobResponse, error)
+	PatchJob(ctx context.Context, in *PatchJobRequest, opts ...grpc.CallOption) (*PatchJobResponse, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
 	RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*RunJobResponse, error)
 	DeleteExecutions(ctx context.Context, in *DeleteExecutionsRequest, opts ...grpc.CallOption) (*DeleteExecutionsResponse, error)
@@ -61,6 +76,19 @@ type DkronClient interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	SetExecution(ctx context.Context, in *Execution, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
+	SetCalendar(ctx context.Context, in *SetCalendarRequest, opts ...grpc.CallOption) (*SetCalendarResponse, error)
+	DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*DeleteCalendarResponse, error)
+	SetMaintenanceWindow(ctx context.Context, in *SetMaintenanceWindowRequest, opts ...grpc.CallOption) (*SetMaintenanceWindowResponse, error)
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error)
+	SetPause(ctx context.Context, in *SetPauseRequest, opts ...grpc.CallOption) (*SetPauseResponse, error)
+	DeletePause(ctx context.Context, in *DeletePauseRequest, opts ...grpc.CallOption) (*DeletePauseResponse, error)
+	SetWebhookTrigger(ctx context.Context, in *SetWebhookTriggerRequest, opts ...grpc.CallOption) (*SetWebhookTriggerResponse, error)
+	DeleteWebhookTrigger(ctx context.Context, in *DeleteWebhookTriggerRequest, opts ...grpc.CallOption) (*DeleteWebhookTriggerResponse, error)
+	SetJobTemplate(ctx context.Context, in *SetJobTemplateRequest, opts ...grpc.CallOption) (*SetJobTemplateResponse, error)
+	DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*DeleteJobTemplateResponse, error)
+	SetNamespace(ctx context.Context, in *SetNamespaceRequest, opts ...grpc.CallOption) (*SetNamespaceResponse, error)
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
 }
 
 type dkronClient struct {
@@ -111,6 +139,16 @@ func (c *dkronClient) SetJob(ctx context.Context, in *SetJobRequest, opts ...grp
 	return out, nil
 }
 
+func (c *dkronClient) PatchJob(ctx context.Context, in *PatchJobRequest, opts ...grpc.CallOption) (*PatchJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchJobResponse)
+	err := c.cc.Invoke(ctx, Dkron_PatchJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dkronClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteJobResponse)
@@ -191,6 +229,136 @@ func (c *dkronClient) SetExecution(ctx context.Context, in *Execution, opts ...g
 	return out, nil
 }
 
+func (c *dkronClient) CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelExecutionResponse)
+	err := c.cc.Invoke(ctx, Dkron_CancelExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) SetCalendar(ctx context.Context, in *SetCalendarRequest, opts ...grpc.CallOption) (*SetCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCalendarResponse)
+	err := c.cc.Invoke(ctx, Dkron_SetCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*DeleteCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCalendarResponse)
+	err := c.cc.Invoke(ctx, Dkron_DeleteCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) SetMaintenanceWindow(ctx context.Context, in *SetMaintenanceWindowRequest, opts ...grpc.CallOption) (*SetMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Dkron_SetMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Dkron_DeleteMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) SetPause(ctx context.Context, in *SetPauseRequest, opts ...grpc.CallOption) (*SetPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPauseResponse)
+	err := c.cc.Invoke(ctx, Dkron_SetPause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) DeletePause(ctx context.Context, in *DeletePauseRequest, opts ...grpc.CallOption) (*DeletePauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePauseResponse)
+	err := c.cc.Invoke(ctx, Dkron_DeletePause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) SetWebhookTrigger(ctx context.Context, in *SetWebhookTriggerRequest, opts ...grpc.CallOption) (*SetWebhookTriggerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWebhookTriggerResponse)
+	err := c.cc.Invoke(ctx, Dkron_SetWebhookTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) DeleteWebhookTrigger(ctx context.Context, in *DeleteWebhookTriggerRequest, opts ...grpc.CallOption) (*DeleteWebhookTriggerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookTriggerResponse)
+	err := c.cc.Invoke(ctx, Dkron_DeleteWebhookTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) SetJobTemplate(ctx context.Context, in *SetJobTemplateRequest, opts ...grpc.CallOption) (*SetJobTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetJobTemplateResponse)
+	err := c.cc.Invoke(ctx, Dkron_SetJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*DeleteJobTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteJobTemplateResponse)
+	err := c.cc.Invoke(ctx, Dkron_DeleteJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) SetNamespace(ctx context.Context, in *SetNamespaceRequest, opts ...grpc.CallOption) (*SetNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNamespaceResponse)
+	err := c.cc.Invoke(ctx, Dkron_SetNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dkronClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, Dkron_DeleteNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DkronServer is the server API for Dkron service.
 // All implementations must embed UnimplementedDkronServer
 // for forward compatibility.
@@ -204,6 +372,7 @@ type DkronServer interface {
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	Leave(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	SetJob(context.Context, *SetJobRequest) (*SetJobResponse, error)
+	PatchJob(context.Context, *PatchJobRequest) (*PatchJobResponse, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
 	RunJob(context.Context, *RunJobRequest) (*RunJobResponse, error)
 	DeleteExecutions(context.Context, *DeleteExecutionsRequest) (*DeleteExecutionsResponse, error)
@@ -216,6 +385,19 @@ type DkronServer interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	SetExecution(context.Context, *Execution) (*emptypb.Empty, error)
+	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
+	SetCalendar(context.Context, *SetCalendarRequest) (*SetCalendarResponse, error)
+	DeleteCalendar(context.Context, *DeleteCalendarRequest) (*DeleteCalendarResponse, error)
+	SetMaintenanceWindow(context.Context, *SetMaintenanceWindowRequest) (*SetMaintenanceWindowResponse, error)
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error)
+	SetPause(context.Context, *SetPauseRequest) (*SetPauseResponse, error)
+	DeletePause(context.Context, *DeletePauseRequest) (*DeletePauseResponse, error)
+	SetWebhookTrigger(context.Context, *SetWebhookTriggerRequest) (*SetWebhookTriggerResponse, error)
+	DeleteWebhookTrigger(context.Context, *DeleteWebhookTriggerRequest) (*DeleteWebhookTriggerResponse, error)
+	SetJobTemplate(context.Context, *SetJobTemplateRequest) (*SetJobTemplateResponse, error)
+	DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*DeleteJobTemplateResponse, error)
+	SetNamespace(context.Context, *SetNamespaceRequest) (*SetNamespaceResponse, error)
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
 	mustEmbedUnimplementedDkronServer()
 }
 
@@ -238,6 +420,9 @@ func (UnimplementedDkronServer) Leave(context.Context, *emptypb.Empty) (*emptypb
 func (UnimplementedDkronServer) SetJob(context.Context, *SetJobRequest) (*SetJobResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetJob not implemented")
 }
+func (UnimplementedDkronServer) PatchJob(context.Context, *PatchJobRequest) (*PatchJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchJob not implemented")
+}
 func (UnimplementedDkronServer) DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteJob not implemented")
 }
@@ -262,6 +447,45 @@ func (UnimplementedDkronServer) GetActiveExecutions(context.Context, *emptypb.Em
 func (UnimplementedDkronServer) SetExecution(context.Context, *Execution) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetExecution not implemented")
 }
+func (UnimplementedDkronServer) CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
+}
+func (UnimplementedDkronServer) SetCalendar(context.Context, *SetCalendarRequest) (*SetCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCalendar not implemented")
+}
+func (UnimplementedDkronServer) DeleteCalendar(context.Context, *DeleteCalendarRequest) (*DeleteCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCalendar not implemented")
+}
+func (UnimplementedDkronServer) SetMaintenanceWindow(context.Context, *SetMaintenanceWindowRequest) (*SetMaintenanceWindowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMaintenanceWindow not implemented")
+}
+func (UnimplementedDkronServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
+func (UnimplementedDkronServer) SetPause(context.Context, *SetPauseRequest) (*SetPauseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPause not implemented")
+}
+func (UnimplementedDkronServer) DeletePause(context.Context, *DeletePauseRequest) (*DeletePauseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePause not implemented")
+}
+func (UnimplementedDkronServer) SetWebhookTrigger(context.Context, *SetWebhookTriggerRequest) (*SetWebhookTriggerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetWebhookTrigger not implemented")
+}
+func (UnimplementedDkronServer) DeleteWebhookTrigger(context.Context, *DeleteWebhookTriggerRequest) (*DeleteWebhookTriggerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhookTrigger not implemented")
+}
+func (UnimplementedDkronServer) SetJobTemplate(context.Context, *SetJobTemplateRequest) (*SetJobTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetJobTemplate not implemented")
+}
+func (UnimplementedDkronServer) DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*DeleteJobTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteJobTemplate not implemented")
+}
+func (UnimplementedDkronServer) SetNamespace(context.Context, *SetNamespaceRequest) (*SetNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNamespace not implemented")
+}
+func (UnimplementedDkronServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
 func (UnimplementedDkronServer) mustEmbedUnimplementedDkronServer() {}
 func (UnimplementedDkronServer) testEmbeddedByValue()               {}
 
@@ -355,6 +579,24 @@ func _Dkron_SetJob_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dkron_PatchJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).PatchJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_PatchJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).PatchJob(ctx, req.(*PatchJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dkron_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteJobRequest)
 	if err := dec(in); err != nil {
@@ -499,6 +741,240 @@ func _Dkron_SetExecution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dkron_CancelExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).CancelExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_CancelExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).CancelExecution(ctx, req.(*CancelExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_SetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).SetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_SetCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).SetCalendar(ctx, req.(*SetCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_DeleteCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).DeleteCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_DeleteCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).DeleteCalendar(ctx, req.(*DeleteCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_SetMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).SetMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_SetMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).SetMaintenanceWindow(ctx, req.(*SetMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_DeleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_SetPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).SetPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_SetPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).SetPause(ctx, req.(*SetPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_DeletePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).DeletePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_DeletePause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).DeletePause(ctx, req.(*DeletePauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_SetWebhookTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWebhookTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).SetWebhookTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_SetWebhookTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).SetWebhookTrigger(ctx, req.(*SetWebhookTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_DeleteWebhookTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).DeleteWebhookTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_DeleteWebhookTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).DeleteWebhookTrigger(ctx, req.(*DeleteWebhookTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_SetJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).SetJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_SetJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).SetJobTemplate(ctx, req.(*SetJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_DeleteJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).DeleteJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_DeleteJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).DeleteJobTemplate(ctx, req.(*DeleteJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_SetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).SetNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_SetNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).SetNamespace(ctx, req.(*SetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dkron_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DkronServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dkron_DeleteNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DkronServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dkron_ServiceDesc is the grpc.ServiceDesc for Dkron service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -522,6 +998,10 @@ var Dkron_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetJob",
 			Handler:    _Dkron_SetJob_Handler,
 		},
+		{
+			MethodName: "PatchJob",
+			Handler:    _Dkron_PatchJob_Handler,
+		},
 		{
 			MethodName: "DeleteJob",
 			Handler:    _Dkron_DeleteJob_Handler,
@@ -554,6 +1034,58 @@ var Dkron_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetExecution",
 			Handler:    _Dkron_SetExecution_Handler,
 		},
+		{
+			MethodName: "CancelExecution",
+			Handler:    _Dkron_CancelExecution_Handler,
+		},
+		{
+			MethodName: "SetCalendar",
+			Handler:    _Dkron_SetCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteCalendar",
+			Handler:    _Dkron_DeleteCalendar_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceWindow",
+			Handler:    _Dkron_SetMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _Dkron_DeleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "SetPause",
+			Handler:    _Dkron_SetPause_Handler,
+		},
+		{
+			MethodName: "DeletePause",
+			Handler:    _Dkron_DeletePause_Handler,
+		},
+		{
+			MethodName: "SetWebhookTrigger",
+			Handler:    _Dkron_SetWebhookTrigger_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookTrigger",
+			Handler:    _Dkron_DeleteWebhookTrigger_Handler,
+		},
+		{
+			MethodName: "SetJobTemplate",
+			Handler:    _Dkron_SetJobTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteJobTemplate",
+			Handler:    _Dkron_DeleteJobTemplate_Handler,
+		},
+		{
+			MethodName: "SetNamespace",
+			Handler:    _Dkron_SetNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _Dkron_DeleteNamespace_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "types/v1/dkron.proto",
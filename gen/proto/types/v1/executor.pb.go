@@ -28,6 +28,7 @@ type ExecuteRequest struct {
 	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 	Config        map[string]string      `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	StatusServer  uint32                 `protobuf:"varint,3,opt,name=status_server,json=statusServer,proto3" json:"status_server,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,6 +84,13 @@ func (x *ExecuteRequest) GetStatusServer() uint32 {
 	return 0
 }
 
+func (x *ExecuteRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
 type ExecuteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Output        []byte                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
@@ -235,13 +243,17 @@ var File_types_v1_executor_proto protoreflect.FileDescriptor
 
 const file_types_v1_executor_proto_rawDesc = "" +
 	"\n" +
-	"\x17types/v1/executor.proto\x12\btypes.v1\"\xc9\x01\n" +
+	"\x17types/v1/executor.proto\x12\btypes.v1\"\xb6\x02\n" +
 	"\x0eExecuteRequest\x12\x19\n" +
 	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12<\n" +
 	"\x06config\x18\x02 \x03(\v2$.types.v1.ExecuteRequest.ConfigEntryR\x06config\x12#\n" +
-	"\rstatus_server\x18\x03 \x01(\rR\fstatusServer\x1a9\n" +
+	"\rstatus_server\x18\x03 \x01(\rR\fstatusServer\x123\n" +
+	"\x03env\x18\x04 \x03(\v2!.types.v1.ExecuteRequest.EnvEntryR\x03env\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"?\n" +
 	"\x0fExecuteResponse\x12\x16\n" +
 	"\x06output\x18\x01 \x01(\fR\x06output\x12\x14\n" +
@@ -269,25 +281,27 @@ func file_types_v1_executor_proto_rawDescGZIP() []byte {
 	return file_types_v1_executor_proto_rawDescData
 }
 
-var file_types_v1_executor_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_types_v1_executor_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_types_v1_executor_proto_goTypes = []any{
 	(*ExecuteRequest)(nil),       // 0: types.v1.ExecuteRequest
 	(*ExecuteResponse)(nil),      // 1: types.v1.ExecuteResponse
 	(*StatusUpdateRequest)(nil),  // 2: types.v1.StatusUpdateRequest
 	(*StatusUpdateResponse)(nil), // 3: types.v1.StatusUpdateResponse
 	nil,                          // 4: types.v1.ExecuteRequest.ConfigEntry
+	nil,                          // 5: types.v1.ExecuteRequest.EnvEntry
 }
 var file_types_v1_executor_proto_depIdxs = []int32{
 	4, // 0: types.v1.ExecuteRequest.config:type_name -> types.v1.ExecuteRequest.ConfigEntry
-	0, // 1: types.v1.ExecutorService.Execute:input_type -> types.v1.ExecuteRequest
-	2, // 2: types.v1.StatusHelperService.Update:input_type -> types.v1.StatusUpdateRequest
-	1, // 3: types.v1.ExecutorService.Execute:output_type -> types.v1.ExecuteResponse
-	3, // 4: types.v1.StatusHelperService.Update:output_type -> types.v1.StatusUpdateResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 1: types.v1.ExecuteRequest.env:type_name -> types.v1.ExecuteRequest.EnvEntry
+	0, // 2: types.v1.ExecutorService.Execute:input_type -> types.v1.ExecuteRequest
+	2, // 3: types.v1.StatusHelperService.Update:input_type -> types.v1.StatusUpdateRequest
+	1, // 4: types.v1.ExecutorService.Execute:output_type -> types.v1.ExecuteResponse
+	3, // 5: types.v1.StatusHelperService.Update:output_type -> types.v1.StatusUpdateResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_types_v1_executor_proto_init() }
@@ -301,7 +315,7 @@ func file_types_v1_executor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_v1_executor_proto_rawDesc), len(file_types_v1_executor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
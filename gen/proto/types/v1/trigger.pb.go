@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: types/v1/trigger.proto
+
+package typesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TriggerMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerMessage) Reset() {
+	*x = TriggerMessage{}
+	mi := &file_types_v1_trigger_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerMessage) ProtoMessage() {}
+
+func (x *TriggerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_trigger_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerMessage.ProtoReflect.Descriptor instead.
+func (*TriggerMessage) Descriptor() ([]byte, []int) {
+	return file_types_v1_trigger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TriggerMessage) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TriggerMessage) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TriggerMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TriggerMessage) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type ConsumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Config        map[string]string      `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HandlerServer uint32                 `protobuf:"varint,3,opt,name=handler_server,json=handlerServer,proto3" json:"handler_server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeRequest) Reset() {
+	*x = ConsumeRequest{}
+	mi := &file_types_v1_trigger_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeRequest) ProtoMessage() {}
+
+func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_trigger_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_trigger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConsumeRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ConsumeRequest) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ConsumeRequest) GetHandlerServer() uint32 {
+	if x != nil {
+		return x.HandlerServer
+	}
+	return 0
+}
+
+type ConsumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeResponse) Reset() {
+	*x = ConsumeResponse{}
+	mi := &file_types_v1_trigger_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeResponse) ProtoMessage() {}
+
+func (x *ConsumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_trigger_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_trigger_proto_rawDescGZIP(), []int{2}
+}
+
+type HandleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*TriggerMessage      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleRequest) Reset() {
+	*x = HandleRequest{}
+	mi := &file_types_v1_trigger_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleRequest) ProtoMessage() {}
+
+func (x *HandleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_trigger_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleRequest.ProtoReflect.Descriptor instead.
+func (*HandleRequest) Descriptor() ([]byte, []int) {
+	return file_types_v1_trigger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HandleRequest) GetMessages() []*TriggerMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type HandleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleResponse) Reset() {
+	*x = HandleResponse{}
+	mi := &file_types_v1_trigger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleResponse) ProtoMessage() {}
+
+func (x *HandleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_trigger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleResponse.ProtoReflect.Descriptor instead.
+func (*HandleResponse) Descriptor() ([]byte, []int) {
+	return file_types_v1_trigger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HandleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HandleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_types_v1_trigger_proto protoreflect.FileDescriptor
+
+const file_types_v1_trigger_proto_rawDesc = "" +
+	"\n" +
+	"\x16types/v1/trigger.proto\x12\btypes.v1\"\xd1\x01\n" +
+	"\x0eTriggerMessage\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\x12?\n" +
+	"\aheaders\x18\x04 \x03(\v2%.types.v1.TriggerMessage.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcb\x01\n" +
+	"\x0eConsumeRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12<\n" +
+	"\x06config\x18\x02 \x03(\v2$.types.v1.ConsumeRequest.ConfigEntryR\x06config\x12%\n" +
+	"\x0ehandler_server\x18\x03 \x01(\rR\rhandlerServer\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x11\n" +
+	"\x0fConsumeResponse\"E\n" +
+	"\rHandleRequest\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.types.v1.TriggerMessageR\bmessages\"@\n" +
+	"\x0eHandleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2P\n" +
+	"\x0eTriggerService\x12>\n" +
+	"\aConsume\x12\x18.types.v1.ConsumeRequest\x1a\x19.types.v1.ConsumeResponse2T\n" +
+	"\x15MessageHandlerService\x12;\n" +
+	"\x06Handle\x12\x17.types.v1.HandleRequest\x1a\x18.types.v1.HandleResponseB\x96\x01\n" +
+	"\fcom.types.v1B\fTriggerProtoP\x01Z7github.com/distribworks/dkron/v4/types/types/v1;typesv1\xa2\x02\x03TXX\xaa\x02\bTypes.V1\xca\x02\bTypes\\V1\xe2\x02\x14Types\\V1\\GPBMetadata\xea\x02\tTypes::V1b\x06proto3"
+
+var (
+	file_types_v1_trigger_proto_rawDescOnce sync.Once
+	file_types_v1_trigger_proto_rawDescData []byte
+)
+
+func file_types_v1_trigger_proto_rawDescGZIP() []byte {
+	file_types_v1_trigger_proto_rawDescOnce.Do(func() {
+		file_types_v1_trigger_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_types_v1_trigger_proto_rawDesc), len(file_types_v1_trigger_proto_rawDesc)))
+	})
+	return file_types_v1_trigger_proto_rawDescData
+}
+
+var file_types_v1_trigger_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_types_v1_trigger_proto_goTypes = []any{
+	(*TriggerMessage)(nil),  // 0: types.v1.TriggerMessage
+	(*ConsumeRequest)(nil),  // 1: types.v1.ConsumeRequest
+	(*ConsumeResponse)(nil), // 2: types.v1.ConsumeResponse
+	(*HandleRequest)(nil),   // 3: types.v1.HandleRequest
+	(*HandleResponse)(nil),  // 4: types.v1.HandleResponse
+	nil,                     // 5: types.v1.TriggerMessage.HeadersEntry
+	nil,                     // 6: types.v1.ConsumeRequest.ConfigEntry
+}
+var file_types_v1_trigger_proto_depIdxs = []int32{
+	5, // 0: types.v1.TriggerMessage.headers:type_name -> types.v1.TriggerMessage.HeadersEntry
+	6, // 1: types.v1.ConsumeRequest.config:type_name -> types.v1.ConsumeRequest.ConfigEntry
+	0, // 2: types.v1.HandleRequest.messages:type_name -> types.v1.TriggerMessage
+	1, // 3: types.v1.TriggerService.Consume:input_type -> types.v1.ConsumeRequest
+	3, // 4: types.v1.MessageHandlerService.Handle:input_type -> types.v1.HandleRequest
+	2, // 5: types.v1.TriggerService.Consume:output_type -> types.v1.ConsumeResponse
+	4, // 6: types.v1.MessageHandlerService.Handle:output_type -> types.v1.HandleResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_types_v1_trigger_proto_init() }
+func file_types_v1_trigger_proto_init() {
+	if File_types_v1_trigger_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_v1_trigger_proto_rawDesc), len(file_types_v1_trigger_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_types_v1_trigger_proto_goTypes,
+		DependencyIndexes: file_types_v1_trigger_proto_depIdxs,
+		MessageInfos:      file_types_v1_trigger_proto_msgTypes,
+	}.Build()
+	File_types_v1_trigger_proto = out.File
+	file_types_v1_trigger_proto_goTypes = nil
+	file_types_v1_trigger_proto_depIdxs = nil
+}
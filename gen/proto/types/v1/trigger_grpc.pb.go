@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: types/v1/trigger.proto
+
+package typesv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TriggerService_Consume_FullMethodName = "/types.v1.TriggerService/Consume"
+)
+
+// TriggerServiceClient is the client API for TriggerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TriggerServiceClient interface {
+	Consume(ctx context.Context, in *ConsumeRequest, opts ...grpc.CallOption) (*ConsumeResponse, error)
+}
+
+type triggerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTriggerServiceClient(cc grpc.ClientConnInterface) TriggerServiceClient {
+	return &triggerServiceClient{cc}
+}
+
+func (c *triggerServiceClient) Consume(ctx context.Context, in *ConsumeRequest, opts ...grpc.CallOption) (*ConsumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeResponse)
+	err := c.cc.Invoke(ctx, TriggerService_Consume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggerServiceServer is the server API for TriggerService service.
+// All implementations must embed UnimplementedTriggerServiceServer
+// for forward compatibility.
+type TriggerServiceServer interface {
+	Consume(context.Context, *ConsumeRequest) (*ConsumeResponse, error)
+	mustEmbedUnimplementedTriggerServiceServer()
+}
+
+// UnimplementedTriggerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTriggerServiceServer struct{}
+
+func (UnimplementedTriggerServiceServer) Consume(context.Context, *ConsumeRequest) (*ConsumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Consume not implemented")
+}
+func (UnimplementedTriggerServiceServer) mustEmbedUnimplementedTriggerServiceServer() {}
+func (UnimplementedTriggerServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTriggerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TriggerServiceServer will
+// result in compilation errors.
+type UnsafeTriggerServiceServer interface {
+	mustEmbedUnimplementedTriggerServiceServer()
+}
+
+func RegisterTriggerServiceServer(s grpc.ServiceRegistrar, srv TriggerServiceServer) {
+	// If the following call panics, it indicates UnimplementedTriggerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TriggerService_ServiceDesc, srv)
+}
+
+func _TriggerService_Consume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).Consume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_Consume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).Consume(ctx, req.(*ConsumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TriggerService_ServiceDesc is the grpc.ServiceDesc for TriggerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TriggerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "types.v1.TriggerService",
+	HandlerType: (*TriggerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Consume",
+			Handler:    _TriggerService_Consume_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "types/v1/trigger.proto",
+}
+
+const (
+	MessageHandlerService_Handle_FullMethodName = "/types.v1.MessageHandlerService/Handle"
+)
+
+// MessageHandlerServiceClient is the client API for MessageHandlerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MessageHandlerServiceClient interface {
+	Handle(ctx context.Context, in *HandleRequest, opts ...grpc.CallOption) (*HandleResponse, error)
+}
+
+type messageHandlerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMessageHandlerServiceClient(cc grpc.ClientConnInterface) MessageHandlerServiceClient {
+	return &messageHandlerServiceClient{cc}
+}
+
+func (c *messageHandlerServiceClient) Handle(ctx context.Context, in *HandleRequest, opts ...grpc.CallOption) (*HandleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandleResponse)
+	err := c.cc.Invoke(ctx, MessageHandlerService_Handle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MessageHandlerServiceServer is the server API for MessageHandlerService service.
+// All implementations must embed UnimplementedMessageHandlerServiceServer
+// for forward compatibility.
+type MessageHandlerServiceServer interface {
+	Handle(context.Context, *HandleRequest) (*HandleResponse, error)
+	mustEmbedUnimplementedMessageHandlerServiceServer()
+}
+
+// UnimplementedMessageHandlerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMessageHandlerServiceServer struct{}
+
+func (UnimplementedMessageHandlerServiceServer) Handle(context.Context, *HandleRequest) (*HandleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Handle not implemented")
+}
+func (UnimplementedMessageHandlerServiceServer) mustEmbedUnimplementedMessageHandlerServiceServer() {}
+func (UnimplementedMessageHandlerServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeMessageHandlerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessageHandlerServiceServer will
+// result in compilation errors.
+type UnsafeMessageHandlerServiceServer interface {
+	mustEmbedUnimplementedMessageHandlerServiceServer()
+}
+
+func RegisterMessageHandlerServiceServer(s grpc.ServiceRegistrar, srv MessageHandlerServiceServer) {
+	// If the following call panics, it indicates UnimplementedMessageHandlerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MessageHandlerService_ServiceDesc, srv)
+}
+
+func _MessageHandlerService_Handle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageHandlerServiceServer).Handle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageHandlerService_Handle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageHandlerServiceServer).Handle(ctx, req.(*HandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MessageHandlerService_ServiceDesc is the grpc.ServiceDesc for MessageHandlerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MessageHandlerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "types.v1.MessageHandlerService",
+	HandlerType: (*MessageHandlerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Handle",
+			Handler:    _MessageHandlerService_Handle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "types/v1/trigger.proto",
+}
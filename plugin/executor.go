@@ -17,6 +17,12 @@ type Executor interface {
 	Execute(args *typesv1.ExecuteRequest, cb StatusHelper) (*typesv1.ExecuteResponse, error)
 }
 
+// ContextExecutor is implemented by the executors that can stop a running
+// execution, the context is cancelled when the execution is cancelled.
+type ContextExecutor interface {
+	ExecuteContext(ctx context.Context, args *typesv1.ExecuteRequest, cb StatusHelper) (*typesv1.ExecuteResponse, error)
+}
+
 // ExecutorPluginConfig is the plugin config
 type ExecutorPluginConfig map[string]string
 
@@ -50,6 +56,12 @@ type ExecutorClient struct {
 }
 
 func (m *ExecutorClient) Execute(args *typesv1.ExecuteRequest, cb StatusHelper) (*typesv1.ExecuteResponse, error) {
+	return m.ExecuteContext(context.Background(), args, cb)
+}
+
+// ExecuteContext runs the execution in the plugin, cancelling the context
+// cancels the call and signals the plugin to stop the execution.
+func (m *ExecutorClient) ExecuteContext(ctx context.Context, args *typesv1.ExecuteRequest, cb StatusHelper) (*typesv1.ExecuteResponse, error) {
 	// This is where the magic conversion to Proto happens
 	statusHelperServer := &GRPCStatusHelperServer{Impl: cb}
 
@@ -75,7 +87,7 @@ func (m *ExecutorClient) Execute(args *typesv1.ExecuteRequest, cb StatusHelper)
 	<-initChan
 
 	args.StatusServer = brokerID
-	r, err := m.client.Execute(context.Background(), args)
+	r, err := m.client.Execute(ctx, args)
 
 	/* In some cases the server cannot start (ex: too many open files), so, the s pointer is nil */
 	if s != nil {
@@ -101,6 +113,9 @@ func (m ExecutorServer) Execute(ctx context.Context, req *typesv1.ExecuteRequest
 	defer conn.Close()
 
 	a := &GRPCStatusHelperClient{typesv1.NewStatusHelperServiceClient(conn)}
+	if ce, ok := m.Impl.(ContextExecutor); ok {
+		return ce.ExecuteContext(ctx, req, a)
+	}
 	return m.Impl.Execute(req, a)
 }
 
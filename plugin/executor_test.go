@@ -44,3 +44,27 @@ func TestExecuteDoesNotPanicIfGRPCIsNotInitializedOnTime(t *testing.T) {
 	var statusHelperMock MockedStatusHelper
 	assert.NotPanics(t, func() { execClient.Execute(&requestStub, statusHelperMock) })
 }
+
+type ContextMockedExecutor struct{}
+
+func (m *ContextMockedExecutor) Execute(ctx context.Context, in *dktypes.ExecuteRequest, opts ...grpc.CallOption) (*dktypes.ExecuteResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestExecuteContextIsPassedToThePlugin(t *testing.T) {
+	var brokerMock MockedBroker
+	var execMock ContextMockedExecutor
+	execClient := ExecutorClient{
+		client: &execMock,
+		broker: &brokerMock,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var requestStub dktypes.ExecuteRequest
+	var statusHelperMock MockedStatusHelper
+	_, err := execClient.ExecuteContext(ctx, &requestStub, statusHelperMock)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -10,4 +10,5 @@ import (
 var PluginMap = map[string]plugin.Plugin{
 	"processor": &ProcessorPlugin{},
 	"executor":  &ExecutorPlugin{},
+	"trigger":   &TriggerPlugin{},
 }
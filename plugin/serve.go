@@ -9,6 +9,7 @@ import (
 const (
 	ProcessorPluginName = "processor"
 	ExecutorPluginName  = "executor"
+	TriggerPluginName   = "trigger"
 )
 
 // Handshake is the HandshakeConfig used to configure clients and servers.
@@ -1,6 +1,7 @@
 package shell
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -44,7 +45,12 @@ type Shell struct{}
 
 // Execute method of the plugin
 func (s *Shell) Execute(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelper) (*dktypes.ExecuteResponse, error) {
-	out, err := s.ExecuteImpl(args, cb)
+	return s.ExecuteContext(context.Background(), args, cb)
+}
+
+// ExecuteContext method of the plugin, cancelling the context kills the running command
+func (s *Shell) ExecuteContext(ctx context.Context, args *dktypes.ExecuteRequest, cb dkplugin.StatusHelper) (*dktypes.ExecuteResponse, error) {
+	out, err := s.ExecuteImplContext(ctx, args, cb)
 	resp := &dktypes.ExecuteResponse{Output: out}
 	if err != nil {
 		resp.Error = err.Error()
@@ -54,6 +60,11 @@ func (s *Shell) Execute(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelper)
 
 // ExecuteImpl do execute command
 func (s *Shell) ExecuteImpl(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelper) ([]byte, error) {
+	return s.ExecuteImplContext(context.Background(), args, cb)
+}
+
+// ExecuteImplContext do execute command, killing it and its children when the context is cancelled
+func (s *Shell) ExecuteImplContext(ctx context.Context, args *dktypes.ExecuteRequest, cb dkplugin.StatusHelper) ([]byte, error) {
 	output, _ := circbuf.NewBuffer(maxBufSize)
 
 	shell, err := strconv.ParseBool(args.Config["shell"])
@@ -66,15 +77,14 @@ func (s *Shell) ExecuteImpl(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelp
 
 	executionInfo := strings.Split(fmt.Sprintf("ENV_JOB_NAME=%s", args.JobName), ",")
 	env = append(env, executionInfo...)
+	for k, v := range args.Env {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
 
 	cmd, err := buildCmd(command, shell, env, cwd)
 	if err != nil {
 		return nil, err
 	}
-	err = setCmdAttr(cmd, args.Config)
-	if err != nil {
-		return nil, err
-	}
 	// use same buffer for both channels, for the full return at the end
 	cmd.Stderr = reportingWriter{buffer: output, cb: cb, isError: true}
 	cmd.Stdout = reportingWriter{buffer: output, cb: cb}
@@ -104,6 +114,20 @@ func (s *Shell) ExecuteImpl(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelp
 		}
 	}
 
+	// Parse memory limit if specified
+	memLimit, err := parseMemoryLimit(args.Config["mem_limit"])
+	if err != nil {
+		return nil, fmt.Errorf("shell: Error parsing job memory limit: %v", err)
+	}
+
+	// Only the commands that can be killed run in their own process group, so
+	// they are killed along with their children.
+	killable := ctx.Done() != nil || jt != 0 || memLimit > 0
+	err = setCmdAttr(cmd, args.Config, killable)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Printf("shell: going to run %s", command)
 
 	err = cmd.Start()
@@ -130,11 +154,17 @@ func (s *Shell) ExecuteImpl(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelp
 		defer slowTimer.Stop()
 	}
 
-	// Parse memory limit if specified
-	memLimit, err := parseMemoryLimit(args.Config["mem_limit"])
-	if err != nil {
-		return nil, fmt.Errorf("shell: Error parsing job memory limit: %v", err)
-	}
+	// Kill the command if the execution is cancelled
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			if err := processKill(cmd); err != nil {
+				log.Printf("shell: Error killing cancelled job '%s': %v", command, err)
+			}
+		}
+	}()
 
 	var memLimitExceededMessage string
 	var memLimitExceeded bool
@@ -181,6 +211,7 @@ func (s *Shell) ExecuteImpl(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelp
 	// go CollectProcessMetrics(args.JobName, cmd.Process.Pid, quit)
 
 	err = cmd.Wait()
+	close(done)
 	if err != nil {
 		log.Printf("shell: Job '%s' execution failed with error: %v", command, err)
 	}
@@ -195,6 +226,13 @@ func (s *Shell) ExecuteImpl(args *dktypes.ExecuteRequest, cb dkplugin.StatusHelp
 		}
 	}
 
+	if ctx.Err() != nil {
+		_, err := output.Write([]byte(fmt.Sprintf("shell: Job '%s' execution was cancelled. Job was killed", command)))
+		if err != nil {
+			log.Printf("Error writing output on cancel event: %v", err)
+		}
+	}
+
 	if memLimitExceeded {
 		_, err := output.Write([]byte(memLimitExceededMessage))
 		if err != nil {
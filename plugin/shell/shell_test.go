@@ -1,6 +1,7 @@
 package shell
 
 import (
+	"context"
 	"os"
 	"runtime"
 	"testing"
@@ -204,6 +205,60 @@ func TestExecuteImpl_CmdStartWait_WithEnvironment(t *testing.T) {
 	assert.Contains(t, string(output), "test-job-env") // ENV_JOB_NAME should be set
 }
 
+func TestExecuteImpl_CmdStartWait_WithRequestEnvironment(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping request environment test on Windows")
+	}
+
+	s := &Shell{}
+	mockCb := &MockStatusHelper{}
+
+	args := &dktypes.ExecuteRequest{
+		JobName: "test-job-parent-env",
+		Config: map[string]string{
+			"command": "echo $ENV_PARENT_JOB_NAME $ENV_PARENT_OUTPUT",
+			"shell":   "true",
+		},
+		Env: map[string]string{
+			"ENV_PARENT_JOB_NAME": "parent-job",
+			"ENV_PARENT_OUTPUT":   "/tmp/report.csv",
+		},
+	}
+
+	output, err := s.ExecuteImpl(args, mockCb)
+
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), "parent-job /tmp/report.csv")
+}
+
+func TestExecuteImpl_CmdStartWait_Cancel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping cancel test on Windows")
+	}
+
+	s := &Shell{}
+	mockCb := &MockStatusHelper{}
+
+	// The child processes must be killed too, otherwise they keep the output open
+	args := &dktypes.ExecuteRequest{
+		JobName: "test-job-cancel",
+		Config: map[string]string{
+			"command": "sleep 10 & sleep 10",
+			"shell":   "true",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+
+	start := time.Now()
+	output, err := s.ExecuteImplContext(ctx, args, mockCb)
+
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Contains(t, string(output), "execution was cancelled")
+}
+
 func TestExecuteImpl_CmdStartWait_NonShellCommand(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("Skipping non-shell command test on Windows")
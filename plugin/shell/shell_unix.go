@@ -11,8 +11,10 @@ import (
 	"syscall"
 )
 
-func setCmdAttr(cmd *exec.Cmd, config map[string]string) error {
-	cmd.SysProcAttr = &syscall.SysProcAttr{}
+func setCmdAttr(cmd *exec.Cmd, config map[string]string, ownGroup bool) error {
+	// Run the command in its own process group, to be able to kill it
+	// along with its children on timeout or cancellation.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: ownGroup}
 
 	su := config["su"]
 	if su != "" {
@@ -39,13 +41,12 @@ func setCmdAttr(cmd *exec.Cmd, config map[string]string) error {
 		}
 	}
 
-	jobTimeout := config["timeout"]
-	if jobTimeout != "" {
-		cmd.SysProcAttr.Setpgid = true
-	}
 	return nil
 }
 
 func processKill(cmd *exec.Cmd) error {
+	if cmd.SysProcAttr == nil || !cmd.SysProcAttr.Setpgid {
+		return cmd.Process.Kill()
+	}
 	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL) // note the minus sign
 }
@@ -0,0 +1,32 @@
+//go:build !windows
+// +build !windows
+
+package shell
+
+import (
+	"os/exec"
+	"os/user"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_setCmdAttrSu(t *testing.T) {
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	// su jobs always get their own process group, killable or not.
+	cmd := exec.Command("true")
+	require.NoError(t, setCmdAttr(cmd, map[string]string{"su": u.Username}, false))
+	assert.True(t, cmd.SysProcAttr.Setpgid)
+	assert.NotNil(t, cmd.SysProcAttr.Credential)
+
+	cmd = exec.Command("true")
+	require.NoError(t, setCmdAttr(cmd, map[string]string{}, false))
+	assert.False(t, cmd.SysProcAttr.Setpgid)
+
+	cmd = exec.Command("true")
+	require.NoError(t, setCmdAttr(cmd, map[string]string{}, true))
+	assert.True(t, cmd.SysProcAttr.Setpgid)
+}
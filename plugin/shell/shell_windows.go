@@ -7,7 +7,7 @@ import (
 	"os/exec"
 )
 
-func setCmdAttr(cmd *exec.Cmd, config map[string]string) error {
+func setCmdAttr(cmd *exec.Cmd, config map[string]string, ownGroup bool) error {
 	return nil
 }
 
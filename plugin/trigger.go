@@ -0,0 +1,195 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	typesv1 "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+// MessageHandler starts a job run for the messages consumed by a trigger,
+// it returns once the run finished, with an error if it didn't succeed.
+type MessageHandler interface {
+	Handle(ctx context.Context, messages []*typesv1.TriggerMessage) error
+}
+
+// Trigger is the interface of the trigger plugins, that consume a message
+// source and start a job run for every message, or batch of messages.
+type Trigger interface {
+	// Consume consumes the source described by the config of the request
+	// until the context is cancelled, passing the messages to the handler.
+	// Messages must only be acknowledged when the handler returns nil, and
+	// be delivered again otherwise.
+	Consume(ctx context.Context, args *typesv1.ConsumeRequest, h MessageHandler) error
+}
+
+// BatchConfig returns the batch settings of a trigger config: batch_size,
+// the number of messages passed to a run, 1 by default, and batch_wait,
+// how long to wait for a batch to fill, 1s by default.
+func BatchConfig(config map[string]string) (int, time.Duration, error) {
+	size, wait := 1, time.Second
+	if v := config["batch_size"]; v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return 0, 0, fmt.Errorf("invalid batch_size %q", v)
+		}
+		size = n
+	}
+	if v := config["batch_wait"]; v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("invalid batch_wait %q", v)
+		}
+		wait = d
+	}
+	return size, wait, nil
+}
+
+// CollectBatch receives up to size items from the channel, waiting at most
+// wait for the batch to fill once the first item is received. It returns a
+// shorter batch when the channel is closed or the context is cancelled.
+func CollectBatch[T any](ctx context.Context, ch <-chan T, size int, wait time.Duration) []T {
+	var batch []T
+	select {
+	case item, ok := <-ch:
+		if !ok {
+			return nil
+		}
+		batch = append(batch, item)
+	case <-ctx.Done():
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for len(batch) < size {
+		select {
+		case item, ok := <-ch:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, item)
+		case <-timer.C:
+			return batch
+		case <-ctx.Done():
+			return batch
+		}
+	}
+	return batch
+}
+
+// This is the implementation of plugin.Plugin so we can serve/consume this.
+// Trigger plugins are only served over gRPC.
+type TriggerPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	Trigger Trigger
+}
+
+func (p *TriggerPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	typesv1.RegisterTriggerServiceServer(s, TriggerServer{Impl: p.Trigger, broker: broker})
+	return nil
+}
+
+func (p *TriggerPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &TriggerClient{client: typesv1.NewTriggerServiceClient(c), broker: broker}, nil
+}
+
+// Here is the gRPC client that GRPCClient talks to.
+type TriggerClient struct {
+	// This is the real implementation
+	client typesv1.TriggerServiceClient
+	broker Broker
+}
+
+// Consume consumes in the plugin, serving the handler to the plugin until
+// the context is cancelled.
+func (m *TriggerClient) Consume(ctx context.Context, args *typesv1.ConsumeRequest, h MessageHandler) error {
+	handlerServer := &GRPCMessageHandlerServer{Impl: h}
+
+	initChan := make(chan bool, 1)
+	var s *grpc.Server
+	serverFunc := func(opts []grpc.ServerOption) *grpc.Server {
+		s = grpc.NewServer(opts...)
+		typesv1.RegisterMessageHandlerServiceServer(s, handlerServer)
+		initChan <- true
+
+		return s
+	}
+
+	brokerID := m.broker.NextId()
+	go func() {
+		m.broker.AcceptAndServe(brokerID, serverFunc)
+		// AcceptAndServe might terminate without calling serverFunc
+		// To prevent eternal blocking, send 'init done' signal
+		initChan <- true
+	}()
+
+	// Wait for s to be initialized in the goroutine
+	<-initChan
+
+	args.HandlerServer = brokerID
+	_, err := m.client.Consume(ctx, args)
+
+	if s != nil {
+		s.Stop()
+	}
+	return err
+}
+
+// Here is the gRPC server that GRPCClient talks to.
+type TriggerServer struct {
+	// This is the real implementation
+	typesv1.TriggerServiceServer
+	Impl   Trigger
+	broker *plugin.GRPCBroker
+}
+
+// Consume calls the plugin with a handler calling back the agent.
+func (m TriggerServer) Consume(ctx context.Context, req *typesv1.ConsumeRequest) (*typesv1.ConsumeResponse, error) {
+	conn, err := m.broker.Dial(req.HandlerServer)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	h := &GRPCMessageHandlerClient{typesv1.NewMessageHandlerServiceClient(conn)}
+	if err := m.Impl.Consume(ctx, req, h); err != nil {
+		return nil, err
+	}
+	return &typesv1.ConsumeResponse{}, nil
+}
+
+// GRPCMessageHandlerClient is an implementation of the message handler over RPC.
+type GRPCMessageHandlerClient struct {
+	client typesv1.MessageHandlerServiceClient
+}
+
+func (m *GRPCMessageHandlerClient) Handle(ctx context.Context, messages []*typesv1.TriggerMessage) error {
+	resp, err := m.client.Handle(ctx, &typesv1.HandleRequest{Messages: messages})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return errors.New(resp.Error)
+	}
+	return nil
+}
+
+// GRPCMessageHandlerServer is the gRPC server that GRPCClient talks to.
+type GRPCMessageHandlerServer struct {
+	// This is the real implementation
+	typesv1.MessageHandlerServiceServer
+	Impl MessageHandler
+}
+
+func (m *GRPCMessageHandlerServer) Handle(ctx context.Context, req *typesv1.HandleRequest) (*typesv1.HandleResponse, error) {
+	if err := m.Impl.Handle(ctx, req.Messages); err != nil {
+		return &typesv1.HandleResponse{Error: err.Error()}, nil
+	}
+	return &typesv1.HandleResponse{Success: true}, nil
+}
@@ -0,0 +1,99 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dktypes "github.com/distribworks/dkron/v4/gen/proto/types/v1"
+	grpc "google.golang.org/grpc"
+)
+
+type MockedTrigger struct{}
+
+func (m *MockedTrigger) Consume(ctx context.Context, in *dktypes.ConsumeRequest, opts ...grpc.CallOption) (*dktypes.ConsumeResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+type MockedMessageHandler struct {
+	err error
+}
+
+func (m *MockedMessageHandler) Handle(ctx context.Context, messages []*dktypes.TriggerMessage) error {
+	return m.err
+}
+
+func TestConsumeDoesNotPanicIfGRPCIsNotInitializedOnTime(t *testing.T) {
+	var brokerMock MockedBroker
+	var triggerMock MockedTrigger
+	triggerClient := TriggerClient{
+		client: &triggerMock,
+		broker: &brokerMock,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var requestStub dktypes.ConsumeRequest
+	assert.NotPanics(t, func() {
+		err := triggerClient.Consume(ctx, &requestStub, &MockedMessageHandler{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestMessageHandlerServer(t *testing.T) {
+	s := &GRPCMessageHandlerServer{Impl: &MockedMessageHandler{}}
+	resp, err := s.Handle(context.Background(), &dktypes.HandleRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	// Run failures are returned in the response to nack the messages
+	s = &GRPCMessageHandlerServer{Impl: &MockedMessageHandler{err: errors.New("run failed")}}
+	resp, err = s.Handle(context.Background(), &dktypes.HandleRequest{})
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Equal(t, "run failed", resp.Error)
+}
+
+func TestBatchConfig(t *testing.T) {
+	size, wait, err := BatchConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, size)
+	assert.Equal(t, time.Second, wait)
+
+	size, wait, err = BatchConfig(map[string]string{"batch_size": "10", "batch_wait": "5s"})
+	require.NoError(t, err)
+	assert.Equal(t, 10, size)
+	assert.Equal(t, 5*time.Second, wait)
+
+	_, _, err = BatchConfig(map[string]string{"batch_size": "0"})
+	assert.Error(t, err)
+
+	_, _, err = BatchConfig(map[string]string{"batch_wait": "soon"})
+	assert.Error(t, err)
+}
+
+func TestCollectBatch(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := range 5 {
+		ch <- i
+	}
+
+	// Full batches are returned right away
+	assert.Equal(t, []int{0, 1, 2}, CollectBatch(context.Background(), ch, 3, time.Hour))
+
+	// Partial batches are returned after the wait
+	assert.Equal(t, []int{3, 4}, CollectBatch(context.Background(), ch, 3, 10*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Empty(t, CollectBatch(ctx, ch, 3, time.Hour))
+
+	close(ch)
+	assert.Empty(t, CollectBatch(context.Background(), ch, 3, time.Hour))
+}
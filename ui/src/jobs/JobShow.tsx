@@ -166,11 +166,13 @@ const JobShow = (props: any) => (
             <TextField source="id" />
             <TextField source="group" sortable={false} />
             <TextField source="job_name" sortable={false} />
+            <ZeroDateField source="scheduled_at" showTime />
             <DateField source="started_at" showTime />
             <ZeroDateField source="finished_at" showTime />
             <TextField source="node_name" sortable={false} />
             <SuccessField />
             <NumberField source="attempt" />
+            <TextField source="trigger" sortable={false} />
           </Datagrid>
         </ReferenceManyField>
       </Tab>